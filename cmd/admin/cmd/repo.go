@@ -6,13 +6,15 @@ import (
 )
 
 type CommandRepository struct {
+	DBPath     string
 	CtrlDBConn *driver.DB
 	CtrlDB     ctrlrepo.ControllerRepository
 }
 
 var Repo CommandRepository
 
-func InitCmdRepo(ctrlDBConn *driver.DB, ctrldb ctrlrepo.ControllerRepository) {
+func InitCmdRepo(dbPath string, ctrlDBConn *driver.DB, ctrldb ctrlrepo.ControllerRepository) {
+	Repo.DBPath = dbPath
 	Repo.CtrlDBConn = ctrlDBConn
 	Repo.CtrlDB = ctrldb
 }
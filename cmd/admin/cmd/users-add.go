@@ -54,7 +54,7 @@ var usersAddCmd = &cobra.Command{
 			i++
 
 			// Try to get file
-			_, err := os.ReadFile(migrationsPath + fmt.Sprintf("user-%d-up.sql", i))
+			_, err := os.ReadFile(migrationsPath + fmt.Sprintf("userdb-%d-up.sql", i))
 
 			// Exit if migration not found
 			if os.IsNotExist(err) {
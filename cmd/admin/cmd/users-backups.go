@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/backups"
+	"github.com/dimitargrozev5/expenses-go-1/internal/userdb"
+	"github.com/spf13/cobra"
+)
+
+// Backup storage settings. They match the flags of the DB Node
+var backupConfig backups.Config
+
+func init() {
+	usersCmd.AddCommand(usersBackupsCmd)
+	usersBackupsCmd.AddCommand(usersBackupsListCmd)
+	addBackupFlags(usersBackupsListCmd)
+}
+
+// Add flags of the backup storage to a command
+func addBackupFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&backupConfig.Backend, "backup-backend", "local", "Backup storage: local or s3")
+	cmd.Flags().StringVar(&backupConfig.Dir, "backup-dir", "./backups/", "Folder for local backups")
+	cmd.Flags().StringVar(&backupConfig.S3.Endpoint, "backup-s3-endpoint", "", "S3 compatible server URL (http://localhost:9000 for MinIO)")
+	cmd.Flags().StringVar(&backupConfig.S3.Region, "backup-s3-region", "us-east-1", "S3 region")
+	cmd.Flags().StringVar(&backupConfig.S3.Bucket, "backup-s3-bucket", "", "S3 bucket for backups")
+	cmd.Flags().StringVar(&backupConfig.S3.AccessKey, "backup-s3-access-key", os.Getenv("BACKUP_S3_ACCESS_KEY"), "S3 access key. Defaults to BACKUP_S3_ACCESS_KEY")
+	cmd.Flags().StringVar(&backupConfig.S3.SecretKey, "backup-s3-secret-key", os.Getenv("BACKUP_S3_SECRET_KEY"), "S3 secret key. Defaults to BACKUP_S3_SECRET_KEY")
+}
+
+// Get backup manager from the flags. Encrypted backups are opened with the master key of user DBs
+func backupManager(store *userdb.Store) *backups.Manager {
+	backend, err := backups.NewBackend(backupConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	manager := backups.New(backend, backups.Retention{})
+	if store.Encrypted() {
+		manager.Sealer = store
+	}
+
+	return manager
+}
+
+// Get users by the file ids their backups are kept under. Backups taken before file ids were keyed use the unkeyed id
+func usersByFileID(store *userdb.Store) map[string]string {
+	emails, err := Repo.CtrlDB.GetUserEmails()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	users := make(map[string]string, 2*len(emails))
+	for _, email := range emails {
+		users[store.FileID(email)] = email
+		users[userdb.LegacyFileID(email)] = email
+	}
+
+	return users
+}
+
+var usersBackupsCmd = &cobra.Command{
+	Use:   "backups",
+	Short: "View user backups",
+	Long:  `View user backups`,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("list\t\t\tlist backups of all users or of one user")
+		fmt.Print("\n\n")
+	},
+}
+
+var usersBackupsListCmd = &cobra.Command{
+	Use:   "list [email]",
+	Short: "List backups of all users or of one user",
+	Long:  `List backups of all users or of one user, oldest first`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		// Get user. Backups are kept under the file id of the user DB
+		store := userDBs()
+		user := ""
+		if len(args) == 1 {
+			user = store.FileID(args[0])
+		}
+
+		// Setup new context
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		// Get backups
+		snapshots, err := backupManager(store).List(ctx, user)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(snapshots) == 0 {
+			fmt.Println("There are no backups.")
+			return
+		}
+
+		// Show emails instead of file ids. Backups of removed users keep the id
+		users := usersByFileID(store)
+		for _, snapshot := range snapshots {
+			email, ok := users[snapshot.User]
+			if !ok {
+				email = snapshot.User
+			}
+
+			encrypted := ""
+			if snapshot.Sealed {
+				encrypted = "\tencrypted"
+			}
+
+			fmt.Printf("%s\t%s\t%d KB%s\n", email, snapshot.Time.Local().Format("2006-01-02 15:04:05"), (snapshot.Size+1023)/1024, encrypted)
+		}
+
+		fmt.Printf("\nBackups: %d\n\n", len(snapshots))
+	},
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/repository/dbrepo"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	usersCmd.AddCommand(usersResetTwoFactorCmd)
+}
+
+var usersResetTwoFactorCmd = &cobra.Command{
+	Use:   "reset-2fa <email>",
+	Short: "Turn off two-factor authentication of a user",
+	Long: `Turn off two-factor authentication of a user who lost their authenticator and recovery codes.
+The secret and recovery codes are removed, so the user logs in with the password only until they set it up again.
+User DBs are opened from the db path, so run it on the host of the DB Node.
+Encrypted DBs need the master key of the DB Node and can't be changed while the DB Node has them open.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		user := args[0]
+
+		// User DB must be on this host
+		store := userDBs()
+		id := store.FileID(user)
+		if !store.Exists(id) {
+			fmt.Printf("User DB of %s not found\n\n", user)
+			return
+		}
+
+		// Confirm reset
+		confirmPrompt := promptui.Prompt{
+			Label:     fmt.Sprintf("Turn off two-factor authentication of %s", user),
+			IsConfirm: true,
+		}
+		_, err := confirmPrompt.Run()
+		if err != nil {
+			fmt.Println("Reset cancelled")
+			return
+		}
+
+		// Open user DB
+		dbconn, err := store.Open(id)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Reset two-factor authentication
+		repo := dbrepo.NewSqliteRepo(nil, id, dbconn.SQL)
+		_, err = repo.ResetTwoFactor(nil)
+		if err != nil {
+			store.Close(id, dbconn)
+			log.Fatal(err)
+		}
+
+		// Close user DB. Encrypted DBs are written back
+		err = store.Close(id, dbconn)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("Two-factor authentication of %s turned off\n\n", user)
+	},
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/attachments"
+	"github.com/dimitargrozev5/expenses-go-1/internal/backups"
+	"github.com/dimitargrozev5/expenses-go-1/internal/userdb"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var restoreAt string
+
+// Accepted formats of the restore time. Times without a zone are local
+var restoreTimeFormats = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+func init() {
+	usersCmd.AddCommand(usersRestoreCmd)
+	usersRestoreCmd.Flags().StringVar(&restoreAt, "at", "", "Restore the last backup taken at or before this time (2006-01-02 15:04)")
+	usersRestoreCmd.MarkFlagRequired("at")
+	addBackupFlags(usersRestoreCmd)
+}
+
+var usersRestoreCmd = &cobra.Command{
+	Use:   "restore <email>",
+	Short: "Restore user DB from a backup",
+	Long: `Restore user DB from the last backup taken at or before a time.
+The current DB is backed up first, so the restore can be reverted.
+User DBs are opened from the db path, so run it on the host of the DB Node.
+Encrypted DBs need the master key of the DB Node and can't be restored while the DB Node has them open.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		user := args[0]
+
+		// Get restore time
+		at, err := parseRestoreTime(restoreAt)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// User DB must be on this host
+		store := userDBs()
+		id := store.FileID(user)
+		if !store.Exists(id) {
+			fmt.Printf("User DB of %s not found\n\n", user)
+			return
+		}
+
+		// Setup new context
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
+
+		// Get backup. Backups taken before file ids were keyed are kept under the unkeyed id,
+		// and backups taken before DBs were named by file id under the email
+		manager := backupManager(store)
+		snapshot, err := manager.Find(ctx, id, at)
+		if errors.Is(err, backups.ErrNotFound) {
+			snapshot, err = manager.Find(ctx, userdb.LegacyFileID(user), at)
+		}
+		if errors.Is(err, backups.ErrNotFound) {
+			snapshot, err = manager.Find(ctx, user, at)
+		}
+		if errors.Is(err, backups.ErrNotFound) {
+			fmt.Printf("There is no backup of %s at or before %s\n\n", user, at.Format("2006-01-02 15:04:05"))
+			return
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Confirm restore
+		confirmPrompt := promptui.Prompt{
+			Label:     fmt.Sprintf("Restore %s to the backup from %s", user, snapshot.Time.Local().Format("2006-01-02 15:04:05")),
+			IsConfirm: true,
+		}
+		_, err = confirmPrompt.Run()
+		if err != nil {
+			fmt.Println("Restore cancelled")
+			return
+		}
+
+		// Open user DB
+		dbconn, err := store.Open(id)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Back up current state
+		files := attachments.New(userdb.FilesPath(store.Dir, id))
+		current, err := manager.Backup(ctx, id, dbconn.SQL, files)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Current DB backed up as %s\n", current.Key)
+
+		// Restore backup
+		err = manager.Restore(ctx, snapshot, dbconn.SQL, files)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Get restored version. It may need migrating
+		var version int64
+		err = dbconn.SQL.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Close user DB. Encrypted DBs are written back
+		err = store.Close(id, dbconn)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("Restored %s from %s\n", user, snapshot.Key)
+		fmt.Printf("User DB Version: %d\n\n", version)
+	},
+}
+
+// Parse restore time in one of the accepted formats
+func parseRestoreTime(s string) (time.Time, error) {
+	for _, format := range restoreTimeFormats {
+		t, err := time.ParseInLocation(format, s, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
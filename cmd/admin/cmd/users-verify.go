@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"github.com/dimitargrozev5/expenses-go-1/internal/repository/dbrepo"
+	"github.com/dimitargrozev5/expenses-go-1/internal/userdb"
+	"github.com/spf13/cobra"
+)
+
+var verifyFix bool
+
+func init() {
+	usersCmd.AddCommand(usersVerifyCmd)
+	usersVerifyCmd.Flags().BoolVar(&verifyFix, "fix", false, "Overwrite drifted values with the recomputed ones")
+}
+
+var usersVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check cached amounts of every user",
+	Long: `Recompute account and category amounts, spending left, tag usage counts and free funds
+from the records of every user and report the values that drifted.
+With --fix the values are corrected in a transaction and every change is written to the user audit log.
+User DBs are opened from the db path, so run it on the host of the DB Node.
+Encrypted DBs need the master key of the DB Node and can't be verified while the DB Node has them open.`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		// Get users
+		emails, err := Repo.CtrlDB.GetUserEmails()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(emails) == 0 {
+			fmt.Println("There are no users.")
+			return
+		}
+
+		// Count users with drift
+		store := userDBs()
+		drifted := 0
+		for _, email := range emails {
+			fmt.Printf("\n%s\n", email)
+
+			// Skip users whose DB isn't on this host
+			id := store.FileID(email)
+			if !store.Exists(id) {
+				fmt.Println("\tuser db not found")
+				continue
+			}
+
+			// Verify user DB
+			ret, err := verifyUser(store, id)
+			if err != nil {
+				fmt.Printf("\tverification failed: %s\n", err)
+				continue
+			}
+
+			if len(ret.Drifts) == 0 {
+				fmt.Println("\tok")
+				continue
+			}
+
+			drifted++
+			for _, drift := range ret.Drifts {
+				fmt.Printf("\t%s #%d %s\t%s: %s, expected %s\n", drift.Entity, drift.EntityId, drift.Name, drift.Field, driftValue(drift, drift.Stored), driftValue(drift, drift.Expected))
+			}
+
+			if ret.Fixed {
+				fmt.Printf("\tfixed %d values\n", len(ret.Drifts))
+			}
+		}
+
+		fmt.Printf("\nUsers with drift: %d of %d\n\n", drifted, len(emails))
+	},
+}
+
+// Verify a single user DB
+func verifyUser(store *userdb.Store, id string) (*models.VerifyIntegrityReturns, error) {
+	// Open own connection
+	dbconn, err := store.Open(id)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := dbrepo.NewSqliteRepo(nil, id, dbconn.SQL)
+	ret, err := repo.VerifyIntegrity(&models.VerifyIntegrityParams{Fix: verifyFix})
+
+	// Encrypted DBs are written back on close
+	closeErr := store.Close(id, dbconn)
+	if err == nil && closeErr != nil {
+		return nil, closeErr
+	}
+
+	return ret, err
+}
+
+// Format a drifted value. Counts have no currency
+func driftValue(drift *models.GrpcDrift, value int64) string {
+	if drift.Currency == "" {
+		return fmt.Sprintf("%d", value)
+	}
+	return money.New(value, drift.Currency).String()
+}
@@ -2,12 +2,38 @@ package cmd
 
 import (
 	"fmt"
+	"log"
+	"os"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/userdb"
 	"github.com/spf13/cobra"
 )
 
+// File with the master key of user DBs. It matches the flag of the DB Node
+var masterKeyFile string
+
 func init() {
 	rootCmd.AddCommand(usersCmd)
+	usersCmd.PersistentFlags().StringVar(&masterKeyFile, "master-key-file", os.Getenv("DB_MASTER_KEY_FILE"), "File with the key that encrypts user DBs. Defaults to DB_MASTER_KEY_FILE")
+}
+
+// Get user DB store from the flags
+func userDBs() *userdb.Store {
+	var masterKey []byte
+	if masterKeyFile != "" {
+		var err error
+		masterKey, err = userdb.ReadMasterKey(masterKeyFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	store, err := userdb.New(Repo.DBPath, masterKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return store
 }
 
 var usersCmd = &cobra.Command{
@@ -16,6 +42,10 @@ var usersCmd = &cobra.Command{
 	Long:  `Control Users`,
 	Run: func(cmd *cobra.Command, args []string) {
 		fmt.Println("add\t\t\tadd new user")
+		fmt.Println("verify\t\t\tcheck cached amounts of every user")
+		fmt.Println("backups\t\t\tview user backups")
+		fmt.Println("restore\t\t\trestore user db from a backup")
+		fmt.Println("reset-2fa\t\tturn off two-factor authentication of a user")
 		fmt.Print("\n\n")
 	},
 }
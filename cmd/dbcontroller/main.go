@@ -1,7 +1,14 @@
 package main
 
 import (
+	"context"
+	"log"
+	"os/signal"
+	"syscall"
+
 	"github.com/dimitargrozev5/expenses-go-1/internal/config"
+	"github.com/dimitargrozev5/expenses-go-1/internal/tracing"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // Init app config
@@ -12,12 +19,32 @@ func main() {
 	// Setup app
 	setupAppState()
 
+	// Setup tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), "dbcontroller", *otelExporter)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracing(context.Background())
+
 	// Setup and connect to DB
 	setupDb()
 
 	// Close db connection on exit
 	defer app.CtrlDB.Close()
 
+	// Serve metrics
+	metricsServer := setupMetrics()
+	defer metricsServer.Close()
+
 	// Setup grpc service
-	setupGrpcService()
+	grpcServer, healthServer := setupGrpcService()
+	healthServer.SetServingStatus("Database", healthpb.HealthCheckResponse_SERVING)
+
+	// Wait for interrupt
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+
+	// Stop accepting work
+	shutdown(grpcServer, healthServer)
 }
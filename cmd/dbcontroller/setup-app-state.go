@@ -2,22 +2,22 @@ package main
 
 import (
 	"flag"
-	"log"
+	"log/slog"
 	"os"
 
-	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+	"github.com/dimitargrozev5/expenses-go-1/internal/config"
 	"github.com/dimitargrozev5/expenses-go-1/internal/jwtutil"
-	"github.com/dimitargrozev5/expenses-go-1/internal/repository"
+	"github.com/dimitargrozev5/expenses-go-1/internal/logging"
+	"github.com/dimitargrozev5/expenses-go-1/internal/ratelimit"
 )
 
-var dbConn = map[string]*driver.DB{}
-var dbRepo = map[string]repository.DatabaseRepo{}
-var infoLog *log.Logger
-var errorLog *log.Logger
 var dbPath = flag.String("db-path", "./db/", "Path to folder containing sqlite databases")
 var migrationsPath = flag.String("migrations-path", "./migrations/", "Path to folder containing sqlite migrations")
 var jwtSecretKey = flag.String("jwt-secret-key", "secret key", "Secret key for signing Json Web Tokens")
 var dbCtrlName = flag.String("db-name", "ctrl.db", "Controller DB name")
+var rateLimit = flag.Float64("rate-limit", 10, "Requests per second allowed to every user")
+var rateBurst = flag.Int("rate-burst", 50, "Requests a user can make at once before the rate limit applies")
+var otelExporter = flag.String("otel-exporter", "", "File or OpenTelemetry collector URL (http://localhost:4318) to export spans to")
 
 // Setup app wide state
 func setupAppState() {
@@ -28,17 +28,15 @@ func setupAppState() {
 	// Set in production
 	app.InProduction = false
 
-	// Set info log
-	infoLog = log.New(os.Stdout, "INFO:\t", log.Ldate|log.Ltime)
-	app.InfoLog = infoLog
-
-	// Set error log
-	errorLog = log.New(os.Stdout, "ERROR:\t", log.Ldate|log.Ltime|log.Lshortfile)
-	app.ErrorLog = errorLog
+	// Set logger
+	app.Logger = logging.New(os.Stdout, "dbcontroller")
+	slog.SetDefault(app.Logger)
 
 	// Set DB connections
-	app.DBConnections = dbConn
-	app.DBRepos = dbRepo
+	app.OpenDBs = config.NewOpenDBs()
+
+	// Set per user rate limiter
+	app.RateLimiter = ratelimit.New(*rateLimit, *rateBurst)
 
 	// Set db path and name
 	app.DBPath = *dbPath
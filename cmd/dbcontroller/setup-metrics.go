@@ -0,0 +1,92 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var metricsAddr = flag.String("metrics-addr", "localhost:9102", "Address to serve Prometheus metrics on")
+
+// Reports cluster state from the controller DB
+type clusterCollector struct {
+	nodes               *prometheus.Desc
+	activeNodes         *prometheus.Desc
+	users               *prometheus.Desc
+	usersByNode         *prometheus.Desc
+	usersByDBVersion    *prometheus.Desc
+	activeSessions      *prometheus.Desc
+	migrationVersion    *prometheus.Desc
+	migrationsAvailable *prometheus.Desc
+}
+
+func newClusterCollector() *clusterCollector {
+	return &clusterCollector{
+		nodes:               prometheus.NewDesc("cluster_nodes", "Number of DB Nodes.", nil, nil),
+		activeNodes:         prometheus.NewDesc("cluster_active_nodes", "Number of DB Nodes with a registered address.", nil, nil),
+		users:               prometheus.NewDesc("cluster_users", "Number of users by status.", []string{"status"}, nil),
+		usersByNode:         prometheus.NewDesc("cluster_node_users", "Number of users assigned to a DB Node.", []string{"node"}, nil),
+		usersByDBVersion:    prometheus.NewDesc("cluster_users_by_db_version", "Number of users by user DB version.", []string{"version"}, nil),
+		activeSessions:      prometheus.NewDesc("cluster_active_sessions", "Number of active user sessions.", nil, nil),
+		migrationVersion:    prometheus.NewDesc("ctrl_db_migration_version", "Migration version of the controller DB.", nil, nil),
+		migrationsAvailable: prometheus.NewDesc("ctrl_db_migrations_available", "Number of controller DB migrations found on disk.", nil, nil),
+	}
+}
+
+func (c *clusterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.nodes
+	ch <- c.activeNodes
+	ch <- c.users
+	ch <- c.usersByNode
+	ch <- c.usersByDBVersion
+	ch <- c.activeSessions
+	ch <- c.migrationVersion
+	ch <- c.migrationsAvailable
+}
+
+func (c *clusterCollector) Collect(ch chan<- prometheus.Metric) {
+	// Get migrations
+	version, err := app.CtrlDBRepo.GetVersion()
+	if err == nil {
+		ch <- prometheus.MustNewConstMetric(c.migrationVersion, prometheus.GaugeValue, float64(version))
+	}
+
+	files, err := filepath.Glob(filepath.Join(app.MigrationsPath, "ctrl-*-up.sql"))
+	if err == nil {
+		ch <- prometheus.MustNewConstMetric(c.migrationsAvailable, prometheus.GaugeValue, float64(len(files)))
+	}
+
+	// Get cluster state
+	stats, err := app.CtrlDBRepo.GetClusterStats()
+	if err != nil {
+		app.Logger.Error("failed to collect cluster stats", "error", err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.nodes, prometheus.GaugeValue, float64(stats.Nodes))
+	ch <- prometheus.MustNewConstMetric(c.activeNodes, prometheus.GaugeValue, float64(stats.ActiveNodes))
+	ch <- prometheus.MustNewConstMetric(c.activeSessions, prometheus.GaugeValue, float64(stats.ActiveSessions))
+
+	for status, count := range stats.UsersByStatus {
+		ch <- prometheus.MustNewConstMetric(c.users, prometheus.GaugeValue, float64(count), status)
+	}
+	for node, count := range stats.UsersByNode {
+		ch <- prometheus.MustNewConstMetric(c.usersByNode, prometheus.GaugeValue, float64(count), strconv.FormatInt(node, 10))
+	}
+	for version, count := range stats.UsersByDBVersion {
+		ch <- prometheus.MustNewConstMetric(c.usersByDBVersion, prometheus.GaugeValue, float64(count), strconv.FormatInt(version, 10))
+	}
+}
+
+func setupMetrics() *http.Server {
+	metrics.MustRegister(
+		newClusterCollector(),
+		metrics.NewDBFilesCollector(app.DBPath),
+	)
+
+	return metrics.Serve(*metricsAddr, app.Logger)
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+)
+
+var shutdownTimeout = flag.Duration("shutdown-timeout", 15*time.Second, "Time to wait for in-flight requests on shutdown")
+
+// Stop accepting work and drain RPCs
+func shutdown(grpcServer *grpc.Server, healthServer *health.Server) {
+	app.Logger.Info("shutting down")
+
+	// Report not serving to clients and load balancers
+	healthServer.Shutdown()
+
+	// Wait for in-flight RPCs
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(*shutdownTimeout):
+		app.Logger.Warn("shutdown timed out, cancelling in-flight RPCs")
+		grpcServer.Stop()
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/repository/dbrepo"
+)
+
+var autoResetInterval = flag.Duration("auto-reset-interval", time.Minute, "Interval between automatic category reset runs")
+
+// Run automatic category resets until context is done
+func watchAutoResets(ctx context.Context) {
+	ticker := time.NewTicker(*autoResetInterval)
+	defer ticker.Stop()
+
+	for {
+		runAutoResets(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run automatic resets on every user DB in the DB folder. Users don't have to be logged in
+func runAutoResets(ctx context.Context) {
+	// Get user DBs
+	ids, err := app.UserDBs.IDs()
+	if err != nil {
+		app.Logger.Error("can't list user dbs", "error", err)
+		return
+	}
+
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+
+		autoResetUser(id)
+	}
+}
+
+// Run automatic resets on a single user DB. Users are logged by the file id of their DB
+func autoResetUser(user string) {
+	// Open own connection. Sqlite handles locking with open user connections
+	dbconn, err := app.UserDBs.Open(user)
+	if err != nil {
+		app.Logger.Error("can't open user db", "user", user, "error", err)
+		return
+	}
+	defer func() {
+		err := app.UserDBs.Close(user, dbconn)
+		if err != nil {
+			app.Logger.Error("can't close user db", "user", user, "error", err)
+		}
+	}()
+
+	// Run resets
+	repo := dbrepo.NewSqliteRepo(&app, user, dbconn.SQL)
+	reset, due, err := repo.AutoResetCategories(time.Now())
+	if err != nil {
+		app.Logger.Error("automatic category reset failed", "user", user, "error", err)
+		return
+	}
+
+	if reset > 0 || due > 0 {
+		app.Logger.Info("automatic category reset", "user", user, "reset", reset, "due", due)
+	}
+}
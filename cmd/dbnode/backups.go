@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/attachments"
+	"github.com/dimitargrozev5/expenses-go-1/internal/backups"
+	"github.com/dimitargrozev5/expenses-go-1/internal/userdb"
+)
+
+var backupInterval = flag.Duration("backup-interval", time.Hour, "Interval between user DB backups. Zero disables backups")
+var backupBackend = flag.String("backup-backend", "local", "Backup storage: local or s3")
+var backupDir = flag.String("backup-dir", "./backups/", "Folder for local backups")
+var backupS3Endpoint = flag.String("backup-s3-endpoint", "", "S3 compatible server URL (http://localhost:9000 for MinIO)")
+var backupS3Region = flag.String("backup-s3-region", "us-east-1", "S3 region")
+var backupS3Bucket = flag.String("backup-s3-bucket", "", "S3 bucket for backups")
+var backupS3AccessKey = flag.String("backup-s3-access-key", os.Getenv("BACKUP_S3_ACCESS_KEY"), "S3 access key. Defaults to BACKUP_S3_ACCESS_KEY")
+var backupS3SecretKey = flag.String("backup-s3-secret-key", os.Getenv("BACKUP_S3_SECRET_KEY"), "S3 secret key. Defaults to BACKUP_S3_SECRET_KEY")
+var backupKeep = flag.Int("backup-keep", 24, "Number of latest backups kept per user")
+var backupDays = flag.Int("backup-days", 30, "Number of days whose last backup is kept per user")
+
+// Create backup manager from the flags
+func setupBackups() (*backups.Manager, error) {
+	backend, err := backups.NewBackend(backups.Config{
+		Backend: *backupBackend,
+		Dir:     *backupDir,
+		S3: backups.S3Config{
+			Endpoint:  *backupS3Endpoint,
+			Region:    *backupS3Region,
+			Bucket:    *backupS3Bucket,
+			AccessKey: *backupS3AccessKey,
+			SecretKey: *backupS3SecretKey,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	manager := backups.New(backend, backups.Retention{Keep: *backupKeep, Days: *backupDays})
+
+	// Encrypt backups of encrypted DBs
+	if app.UserDBs.Encrypted() {
+		manager.Sealer = app.UserDBs
+	}
+
+	return manager, nil
+}
+
+// Back up user DBs until context is done
+func watchBackups(ctx context.Context, manager *backups.Manager) {
+	ticker := time.NewTicker(*backupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		runBackups(ctx, manager)
+	}
+}
+
+// Back up every user DB in the DB folder and remove backups past retention
+func runBackups(ctx context.Context, manager *backups.Manager) {
+	// Get user DBs
+	ids, err := app.UserDBs.IDs()
+	if err != nil {
+		app.Logger.Error("can't list user dbs", "error", err)
+		return
+	}
+
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+
+		backupUser(ctx, manager, id)
+	}
+
+	// Remove old backups
+	removed, err := manager.Prune(ctx, "")
+	if err != nil {
+		app.Logger.Error("can't remove old backups", "error", err)
+	}
+	if len(removed) > 0 {
+		app.Logger.Info("removed old backups", "count", len(removed))
+	}
+}
+
+// Back up a single user DB. Backups are kept under the file id of the DB
+func backupUser(ctx context.Context, manager *backups.Manager, user string) {
+	// Open own connection. The backup API copies pages between writes of open user connections
+	dbconn, err := app.UserDBs.Open(user)
+	if err != nil {
+		app.Logger.Error("can't open user db", "user", user, "error", err)
+		return
+	}
+	defer func() {
+		err := app.UserDBs.Close(user, dbconn)
+		if err != nil {
+			app.Logger.Error("can't close user db", "user", user, "error", err)
+		}
+	}()
+
+	files := attachments.New(userdb.FilesPath(app.DBPath, user))
+	snapshot, err := manager.Backup(ctx, user, dbconn.SQL, files)
+	if err != nil {
+		app.Logger.Error("backup failed", "user", user, "error", err)
+		return
+	}
+
+	app.Logger.Info("backup", "user", user, "key", snapshot.Key, "size", snapshot.Size)
+}
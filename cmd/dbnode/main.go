@@ -1,6 +1,14 @@
 package main
 
-import "github.com/dimitargrozev5/expenses-go-1/internal/config"
+import (
+	"context"
+	"log"
+	"os/signal"
+	"syscall"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/config"
+	"github.com/dimitargrozev5/expenses-go-1/internal/tracing"
+)
 
 var app config.DBNodeConfig
 
@@ -9,9 +17,56 @@ func main() {
 	// Setup app state
 	setupAppState()
 
+	// Setup user DBs
+	err := setupUserDBs()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Setup tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), "dbnode", *otelExporter)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracing(context.Background())
+
+	// Setup backups
+	backupManager, err := setupBackups()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Serve metrics
+	metricsServer := setupMetrics()
+	defer metricsServer.Close()
+
+	// Connect to db controller
+	setupCtrlClient()
+	defer ctrlConn.Close()
+
 	// Register with db controller
 	registerDBNode()
 
 	// Start gRPC server
-	setupGrpcService()
+	grpcServer, healthServer := setupGrpcService()
+
+	// Wait for interrupt
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Report health
+	go watchHealth(ctx, healthServer)
+
+	// Reset categories whose period has ended
+	go watchAutoResets(ctx)
+
+	// Back up user DBs
+	if *backupInterval > 0 {
+		go watchBackups(ctx, backupManager)
+	}
+
+	<-ctx.Done()
+
+	// Stop accepting work and close user DBs
+	shutdown(grpcServer, healthServer)
 }
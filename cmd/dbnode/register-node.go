@@ -2,50 +2,32 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
-	"github.com/dimitargrozev5/expenses-go-1/internal/jwtutil"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 	"github.com/dimitargrozev5/expenses-go-1/internal/sysinfo"
-	"github.com/golang-jwt/jwt/v5"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/metadata"
 )
 
 func registerDBNode() {
 
-	// Open connection to DB Controller
-	var opts = []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	}
-
-	conn, err := grpc.NewClient(app.ControllerAddress, opts...)
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer conn.Close()
-
-	// Create gRPC client
-	client := models.NewDatabaseClient(conn)
-
 	// Get system info
 	props := sysinfo.Overview()
 
-	// Create jwt
-	jwt, err := jwtutil.Repo.Generate(jwt.MapClaims{})
+	// Register node
+	_, err := app.CtrlClient.RegisterNode(context.Background(), &props)
 	if err != nil {
 		log.Fatal(err)
 	}
+}
 
-	// Create context with metadata
-	md := metadata.Pairs("authorization", fmt.Sprintf("Bearer %s", jwt))
-	ctxWithMeta := metadata.NewOutgoingContext(context.Background(), md)
+func deregisterDBNode() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
 
-	// Register node
-	_, err = client.RegisterNode(ctxWithMeta, &props)
+	// Deregister node
+	_, err := app.CtrlClient.DeregisterNode(ctx, &models.DBNodeData{ID: app.NodeID})
 	if err != nil {
-		log.Fatal(err)
+		app.Logger.Error("failed to deregister node", "error", err)
 	}
 }
@@ -2,23 +2,21 @@ package main
 
 import (
 	"flag"
-	"log"
+	"log/slog"
 	"os"
 
-	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+	"github.com/dimitargrozev5/expenses-go-1/internal/config"
 	"github.com/dimitargrozev5/expenses-go-1/internal/jwtutil"
-	"github.com/dimitargrozev5/expenses-go-1/internal/repository"
+	"github.com/dimitargrozev5/expenses-go-1/internal/logging"
 	"github.com/dimitargrozev5/expenses-go-1/internal/sysinfo"
 )
 
-var dbConn = map[string]*driver.DB{}
-var dbRepo = map[string]repository.DatabaseRepo{}
-var infoLog *log.Logger
-var errorLog *log.Logger
 var id = flag.Int64("node-id", 0, "Node ID from the Controller DB")
 var dbPath = flag.String("db-path", "./db/", "Path to folder containing sqlite databases")
 var jwtSecretKey = flag.String("jwt-secret-key", "secret key", "Secret key for signing Json Web Tokens")
 var ctrlAddr = flag.String("ctrl-addr", "localhost:3002", "DB Controller address")
+var otelExporter = flag.String("otel-exporter", "", "File or OpenTelemetry collector URL (http://localhost:4318) to export spans to")
+var attachmentQuota = flag.Int64("attachment-quota", 50, "Attachment storage per user in MB")
 
 // Setup app wide state
 func setupAppState() {
@@ -29,21 +27,19 @@ func setupAppState() {
 	// Set in production
 	app.InProduction = false
 
-	// Set info log
-	infoLog = log.New(os.Stdout, "INFO:\t", log.Ldate|log.Ltime)
-	app.InfoLog = infoLog
-
-	// Set error log
-	errorLog = log.New(os.Stdout, "ERROR:\t", log.Ldate|log.Ltime|log.Lshortfile)
-	app.ErrorLog = errorLog
+	// Set logger
+	app.Logger = logging.New(os.Stdout, "dbnode")
+	slog.SetDefault(app.Logger)
 
 	// Set DB connections
-	app.DBConnections = dbConn
-	app.DBRepos = dbRepo
+	app.OpenDBs = config.NewOpenDBs()
 
 	// Set db path and name
 	app.DBPath = *dbPath
 
+	// Set attachment quota
+	app.AttachmentQuota = *attachmentQuota * 1024 * 1024
+
 	// Set controller address
 	app.ControllerAddress = *ctrlAddr
 
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/jwtutil"
+	"github.com/dimitargrozev5/expenses-go-1/internal/logging"
+	"github.com/dimitargrozev5/expenses-go-1/internal/metrics"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/tracing"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+var ctrlConn *grpc.ClientConn
+
+// Open a connection to the DB Controller that stays open for the life of the node
+func setupCtrlClient() {
+
+	// Create jwt
+	nodeToken, err := jwtutil.Repo.Generate(jwt.MapClaims{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Add node token and request details to every call
+	nodeInterceptor := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := tracing.StartClientSpan(ctx, method)
+
+		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", fmt.Sprintf("Bearer %s", nodeToken)))
+		ctx = tracing.Inject(logging.OutgoingContext(ctx))
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		tracing.EndRPCSpan(span, err)
+
+		return err
+	}
+
+	// Open connection to DB Controller
+	var opts = []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor, nodeInterceptor),
+	}
+
+	ctrlConn, err = grpc.NewClient(app.ControllerAddress, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create gRPC client
+	app.CtrlClient = models.NewDatabaseClient(ctrlConn)
+}
@@ -7,8 +7,11 @@ import (
 	"net"
 
 	"github.com/dimitargrozev5/expenses-go-1/internal/dbnoderpc"
+	"github.com/dimitargrozev5/expenses-go-1/internal/metrics"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var (
@@ -19,7 +22,7 @@ var (
 	port       = flag.Int("port", 3003, "The server port")
 )
 
-func setupGrpcService() {
+func setupGrpcService() (*grpc.Server, *health.Server) {
 
 	// Start listening on specified port
 	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", *port))
@@ -49,8 +52,21 @@ func setupGrpcService() {
 	// Register server
 	dbnoderpc.NewDatabaseServer(databaseServer)
 
-	// Add JWT token interceptor
-	opts = append(opts, grpc.UnaryInterceptor(dbnoderpc.Server.AuthInterceptor))
+	// Add metrics, logging, recovery, JWT token and user db flush interceptors
+	opts = append(opts, grpc.ChainUnaryInterceptor(
+		metrics.UnaryServerInterceptor,
+		dbnoderpc.Server.LoggingInterceptor,
+		dbnoderpc.Server.RecoveryInterceptor,
+		dbnoderpc.Server.AuthInterceptor,
+		dbnoderpc.Server.FlushInterceptor,
+	))
+	opts = append(opts, grpc.ChainStreamInterceptor(
+		metrics.StreamServerInterceptor,
+		dbnoderpc.Server.LoggingStreamInterceptor,
+		dbnoderpc.Server.RecoveryStreamInterceptor,
+		dbnoderpc.Server.AuthStreamInterceptor,
+		dbnoderpc.Server.FlushStreamInterceptor,
+	))
 
 	// Create server
 	grpcServer := grpc.NewServer(opts...)
@@ -58,7 +74,18 @@ func setupGrpcService() {
 	// Register server
 	models.RegisterDatabaseServer(grpcServer, databaseServer)
 
+	// Register health service
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
 	// Start grpc server
-	fmt.Printf("Starting gRPC server on port %d", *port)
-	grpcServer.Serve(lis)
+	app.Logger.Info("starting gRPC server", "port", *port)
+	go func() {
+		err := grpcServer.Serve(lis)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	return grpcServer, healthServer
 }
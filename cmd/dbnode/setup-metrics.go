@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+	"strconv"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var metricsAddr = flag.String("metrics-addr", "localhost:9103", "Address to serve Prometheus metrics on")
+
+// Reports per-user connections held by the node
+type userDBCollector struct {
+	openConnections *prometheus.Desc
+	dbVersions      *prometheus.Desc
+}
+
+func newUserDBCollector() *userDBCollector {
+	return &userDBCollector{
+		openConnections: prometheus.NewDesc("node_open_user_connections", "Number of open user DB connections.", nil, nil),
+		dbVersions:      prometheus.NewDesc("node_open_user_dbs_by_version", "Number of open user DBs by DB version.", []string{"version"}, nil),
+	}
+}
+
+func (c *userDBCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.openConnections
+	ch <- c.dbVersions
+}
+
+func (c *userDBCollector) Collect(ch chan<- prometheus.Metric) {
+	dbs := app.OpenDBs.All()
+	ch <- prometheus.MustNewConstMetric(c.openConnections, prometheus.GaugeValue, float64(len(dbs)))
+
+	// Count migration versions of open user DBs
+	versions := map[int64]int{}
+	for _, db := range dbs {
+		user, err := db.Repo.GetUser(nil)
+		if err != nil {
+			continue
+		}
+		versions[user.DBVersion]++
+	}
+
+	for version, count := range versions {
+		ch <- prometheus.MustNewConstMetric(c.dbVersions, prometheus.GaugeValue, float64(count), strconv.FormatInt(version, 10))
+	}
+}
+
+func setupMetrics() *http.Server {
+	metrics.MustRegister(
+		newUserDBCollector(),
+		metrics.NewDBFilesCollector(app.DBPath),
+		metrics.NewSysinfoCollector(),
+	)
+
+	return metrics.Serve(*metricsAddr, app.Logger)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/userdb"
+)
+
+var masterKeyFile = flag.String("master-key-file", os.Getenv("DB_MASTER_KEY_FILE"), "File with the hex encoded 32 byte key that encrypts user DBs. Empty keeps user DBs unencrypted. Defaults to DB_MASTER_KEY_FILE")
+
+// Open the user DB store and migrate the DB folder to it.
+// DBs named after emails or after file ids of another key are renamed, and plain DBs are encrypted when there's a master key
+func setupUserDBs() error {
+	// Get master key
+	var masterKey []byte
+	if *masterKeyFile != "" {
+		var err error
+		masterKey, err = userdb.ReadMasterKey(*masterKeyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	var err error
+	app.UserDBs, err = userdb.New(app.DBPath, masterKey)
+	if err != nil {
+		return err
+	}
+
+	// Migrate DB folder
+	renamed, encrypted, err := app.UserDBs.Migrate()
+	if err != nil {
+		return err
+	}
+	if renamed > 0 || encrypted > 0 {
+		app.Logger.Info("migrated user dbs", "renamed", renamed, "encrypted", encrypted)
+	}
+
+	app.Logger.Info("user dbs", "encrypted", app.UserDBs.Encrypted())
+	return nil
+}
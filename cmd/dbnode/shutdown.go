@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var shutdownTimeout = flag.Duration("shutdown-timeout", 15*time.Second, "Time to wait for in-flight requests on shutdown")
+var healthInterval = flag.Duration("health-interval", 10*time.Second, "Interval between node health checks")
+
+// Check node health until context is done
+func watchHealth(ctx context.Context, healthServer *health.Server) {
+	ticker := time.NewTicker(*healthInterval)
+	defer ticker.Stop()
+
+	for {
+		status := checkHealth(ctx)
+		healthServer.SetServingStatus("", status)
+		healthServer.SetServingStatus("Database", status)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Node is healthy when it can access the DB folder and reach the DB Controller
+func checkHealth(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	// Check db folder
+	_, err := os.ReadDir(app.DBPath)
+	if err != nil {
+		app.Logger.Error("can't access db folder", "error", err)
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	// Check controller
+	checkCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	res, err := healthpb.NewHealthClient(ctrlConn).Check(checkCtx, &healthpb.HealthCheckRequest{})
+	if err != nil || res.Status != healthpb.HealthCheckResponse_SERVING {
+		app.Logger.Error("can't reach db controller", "error", err)
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+// Stop accepting work, drain RPCs and close user DBs
+func shutdown(grpcServer *grpc.Server, healthServer *health.Server) {
+	app.Logger.Info("shutting down")
+
+	// Report not serving to clients and load balancers
+	healthServer.Shutdown()
+
+	// Stop getting new users from the controller
+	deregisterDBNode()
+
+	// Wait for in-flight RPCs
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(*shutdownTimeout):
+		app.Logger.Warn("shutdown timed out, cancelling in-flight RPCs")
+		grpcServer.Stop()
+	}
+
+	// Close user DBs. Encrypted DBs are written back
+	for userKey, db := range app.OpenDBs.RemoveAll() {
+		err := app.UserDBs.Close(app.UserDBs.FileID(userKey), db.Conn)
+		if err != nil {
+			app.Logger.Error("failed to close user db", "userKey", userKey, "error", err)
+		}
+	}
+}
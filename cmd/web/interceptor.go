@@ -3,27 +3,125 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/logging"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
-// unaryInterceptor is an example unary interceptor.
+// Log and trace calls to the DB services
+func loggingInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+
+	// Start call span and pass request details on
+	ctx, span := tracing.StartClientSpan(ctx, method)
+	ctx = tracing.Inject(logging.OutgoingContext(ctx))
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	tracing.EndRPCSpan(span, err)
+
+	// Log call
+	attrs := []any{"method", method, "latency", time.Since(start), "status", status.Code(err).String(), "userKey", app.Session.GetString(ctx, "user_key")}
+	if err != nil {
+		app.Logger.ErrorContext(ctx, "rpc call failed", append(attrs, "error", err)...)
+	} else {
+		app.Logger.InfoContext(ctx, "rpc call finished", attrs...)
+	}
+
+	return err
+}
+
+// Add auth token to calls and refresh it when it expires
 func authInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 	// Check for auth token in context
 	token := app.Session.GetString(ctx, "user_token")
 
-	// Define variable
-	ctxWithMeta := ctx
+	err := invoker(withToken(ctx, token), method, req, reply, cc, opts...)
+
+	// Skip refreshing for calls that don't use the access token
+	if strings.HasSuffix(method, "/Authenticate") || strings.HasSuffix(method, "/VerifyTwoFactor") || strings.HasSuffix(method, "/RefreshToken") {
+		return err
+	}
+
+	// Access token expired or session revoked
+	if status.Code(err) == codes.Unauthenticated && len(token) > 0 {
+
+		// Get refresh token
+		refreshToken := app.Session.GetString(ctx, "refresh_token")
+		if len(refreshToken) == 0 {
+			return err
+		}
+
+		// Exchange refresh token. Requests of the same session share the refresh
+		result, refreshErr := refresher.refresh(refreshToken, func() (*models.LoginToken, error) {
+			result := &models.LoginToken{}
+			err := invoker(ctx, "/Database/RefreshToken", &models.RefreshTokenParams{RefreshToken: refreshToken}, result, cc, opts...)
+			return result, err
+		})
+		if refreshErr != nil {
+
+			// Session is no longer valid
+			app.Session.Remove(ctx, "user_token")
+			app.Session.Remove(ctx, "refresh_token")
+
+			return err
+		}
 
-	// If token is set
-	if len(token) > 0 {
+		// Store new tokens in session
+		app.Session.Put(ctx, "user_token", result.Token)
+		app.Session.Put(ctx, "refresh_token", result.RefreshToken)
 
-		// Add auth token to context
-		md := metadata.Pairs("authorization", fmt.Sprintf("Bearer %s", token))
-		ctxWithMeta = metadata.NewOutgoingContext(ctx, md)
+		// Retry call
+		err = invoker(withToken(ctx, result.Token), method, req, reply, cc, opts...)
 	}
 
-	err := invoker(ctxWithMeta, method, req, reply, cc, opts...)
 	return err
 }
+
+// Add auth token to context
+func withToken(ctx context.Context, token string) context.Context {
+	// If token is not set
+	if len(token) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, "authorization", fmt.Sprintf("Bearer %s", token))
+}
+
+// Log and trace opening streams to the DB services
+func loggingStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	start := time.Now()
+
+	// Start call span and pass request details on
+	ctx, span := tracing.StartClientSpan(ctx, method)
+	ctx = tracing.Inject(logging.OutgoingContext(ctx))
+
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+
+	tracing.EndRPCSpan(span, err)
+
+	// Log call
+	attrs := []any{"method", method, "latency", time.Since(start), "status", status.Code(err).String(), "userKey", app.Session.GetString(ctx, "user_key")}
+	if err != nil {
+		app.Logger.ErrorContext(ctx, "rpc stream failed", append(attrs, "error", err)...)
+	} else {
+		app.Logger.InfoContext(ctx, "rpc stream opened", attrs...)
+	}
+
+	return stream, err
+}
+
+// Add auth token to streams. Tokens are refreshed by the unary calls made on every page
+func authStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	token := app.Session.GetString(ctx, "user_token")
+
+	return streamer(withToken(ctx, token), desc, cc, method, opts...)
+}
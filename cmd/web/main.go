@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
 	"encoding/gob"
 	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/alexedwards/scs/v2"
@@ -14,30 +18,37 @@ import (
 	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
 	"github.com/dimitargrozev5/expenses-go-1/internal/handlers"
 	"github.com/dimitargrozev5/expenses-go-1/internal/helpers"
+	"github.com/dimitargrozev5/expenses-go-1/internal/logging"
+	"github.com/dimitargrozev5/expenses-go-1/internal/metrics"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/prefs"
+	"github.com/dimitargrozev5/expenses-go-1/internal/sessionstore"
+	"github.com/dimitargrozev5/expenses-go-1/internal/tracing"
 	_ "github.com/mattn/go-sqlite3"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 var (
-	seed   = flag.Bool("seed", false, "Create and seed new DB asd@asd.asd with password asd")
-	port   = flag.String("port", "3001", "Set server port")
-	dbAddr = flag.String("dbaddr", "127.0.0.1:3002", "Database Controller address")
+	seed            = flag.Bool("seed", false, "Create and seed new DB asd@asd.asd with password asd")
+	port            = flag.String("port", "3001", "Set server port")
+	dbAddr          = flag.String("dbaddr", "127.0.0.1:3002", "Database Controller address")
+	shutdownTimeout = flag.Duration("shutdown-timeout", 15*time.Second, "Time to wait for in-flight requests on shutdown")
+	otelExporter    = flag.String("otel-exporter", "", "File or OpenTelemetry collector URL (http://localhost:4318) to export spans to")
 )
 
 // Init app config
 var app config.AppConfig
 var session *scs.SessionManager
-var infoLog *log.Logger
-var errorLog *log.Logger
+var sessions sessionstore.Store
 
 func main() {
 
 	// Start gRPC client
 	var opts = []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(authInterceptor),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor, loggingInterceptor, authInterceptor),
+		grpc.WithChainStreamInterceptor(metrics.StreamClientInterceptor, loggingStreamInterceptor, authStreamInterceptor),
 	}
 
 	conn, err := grpc.NewClient(*dbAddr, opts...)
@@ -51,17 +62,44 @@ func main() {
 		log.Fatal(err)
 	}
 	defer handlers.Repo.CloseAllConnections()
+	defer sessions.Close()
 
-	fmt.Println("Starting server on port ", *port)
+	// Setup tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), "web", *otelExporter)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracing(context.Background())
+
+	app.Logger.Info("starting server", "port", *port)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%s", *port),
 		Handler: routes(&app),
 	}
 
-	err = srv.ListenAndServe()
+	// Start server
+	go func() {
+		err := srv.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+
+	// Wait for interrupt
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+
+	app.Logger.Info("shutting down")
+
+	// Drain in-flight requests
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+
+	err = srv.Shutdown(shutdownCtx)
 	if err != nil {
-		log.Fatal(err)
+		app.Logger.Error("failed to drain requests", "error", err)
 	}
 }
 
@@ -73,6 +111,7 @@ func run(conn *grpc.ClientConn) error {
 	gob.Register(models.Expense{})
 	gob.Register(forms.Form{})
 	gob.Register(map[string]*forms.Form{})
+	gob.Register(prefs.Prefs{})
 
 	// Read command line arguments
 	flag.Parse()
@@ -80,20 +119,19 @@ func run(conn *grpc.ClientConn) error {
 	// Set in production
 	app.InProduction = false
 
-	// Set info log
-	infoLog = log.New(os.Stdout, "INFO:\t", log.Ldate|log.Ltime)
-	app.InfoLog = infoLog
+	// Set logger
+	app.Logger = logging.New(os.Stdout, "web")
+	slog.SetDefault(app.Logger)
 
-	// Set error log
-	errorLog = log.New(os.Stdout, "ERROR:\t", log.Ldate|log.Ltime|log.Lshortfile)
-	app.ErrorLog = errorLog
+	// Open session store
+	store, err := openSessionStore()
+	if err != nil {
+		return err
+	}
+	sessions = store
 
 	// Set session
-	session = scs.New()
-	session.Lifetime = 24 * time.Hour
-	session.Cookie.Persist = true
-	session.Cookie.SameSite = http.SameSiteLaxMode
-	session.Cookie.Secure = app.InProduction
+	session = setupSession(store)
 
 	app.Session = session
 
@@ -2,11 +2,91 @@ package main
 
 import (
 	"net/http"
+	"regexp"
+	"time"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/handlers"
 	"github.com/dimitargrozev5/expenses-go-1/internal/helpers"
+	"github.com/dimitargrozev5/expenses-go-1/internal/logging"
+	"github.com/dimitargrozev5/expenses-go-1/internal/prefs"
+	"github.com/dimitargrozev5/expenses-go-1/internal/tracing"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
 	"github.com/justinas/nosurf"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
+// RequestID adds an id to every request, which is passed on to the DB services
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := logging.NewRequestID()
+		w.Header().Set(logging.RequestIDHeader, id)
+
+		ctx := logging.WithRequestID(r.Context(), id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// LogRequest logs and traces every request
+func LogRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		// Start request span
+		ctx, span := tracing.StartServerSpan(r.Context(), r.Method)
+		defer span.End()
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		r = r.WithContext(ctx)
+
+		next.ServeHTTP(ww, r)
+
+		// Get route pattern once routing is done
+		route := chi.RouteContext(r.Context()).RoutePattern()
+
+		span.SetName(r.Method + " " + route)
+		span.SetAttributes(
+			attribute.String("http.route", route),
+			attribute.Int("http.status_code", ww.Status()),
+		)
+		if ww.Status() >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(ww.Status()))
+		}
+
+		app.Logger.InfoContext(ctx, "request finished",
+			"method", r.Method,
+			"route", route,
+			"status", ww.Status(),
+			"latency", time.Since(start),
+			"userKey", session.GetString(ctx, "user_key"),
+		)
+	})
+}
+
+// Largest body of requests other than attachment uploads
+const maxBodySize = 1024 * 1024
+
+// Room for the multipart headers and the other form fields of an upload
+const uploadOverhead = 1024 * 1024
+
+// Paths of attachment uploads
+var uploadPath = regexp.MustCompile(`^/expenses/[^/]+/attachments$`)
+
+// LimitBody limits the size of request bodies. It runs before the CSRF check, which parses the whole form
+// and keeps large files on disk, so a single request can't fill the disk before it's rejected
+func LimitBody(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit := int64(maxBodySize)
+		if r.Method == http.MethodPost && uploadPath.MatchString(r.URL.Path) {
+			limit = handlers.MaxUploadSize + uploadOverhead
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
+
 // NoSurf adds CSRF protection to all POST requests
 func NoSurf(next http.Handler) http.Handler {
 	csrfHandler := nosurf.New(next)
@@ -37,3 +117,11 @@ func IsAuth(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// Preferences puts the display preferences of the user in the request context, so views format with them
+func Preferences(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := prefs.WithPrefs(r.Context(), handlers.Repo.Prefs(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/handlers"
+)
+
+// Bodies over the limit of their route can't be read
+func TestLimitBody(t *testing.T) {
+	handler := LimitBody(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := io.Copy(io.Discard, r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		}
+	}))
+
+	tests := []struct {
+		name     string
+		path     string
+		size     int
+		expected int
+	}{
+		{"small form", "/expenses", 1024, http.StatusOK},
+		{"large form", "/expenses", maxBodySize + 1, http.StatusRequestEntityTooLarge},
+		{"upload", "/expenses/1/attachments", handlers.MaxUploadSize, http.StatusOK},
+		{"upload over the limit", "/expenses/1/attachments", handlers.MaxUploadSize + uploadOverhead + 1, http.StatusRequestEntityTooLarge},
+		{"other attachment route", "/attachments/1/delete", maxBodySize + 1, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodPost, test.path, bytes.NewReader(make([]byte, test.size)))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != test.expected {
+			t.Errorf("wrong status for %s; expected %d; received %d", test.name, test.expected, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+// How long the result of a refresh is handed to requests that still carry the refresh token it used
+const refreshGrace = 30 * time.Second
+
+// Refresh of a refresh token, running or finished
+type refreshCall struct {
+	done     chan struct{}
+	result   *models.LoginToken
+	err      error
+	finished time.Time
+}
+
+// Serializes refreshes per refresh token. Refresh tokens rotate on every use, so requests of a session
+// that hit an expired access token at once share a single refresh instead of using the token twice
+type tokenRefresher struct {
+	mu    sync.Mutex
+	calls map[string]*refreshCall
+}
+
+var refresher = &tokenRefresher{calls: map[string]*refreshCall{}}
+
+// Run refresh once for a refresh token. Concurrent and recent callers with the same token get its result
+func (r *tokenRefresher) refresh(refreshToken string, refresh func() (*models.LoginToken, error)) (*models.LoginToken, error) {
+	r.mu.Lock()
+
+	// Forget old results
+	now := time.Now()
+	for token, call := range r.calls {
+		if !call.finished.IsZero() && now.Sub(call.finished) > refreshGrace {
+			delete(r.calls, token)
+		}
+	}
+
+	// Wait for a running or use a recent refresh
+	if call, ok := r.calls[refreshToken]; ok {
+		r.mu.Unlock()
+		<-call.done
+		return call.result, call.err
+	}
+
+	call := &refreshCall{done: make(chan struct{})}
+	r.calls[refreshToken] = call
+	r.mu.Unlock()
+
+	call.result, call.err = refresh()
+
+	r.mu.Lock()
+	call.finished = time.Now()
+	r.mu.Unlock()
+	close(call.done)
+
+	return call.result, call.err
+}
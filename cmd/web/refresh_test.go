@@ -0,0 +1,73 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+// Concurrent refreshes with the same token use it once and get the same tokens
+func TestRefreshConcurrent(t *testing.T) {
+	r := &tokenRefresher{calls: map[string]*refreshCall{}}
+
+	var calls atomic.Int32
+	refresh := func() (*models.LoginToken, error) {
+		n := calls.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		return &models.LoginToken{Token: "access", RefreshToken: string(rune('a' + n))}, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*models.LoginToken, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = r.refresh("old", refresh)
+		}(i)
+	}
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("wrong number of refreshes; expected 1; received %d", calls.Load())
+	}
+	for i, result := range results {
+		if result == nil || result.RefreshToken != results[0].RefreshToken {
+			t.Errorf("request %d got different tokens", i)
+		}
+	}
+
+	// A request that still has the old token within the grace period gets the same tokens
+	result, _ := r.refresh("old", refresh)
+	if calls.Load() != 1 || result.RefreshToken != results[0].RefreshToken {
+		t.Error("recent refresh was not reused")
+	}
+
+	// Other tokens refresh on their own
+	r.refresh("other", refresh)
+	if calls.Load() != 2 {
+		t.Errorf("wrong number of refreshes; expected 2; received %d", calls.Load())
+	}
+}
+
+// Results older than the grace period are dropped
+func TestRefreshExpired(t *testing.T) {
+	r := &tokenRefresher{calls: map[string]*refreshCall{}}
+
+	done := make(chan struct{})
+	close(done)
+	r.calls["old"] = &refreshCall{done: done, result: &models.LoginToken{}, finished: time.Now().Add(-refreshGrace - time.Second)}
+
+	called := false
+	r.refresh("old", func() (*models.LoginToken, error) {
+		called = true
+		return &models.LoginToken{}, nil
+	})
+
+	if !called {
+		t.Error("expired refresh was reused")
+	}
+}
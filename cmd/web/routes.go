@@ -5,6 +5,7 @@ import (
 
 	"github.com/dimitargrozev5/expenses-go-1/internal/config"
 	"github.com/dimitargrozev5/expenses-go-1/internal/handlers"
+	"github.com/dimitargrozev5/expenses-go-1/internal/metrics"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 )
@@ -12,9 +13,13 @@ import (
 func routes(_ *config.AppConfig) http.Handler {
 	mux := chi.NewRouter()
 
+	mux.Use(RequestID)
+	mux.Use(metrics.HTTPMiddleware)
 	mux.Use(middleware.Recoverer)
+	mux.Use(LimitBody)
 	mux.Use(NoSurf)
 	mux.Use(SessionLoad)
+	mux.Use(LogRequest)
 
 	// mux.Get("/", handlers.Repo.Home)
 	// mux.Get("/about", handlers.Repo.About)
@@ -28,6 +33,11 @@ func routes(_ *config.AppConfig) http.Handler {
 
 		// Handle login page
 		r.Post("/login", handlers.Repo.PostLogin)
+		r.Get("/login/two-factor", handlers.Repo.TwoFactorLogin)
+		r.Post("/login/two-factor", handlers.Repo.PostTwoFactorLogin)
+
+		// Serve metrics
+		r.Handle("/metrics", metrics.Handler())
 
 		// Serve access to static files
 		r.Get("/static/*", handlers.Repo.Static)
@@ -38,6 +48,9 @@ func routes(_ *config.AppConfig) http.Handler {
 		// Set auth middleware
 		r.Use(IsAuth)
 
+		// Set display preferences
+		r.Use(Preferences)
+
 		// Handle logout
 		r.Get("/logout", handlers.Repo.Logout)
 
@@ -46,11 +59,42 @@ func routes(_ *config.AppConfig) http.Handler {
 		r.Post("/expenses/add", handlers.Repo.PostNewExpense)
 		r.Post("/expenses/{expenseId}/edit", handlers.Repo.PostEditExpense)
 		r.Post("/expenses/{expenseId}/delete", handlers.Repo.PostDeleteExpense)
+		r.Post("/expenses/{expenseId}/refund", handlers.Repo.PostExpenseRefund)
+		r.Post("/expenses/{expenseId}/reimbursement", handlers.Repo.PostExpenseReimbursement)
+		r.Post("/expenses/refunds/{refundId}/delete", handlers.Repo.PostDeleteExpenseRefund)
+		r.Post("/expenses/{expenseId}/attachments", handlers.Repo.PostExpenseAttachment)
+		r.Get("/attachments/{attachmentId}", handlers.Repo.Attachment)
+		r.Post("/attachments/{attachmentId}/delete", handlers.Repo.PostDeleteAttachment)
+
+		// Handle payee related routes
+		r.Get("/payees", handlers.Repo.Payees)
+		r.Post("/payees/add", handlers.Repo.PostNewPayee)
+		r.Post("/payees/{payeeId}/edit", handlers.Repo.PostEditPayee)
+		r.Post("/payees/{payeeId}/delete", handlers.Repo.PostDeletePayee)
+
+		// Handle rule related routes
+		r.Get("/rules", handlers.Repo.Rules)
+		r.Post("/rules/add", handlers.Repo.PostNewRule)
+		r.Post("/rules/{ruleId}/edit", handlers.Repo.PostEditRule)
+		r.Post("/rules/{ruleId}/delete", handlers.Repo.PostDeleteRule)
+
+		// Handle income related routes
+		r.Get("/incomes", handlers.Repo.Incomes)
+		r.Post("/incomes/add", handlers.Repo.PostNewIncome)
+		r.Post("/incomes/{incomeId}/edit", handlers.Repo.PostEditIncome)
+		r.Post("/incomes/{incomeId}/delete", handlers.Repo.PostDeleteIncome)
+
+		// Handle liability related routes
+		r.Get("/liabilities", handlers.Repo.Liabilities)
+		r.Post("/liabilities/add", handlers.Repo.PostNewLiability)
+		r.Post("/liabilities/{liabilityId}/pay", handlers.Repo.PostLiabilityPayment)
+		r.Post("/liabilities/{liabilityId}/delete", handlers.Repo.PostDeleteLiability)
 
 		// Handle accounts related routes
 		r.Get("/accounts", handlers.Repo.Accounts)
 		r.Post("/accounts/add", handlers.Repo.PostNewAccount)
 		r.Post("/accounts/modify-free-funds", handlers.Repo.PostModifyFreeFunds)
+		r.Post("/accounts/transfer", handlers.Repo.PostTransferFunds)
 		r.Post("/accounts/{accountId}/move-up", handlers.Repo.PostMoveAccount(1))
 		r.Post("/accounts/{accountId}/move-down", handlers.Repo.PostMoveAccount(-1))
 		r.Post("/accounts/{accountId}/delete", handlers.Repo.PostDeleteAccount)
@@ -62,6 +106,34 @@ func routes(_ *config.AppConfig) http.Handler {
 		r.Post("/categories/{categoryId}/move-up", handlers.Repo.PostMoveCategory(1))
 		r.Post("/categories/{categoryId}/move-down", handlers.Repo.PostMoveCategory(-1))
 		r.Post("/categories/{categoryId}/delete", handlers.Repo.PostDeleteCategory)
+		r.Post("/categories/{categoryId}/auto-reset", handlers.Repo.PostCategoryAutoReset)
+		r.Post("/categories/{categoryId}/goal", handlers.Repo.PostCategoryGoal)
+
+		// Handle activity related routes
+		r.Get("/activity", handlers.Repo.Activity)
+		r.Post("/activity/undo", handlers.Repo.PostUndo)
+
+		// Handle session related routes
+		r.Get("/settings/sessions", handlers.Repo.Sessions)
+		r.Post("/settings/sessions/{sessionId}/revoke", handlers.Repo.PostRevokeSession)
+
+		// Handle settings related routes
+		r.Get("/settings/preferences", handlers.Repo.Settings)
+		r.Post("/settings/preferences", handlers.Repo.PostSettings)
+
+		// Handle security related routes
+		r.Get("/settings/security", handlers.Repo.Security)
+		r.Post("/settings/security/two-factor/setup", handlers.Repo.PostTwoFactorSetup)
+		r.Post("/settings/security/two-factor/enable", handlers.Repo.PostEnableTwoFactor)
+		r.Post("/settings/security/two-factor/disable", handlers.Repo.PostDisableTwoFactor)
+		r.Post("/settings/security/recovery-codes", handlers.Repo.PostRecoveryCodes)
+
+		// Handle exchange rates related routes
+		r.Get("/settings/exchange-rates", handlers.Repo.ExchangeRates)
+		r.Post("/settings/exchange-rates/add", handlers.Repo.PostNewExchangeRate)
+		r.Post("/settings/exchange-rates/import", handlers.Repo.PostImportExchangeRates)
+		r.Post("/settings/exchange-rates/base-currency", handlers.Repo.PostBaseCurrency)
+		r.Post("/settings/exchange-rates/{rateId}/delete", handlers.Repo.PostDeleteExchangeRate)
 	})
 
 	return mux
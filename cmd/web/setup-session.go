@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+	"github.com/dimitargrozev5/expenses-go-1/internal/sessionstore"
+)
+
+var (
+	sessionStore       = flag.String("session-store", "sqlite", "Where sessions are kept: sqlite, redis or memory")
+	sessionDB          = flag.String("session-db", "./web-db/sessions.db", "Sqlite db of the sqlite session store")
+	redisAddr          = flag.String("redis-addr", "localhost:6379", "Address of the Redis compatible server of the redis session store")
+	redisPassword      = flag.String("redis-password", os.Getenv("REDIS_PASSWORD"), "Password of the Redis compatible server. Defaults to REDIS_PASSWORD")
+	sessionIdleTimeout = flag.Duration("session-idle-timeout", 24*time.Hour, "Sessions that aren't used for this long expire. 0 disables it")
+	sessionLifetime    = flag.Duration("session-lifetime", 14*24*time.Hour, "Sessions expire this long after login, even when they are used")
+)
+
+// Open the configured session store
+func openSessionStore() (sessionstore.Store, error) {
+	switch *sessionStore {
+	case "sqlite":
+		// Create db folder
+		err := os.MkdirAll(filepath.Dir(*sessionDB), os.ModePerm)
+		if err != nil {
+			return nil, err
+		}
+
+		return sessionstore.NewSQLite(*sessionDB, 5*time.Minute)
+	case "redis":
+		store := sessionstore.NewRedis(*redisAddr, *redisPassword)
+
+		// Fail on start instead of on the first request
+		err := store.Ping()
+		if err != nil {
+			store.Close()
+			return nil, fmt.Errorf("can't reach session store at %s: %w", *redisAddr, err)
+		}
+
+		return store, nil
+	case "memory":
+		return sessionstore.NewMemory(), nil
+	}
+
+	return nil, fmt.Errorf("unknown session store %q", *sessionStore)
+}
+
+// Create session manager. Cookies last until the browser closes, unless the user asks to be remembered
+func setupSession(store sessionstore.Store) *scs.SessionManager {
+	session := scs.New()
+	session.Store = store
+	session.Lifetime = *sessionLifetime
+	session.IdleTimeout = *sessionIdleTimeout
+	session.Cookie.Persist = false
+	session.Cookie.SameSite = http.SameSiteLaxMode
+	session.Cookie.Secure = app.InProduction
+
+	return session
+}
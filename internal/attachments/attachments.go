@@ -0,0 +1,153 @@
+package attachments
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// Size of the chunks attachments are streamed in
+const ChunkSize = 32 * 1024
+
+// Content is larger than the space left
+var ErrTooLarge = errors.New("attachment is larger than the space left")
+
+// Valid content hashes
+var hashRe = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Content-addressed file store. Files are named by the SHA-256 of their content,
+// so the same content is kept once
+type Store struct {
+	Dir string
+}
+
+// Create a store in a directory. The directory is created on the first write
+func New(dir string) *Store {
+	return &Store{Dir: dir}
+}
+
+// Get path of the content with a hash
+func (s *Store) path(hash string) (string, error) {
+	if !hashRe.MatchString(hash) {
+		return "", fmt.Errorf("invalid attachment hash %q", hash)
+	}
+	return filepath.Join(s.Dir, hash[:2], hash), nil
+}
+
+// Write content to the store and return its hash and size.
+// Content larger than limit bytes is rejected with ErrTooLarge
+func (s *Store) Put(r io.Reader, limit int64) (string, int64, error) {
+	// Create store directory
+	err := os.MkdirAll(s.Dir, 0o700)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// Write to a temporary file while hashing
+	tmp, err := os.CreateTemp(s.Dir, "upload-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hasher), io.LimitReader(r, limit+1))
+	if err != nil {
+		return "", 0, err
+	}
+	if size > limit {
+		return "", 0, ErrTooLarge
+	}
+	err = tmp.Close()
+	if err != nil {
+		return "", 0, err
+	}
+
+	// Move to the content path. Existing content is the same, so it's kept
+	hash := hex.EncodeToString(hasher.Sum(nil))
+	path, _ := s.path(hash)
+	if _, err := os.Stat(path); err == nil {
+		return hash, size, nil
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return "", 0, err
+	}
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return hash, size, nil
+}
+
+// Open content by hash
+func (s *Store) Open(hash string) (*os.File, error) {
+	path, err := s.path(hash)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+// Remove content by hash. Missing content is not an error
+func (s *Store) Remove(hash string) error {
+	path, err := s.path(hash)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Read a stream of chunks. The first chunk may have come with the stream details
+func NewChunkReader(first []byte, next func() ([]byte, error)) io.Reader {
+	return &chunkReader{buf: first, next: next}
+}
+
+type chunkReader struct {
+	buf  []byte
+	next func() ([]byte, error)
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		chunk, err := c.next()
+		if err != nil {
+			return 0, err
+		}
+		c.buf = chunk
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+// Send content in chunks
+func SendChunks(r io.Reader, send func([]byte) error) error {
+	buf := make([]byte, ChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			sendErr := send(buf[:n])
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,508 @@
+package backups
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/attachments"
+	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+)
+
+// Extensions of compressed and of encrypted snapshots
+const (
+	ext       = ".db.gz"
+	sealedExt = ".db.gz.enc"
+)
+
+// Time in snapshot keys. Keys of a user sort by time
+const keyTime = "20060102T150405Z"
+
+// No snapshot matches
+var ErrNotFound = errors.New("backup not found")
+
+// Stored object
+type Object struct {
+	Key  string
+	Size int64
+}
+
+// Storage of compressed snapshots. Keys are slash separated
+type Backend interface {
+	Put(ctx context.Context, key string, r io.ReadSeeker) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	List(ctx context.Context, prefix string) ([]Object, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Snapshot of a user db
+type Snapshot struct {
+	User   string
+	Key    string
+	Time   time.Time
+	Size   int64
+	Sealed bool
+}
+
+// Encrypts snapshots of a user
+type Sealer interface {
+	Seal(user string, data []byte) ([]byte, error)
+	Unseal(user string, data []byte) ([]byte, error)
+}
+
+// Snapshots that are kept. The newest Keep snapshots are kept, along with the newest snapshot
+// of each of the last Days days. Zero keeps everything
+type Retention struct {
+	Keep int
+	Days int
+}
+
+// Takes, lists, restores and prunes snapshots of user dbs.
+// Attachment content a snapshot references is stored once per user next to the snapshots and kept while a snapshot references it,
+// so a restore doesn't bring back attachments whose content was removed. Snapshots are encrypted when there's a sealer, and they are kept in memory so they never reach the disk unencrypted
+type Manager struct {
+	Backend   Backend
+	Retention Retention
+	Sealer    Sealer
+}
+
+// Create a manager
+func New(backend Backend, retention Retention) *Manager {
+	return &Manager{Backend: backend, Retention: retention}
+}
+
+// Get key of a user snapshot taken at a time
+func snapshotKey(user string, t time.Time, sealed bool) string {
+	if sealed {
+		return fmt.Sprintf("%s/%s%s", user, t.UTC().Format(keyTime), sealedExt)
+	}
+	return fmt.Sprintf("%s/%s%s", user, t.UTC().Format(keyTime), ext)
+}
+
+// Get snapshot from its key. Keys that aren't snapshots are skipped
+func parseKey(obj Object) (Snapshot, bool) {
+	user, file := path.Split(obj.Key)
+	if user == "" {
+		return Snapshot{}, false
+	}
+
+	// Get time from file name
+	name, sealed := strings.CutSuffix(file, sealedExt)
+	if !sealed {
+		var ok bool
+		name, ok = strings.CutSuffix(file, ext)
+		if !ok {
+			return Snapshot{}, false
+		}
+	}
+
+	t, err := time.Parse(keyTime, name)
+	if err != nil {
+		return Snapshot{}, false
+	}
+
+	return Snapshot{User: strings.TrimSuffix(user, "/"), Key: obj.Key, Time: t, Size: obj.Size, Sealed: sealed}, true
+}
+
+// Take a consistent snapshot of a user db with the online backup API, compress it and store it
+// along with the attachment content it references. Nil files skips attachments
+func (m *Manager) Backup(ctx context.Context, user string, db *sql.DB, files *attachments.Store) (Snapshot, error) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	if m.Sealer != nil {
+		return m.backupSealed(ctx, user, db, files, now)
+	}
+
+	// Copy db to a temporary file. Writers aren't blocked while pages are copied
+	dir, err := os.MkdirTemp("", "backup-*")
+	if err != nil {
+		return Snapshot{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	dbFile := filepath.Join(dir, "snapshot.db")
+	err = driver.CopyToFile(ctx, db, dbFile)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// Store attachments of the snapshot first, so a stored snapshot always has them
+	snapshot := Snapshot{User: user, Key: snapshotKey(user, now, false), Time: now}
+	if files != nil {
+		err = m.backupFileAttachments(ctx, snapshot, dbFile, files)
+		if err != nil {
+			return Snapshot{}, err
+		}
+	}
+
+	// Compress snapshot
+	gzFile, err := os.Create(filepath.Join(dir, "snapshot"+ext))
+	if err != nil {
+		return Snapshot{}, err
+	}
+	defer gzFile.Close()
+
+	err = compress(gzFile, dbFile)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	size, err := gzFile.Seek(0, io.SeekEnd)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	_, err = gzFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// Store snapshot
+	snapshot.Size = size
+	err = m.Backend.Put(ctx, snapshot.Key, gzFile)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return snapshot, nil
+}
+
+// Take a snapshot in memory, compress it, encrypt it and store it
+func (m *Manager) backupSealed(ctx context.Context, user string, db *sql.DB, files *attachments.Store, now time.Time) (Snapshot, error) {
+	data, err := driver.Serialize(ctx, db)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// Store attachments of the snapshot first, so a stored snapshot always has them
+	snapshot := Snapshot{User: user, Key: snapshotKey(user, now, true), Time: now, Sealed: true}
+	if files != nil {
+		src, err := driver.OpenMemory(ctx, data)
+		if err != nil {
+			return Snapshot{}, err
+		}
+		err = m.backupFiles(ctx, snapshot, src, files)
+		src.Close()
+		if err != nil {
+			return Snapshot{}, err
+		}
+	}
+
+	// Compress snapshot
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write(data)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	err = gz.Close()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// Encrypt snapshot
+	sealed, err := m.Sealer.Seal(user, buf.Bytes())
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// Store snapshot
+	snapshot.Size = int64(len(sealed))
+	err = m.Backend.Put(ctx, snapshot.Key, bytes.NewReader(sealed))
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return snapshot, nil
+}
+
+// Store attachments a snapshot file references
+func (m *Manager) backupFileAttachments(ctx context.Context, snapshot Snapshot, dbFile string, files *attachments.Store) error {
+	src, err := driver.NewDatabase("file:" + dbFile + "?mode=ro")
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	return m.backupFiles(ctx, snapshot, src, files)
+}
+
+// Compress a file into a writer
+func compress(w io.Writer, file string) error {
+	src, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	gz := gzip.NewWriter(w)
+	_, err = io.Copy(gz, src)
+	if err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// Get snapshots oldest first. Empty user lists snapshots of every user
+func (m *Manager) List(ctx context.Context, user string) ([]Snapshot, error) {
+	prefix := ""
+	if user != "" {
+		prefix = user + "/"
+	}
+
+	objects, err := m.Backend.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]Snapshot, 0, len(objects))
+	for _, obj := range objects {
+		if snapshot, ok := parseKey(obj); ok {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].User != snapshots[j].User {
+			return snapshots[i].User < snapshots[j].User
+		}
+		return snapshots[i].Time.Before(snapshots[j].Time)
+	})
+
+	return snapshots, nil
+}
+
+// Get the newest user snapshot taken at or before a time
+func (m *Manager) Find(ctx context.Context, user string, at time.Time) (Snapshot, error) {
+	snapshots, err := m.List(ctx, user)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if !snapshots[i].Time.After(at) {
+			return snapshots[i], nil
+		}
+	}
+
+	return Snapshot{}, ErrNotFound
+}
+
+// Restore a snapshot into a user db with the online backup API. Open connections see the restored data.
+// Attachment content the snapshot references is put back into files first. Nil files skips attachments
+func (m *Manager) Restore(ctx context.Context, snapshot Snapshot, db *sql.DB, files *attachments.Store) error {
+	if snapshot.Sealed {
+		return m.restoreSealed(ctx, snapshot, db, files)
+	}
+
+	// Get snapshot
+	r, err := m.Backend.Get(ctx, snapshot.Key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	// Decompress to a temporary file
+	dir, err := os.MkdirTemp("", "restore-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	dbFile := filepath.Join(dir, "snapshot.db")
+	f, err := os.Create(dbFile)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, gz)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	// Restore attachments
+	if files != nil {
+		src, err := driver.NewDatabase("file:" + dbFile + "?mode=ro")
+		if err != nil {
+			return err
+		}
+		err = m.restoreFiles(ctx, snapshot, src, files)
+		src.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return driver.CopyFromFile(ctx, dbFile, db)
+}
+
+// Decrypt and decompress a snapshot in memory, then restore it
+func (m *Manager) restoreSealed(ctx context.Context, snapshot Snapshot, db *sql.DB, files *attachments.Store) error {
+	if m.Sealer == nil {
+		return errors.New("encrypted backups need the master key")
+	}
+
+	// Get snapshot
+	r, err := m.Backend.Get(ctx, snapshot.Key)
+	if err != nil {
+		return err
+	}
+	sealed, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return err
+	}
+
+	// Decrypt and decompress snapshot
+	compressed, err := m.Sealer.Unseal(snapshot.User, sealed)
+	if err != nil {
+		return err
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(gz)
+	if err != nil {
+		return err
+	}
+
+	// Restore from an in-memory copy
+	src, err := driver.OpenMemory(ctx, data)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	// Restore attachments
+	if files != nil {
+		err = m.restoreFiles(ctx, snapshot, src, files)
+		if err != nil {
+			return err
+		}
+	}
+
+	return driver.CopyDB(ctx, db, src)
+}
+
+// Remove snapshots that the retention policy doesn't keep, then attachment content no kept snapshot references.
+// Empty user prunes snapshots of every user. Returns the removed snapshots
+func (m *Manager) Prune(ctx context.Context, user string) ([]Snapshot, error) {
+	if m.Retention.Keep == 0 && m.Retention.Days == 0 {
+		return nil, nil
+	}
+
+	snapshots, err := m.List(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group snapshots by user. They are sorted by user and time
+	byUser := make(map[string][]Snapshot)
+	for _, snapshot := range snapshots {
+		byUser[snapshot.User] = append(byUser[snapshot.User], snapshot)
+	}
+
+	removed := make([]Snapshot, 0)
+	now := time.Now()
+	for _, userSnapshots := range byUser {
+		for _, snapshot := range expired(userSnapshots, m.Retention, now) {
+			err = m.Backend.Delete(ctx, snapshot.Key)
+			if err != nil {
+				return removed, err
+			}
+			removed = append(removed, snapshot)
+
+			// Remove manifest
+			err = m.Backend.Delete(ctx, manifestKey(snapshot.Key))
+			if err != nil {
+				return removed, err
+			}
+		}
+	}
+
+	// Remove attachment content of removed snapshots
+	prefix := ""
+	if user != "" {
+		prefix = user + "/"
+	}
+	objects, err := m.Backend.List(ctx, prefix)
+	if err != nil {
+		return removed, err
+	}
+
+	return removed, m.pruneFiles(ctx, objects)
+}
+
+// Get snapshots of a user, oldest first, that the retention policy doesn't keep
+func expired(snapshots []Snapshot, retention Retention, now time.Time) []Snapshot {
+	keep := make(map[string]bool)
+
+	// Keep the newest snapshots
+	for i := len(snapshots) - 1; i >= 0 && i >= len(snapshots)-retention.Keep; i-- {
+		keep[snapshots[i].Key] = true
+	}
+
+	// Keep the newest snapshot of each recent day
+	cutoff := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, -retention.Days+1)
+	days := make(map[string]bool)
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		day := snapshots[i].Time.UTC().Format("2006-01-02")
+		if retention.Days > 0 && !snapshots[i].Time.Before(cutoff) && !days[day] {
+			days[day] = true
+			keep[snapshots[i].Key] = true
+		}
+	}
+
+	result := make([]Snapshot, 0)
+	for _, snapshot := range snapshots {
+		if !keep[snapshot.Key] {
+			result = append(result, snapshot)
+		}
+	}
+
+	return result
+}
+
+// Backend settings. Backend is local or s3
+type Config struct {
+	Backend string
+	Dir     string
+	S3      S3Config
+}
+
+// Create the configured backend
+func NewBackend(config Config) (Backend, error) {
+	switch config.Backend {
+	case "local":
+		return NewLocal(config.Dir), nil
+	case "s3":
+		if config.S3.Endpoint == "" || config.S3.Bucket == "" {
+			return nil, errors.New("s3 backups need an endpoint and a bucket")
+		}
+		return NewS3(config.S3), nil
+	}
+
+	return nil, fmt.Errorf("unknown backup backend %q", config.Backend)
+}
@@ -0,0 +1,374 @@
+package backups
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/attachments"
+	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+)
+
+// Get snapshots of a user at times
+func snapshotsAt(times ...string) []Snapshot {
+	snapshots := make([]Snapshot, 0, len(times))
+	for _, s := range times {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			panic(err)
+		}
+		snapshots = append(snapshots, Snapshot{User: "u", Key: snapshotKey("u", t, false), Time: t})
+	}
+	return snapshots
+}
+
+// Get times of snapshots
+func snapshotTimes(snapshots []Snapshot) []string {
+	times := make([]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		times = append(times, snapshot.Time.Format(time.RFC3339))
+	}
+	return times
+}
+
+func TestExpired(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2024-03-10T12:00:00Z")
+	snapshots := snapshotsAt(
+		"2024-03-01T10:00:00Z",
+		"2024-03-08T10:00:00Z",
+		"2024-03-08T20:00:00Z",
+		"2024-03-09T10:00:00Z",
+		"2024-03-10T09:00:00Z",
+		"2024-03-10T11:00:00Z",
+	)
+
+	tests := []struct {
+		name      string
+		retention Retention
+		expected  []string
+	}{
+		{"keep newest", Retention{Keep: 2}, []string{
+			"2024-03-01T10:00:00Z",
+			"2024-03-08T10:00:00Z",
+			"2024-03-08T20:00:00Z",
+			"2024-03-09T10:00:00Z",
+		}},
+		{"keep more than there are", Retention{Keep: 10}, []string{}},
+		{"newest of each day", Retention{Days: 3}, []string{
+			"2024-03-01T10:00:00Z",
+			"2024-03-08T10:00:00Z",
+			"2024-03-10T09:00:00Z",
+		}},
+		{"today only", Retention{Days: 1}, []string{
+			"2024-03-01T10:00:00Z",
+			"2024-03-08T10:00:00Z",
+			"2024-03-08T20:00:00Z",
+			"2024-03-09T10:00:00Z",
+			"2024-03-10T09:00:00Z",
+		}},
+		{"newest and days", Retention{Keep: 3, Days: 3}, []string{
+			"2024-03-01T10:00:00Z",
+			"2024-03-08T10:00:00Z",
+		}},
+	}
+
+	for _, test := range tests {
+		received := snapshotTimes(expired(snapshots, test.retention, now))
+		if !reflect.DeepEqual(received, test.expected) {
+			t.Errorf("wrong expired snapshots for %s; expected %v; received %v", test.name, test.expected, received)
+		}
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		key    string
+		ok     bool
+		sealed bool
+	}{
+		{"u/20240310T120000Z.db.gz", true, false},
+		{"u/20240310T120000Z.db.gz.enc", true, true},
+		{"u/20240310T120000Z.db.gz.files", false, false},
+		{"u/files/" + strings.Repeat("a", 64), false, false},
+		{"20240310T120000Z.db.gz", false, false},
+		{"u/latest.db.gz", false, false},
+	}
+
+	for _, test := range tests {
+		snapshot, ok := parseKey(Object{Key: test.key})
+		if ok != test.ok {
+			t.Errorf("wrong ok for %s; expected %v; received %v", test.key, test.ok, ok)
+			continue
+		}
+		if ok && (snapshot.User != "u" || snapshot.Sealed != test.sealed) {
+			t.Errorf("wrong snapshot for %s; received %+v", test.key, snapshot)
+		}
+	}
+}
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	backend := NewLocal(t.TempDir())
+
+	// Put objects
+	for _, key := range []string{"a/1.db.gz", "a/2.db.gz", "b/1.db.gz"} {
+		err := backend.Put(ctx, key, strings.NewReader(key))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Get object
+	r, err := backend.Get(ctx, "a/2.db.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := io.ReadAll(r)
+	r.Close()
+	if string(data) != "a/2.db.gz" {
+		t.Errorf("wrong content; expected %q; received %q", "a/2.db.gz", data)
+	}
+
+	// List by prefix
+	objects, err := backend.List(ctx, "a/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]string, 0)
+	for _, obj := range objects {
+		keys = append(keys, obj.Key)
+	}
+	if !reflect.DeepEqual(keys, []string{"a/1.db.gz", "a/2.db.gz"}) {
+		t.Errorf("wrong keys; expected %v; received %v", []string{"a/1.db.gz", "a/2.db.gz"}, keys)
+	}
+
+	// Delete object. Missing objects are deleted already
+	err = backend.Delete(ctx, "a/2.db.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = backend.Delete(ctx, "a/2.db.gz")
+	if err != nil {
+		t.Errorf("wrong error deleting missing object; expected nil; received %v", err)
+	}
+	_, err = backend.Get(ctx, "a/2.db.gz")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("wrong error getting deleted object; expected %v; received %v", ErrNotFound, err)
+	}
+
+	// Keys can't leave the directory
+	for _, key := range []string{"../x", "a/../../x", "/etc/passwd"} {
+		err = backend.Put(ctx, key, strings.NewReader(""))
+		if err == nil {
+			t.Errorf("wrong error putting %s; expected invalid key; received nil", key)
+		}
+	}
+	_, err = os.Stat(filepath.Join(filepath.Dir(backend.Dir), "x"))
+	if err == nil {
+		t.Errorf("object was written outside the directory")
+	}
+
+	// Listing a directory that doesn't exist is empty
+	objects, err = NewLocal(filepath.Join(backend.Dir, "missing")).List(ctx, "")
+	if err != nil || len(objects) != 0 {
+		t.Errorf("wrong listing of missing directory; expected no objects; received %v, %v", objects, err)
+	}
+}
+
+// Sealer that marks data with the user
+type testSealer struct{}
+
+func (testSealer) Seal(user string, data []byte) ([]byte, error) {
+	return append([]byte(user+":"), data...), nil
+}
+
+func (testSealer) Unseal(user string, data []byte) ([]byte, error) {
+	plain, ok := bytes.CutPrefix(data, []byte(user+":"))
+	if !ok {
+		return nil, errors.New("wrong user")
+	}
+	return plain, nil
+}
+
+// Create a user db with attachments of content
+func attachmentsDB(t *testing.T, files *attachments.Store, content ...string) *driver.DB {
+	t.Helper()
+
+	dbconn, err := driver.ConnectSQL(filepath.Join(t.TempDir(), "user.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { dbconn.SQL.Close() })
+
+	_, err = dbconn.SQL.Exec(`CREATE TABLE attachments (id INTEGER PRIMARY KEY, hash TEXT NOT NULL)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range content {
+		hash, _, err := files.Put(strings.NewReader(c), 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = dbconn.SQL.Exec(`INSERT INTO attachments (hash) VALUES ($1)`, hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dbconn
+}
+
+// Read content from a store
+func readContent(files *attachments.Store, hash string) (string, error) {
+	f, err := files.Open(hash)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	return string(data), err
+}
+
+func TestBackupRestoreAttachments(t *testing.T) {
+	for _, sealed := range []bool{false, true} {
+		ctx := context.Background()
+		manager := New(NewLocal(t.TempDir()), Retention{})
+		if sealed {
+			manager.Sealer = testSealer{}
+		}
+
+		files := attachments.New(t.TempDir())
+		dbconn := attachmentsDB(t, files, "receipt", "invoice")
+
+		var hashes []string
+		rows, err := dbconn.SQL.Query(`SELECT hash FROM attachments ORDER BY id`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for rows.Next() {
+			var hash string
+			rows.Scan(&hash)
+			hashes = append(hashes, hash)
+		}
+		rows.Close()
+
+		snapshot, err := manager.Backup(ctx, "u", dbconn.SQL, files)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Content is stored once and listed in the manifest
+		for _, hash := range hashes {
+			_, err = manager.Backend.Get(ctx, fileKey("u", hash, sealed))
+			if err != nil {
+				t.Errorf("content %s wasn't backed up (sealed %v): %v", hash, sealed, err)
+			}
+		}
+		_, err = manager.Backend.Get(ctx, manifestKey(snapshot.Key))
+		if err != nil {
+			t.Errorf("manifest wasn't stored (sealed %v): %v", sealed, err)
+		}
+
+		// Manifest and content aren't listed as snapshots
+		snapshots, err := manager.List(ctx, "u")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(snapshots) != 1 {
+			t.Errorf("wrong number of snapshots (sealed %v); expected 1; received %d", sealed, len(snapshots))
+		}
+
+		// Delete an attachment and its content, then restore
+		_, err = dbconn.SQL.Exec(`DELETE FROM attachments WHERE hash = $1`, hashes[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = files.Remove(hashes[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = manager.Restore(ctx, snapshot, dbconn.SQL, files)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var count int
+		dbconn.SQL.QueryRow(`SELECT count(*) FROM attachments`).Scan(&count)
+		if count != 2 {
+			t.Errorf("wrong number of restored attachments (sealed %v); expected 2; received %d", sealed, count)
+		}
+		content, err := readContent(files, hashes[0])
+		if err != nil || content != "receipt" {
+			t.Errorf("wrong restored content (sealed %v); expected %q; received %q, %v", sealed, "receipt", content, err)
+		}
+	}
+}
+
+func TestPruneAttachments(t *testing.T) {
+	ctx := context.Background()
+	manager := New(NewLocal(t.TempDir()), Retention{Keep: 1})
+
+	old := strings.Repeat("a", 64)
+	kept := strings.Repeat("b", 64)
+	orphan := strings.Repeat("c", 64)
+
+	// Two snapshots, each referencing its own content, and content no snapshot references
+	objects := map[string]string{
+		"u/20240301T000000Z.db.gz":       "",
+		"u/20240301T000000Z.db.gz.files": old,
+		"u/20240302T000000Z.db.gz":       "",
+		"u/20240302T000000Z.db.gz.files": kept + "\n" + old,
+		"u/files/" + old:                 "old",
+		"u/files/" + kept:                "kept",
+		"u/files/" + orphan:              "orphan",
+		"v/files/" + orphan:              "other user",
+	}
+	for key, content := range objects {
+		err := manager.Backend.Put(ctx, key, strings.NewReader(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	removed, err := manager.Prune(ctx, "u")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(removed) != 1 || removed[0].Key != "u/20240301T000000Z.db.gz" {
+		t.Errorf("wrong removed snapshots; expected the first; received %v", removed)
+	}
+
+	tests := []struct {
+		key    string
+		exists bool
+	}{
+		{"u/20240301T000000Z.db.gz", false},
+		{"u/20240301T000000Z.db.gz.files", false},
+		{"u/20240302T000000Z.db.gz", true},
+		{"u/20240302T000000Z.db.gz.files", true},
+		{"u/files/" + old, true},
+		{"u/files/" + kept, true},
+		{"u/files/" + orphan, false},
+		{"v/files/" + orphan, true},
+	}
+
+	for _, test := range tests {
+		r, err := manager.Backend.Get(ctx, test.key)
+		if err == nil {
+			r.Close()
+		}
+		if (err == nil) != test.exists {
+			t.Errorf("wrong existence of %s; expected %v; received %v", test.key, test.exists, err == nil)
+		}
+	}
+}
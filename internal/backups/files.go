@@ -0,0 +1,250 @@
+package backups
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/attachments"
+)
+
+// Attachment content of a user is kept once under this folder and shared by the snapshots that reference it
+const filesDir = "files/"
+
+// Extension of the list of attachment hashes a snapshot references
+const manifestExt = ".files"
+
+// Get key of attachment content
+func fileKey(user, hash string, sealed bool) string {
+	if sealed {
+		return user + "/" + filesDir + hash + ".enc"
+	}
+	return user + "/" + filesDir + hash
+}
+
+// Get hash from the key of attachment content. Keys that aren't attachment content are skipped
+func parseFileKey(key string) (string, bool) {
+	_, file, ok := strings.Cut(key, "/"+filesDir)
+	if !ok || strings.Contains(file, "/") {
+		return "", false
+	}
+	return strings.TrimSuffix(file, ".enc"), true
+}
+
+// Get key of the manifest of a snapshot
+func manifestKey(snapshotKey string) string {
+	return snapshotKey + manifestExt
+}
+
+// Get hashes of the attachments a db references. Dbs without attachments have none
+func attachmentHashes(ctx context.Context, db *sql.DB) ([]string, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'attachments')`).Scan(&exists)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT DISTINCT hash FROM attachments ORDER BY hash`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hashes := make([]string, 0)
+	for rows.Next() {
+		var hash string
+		err = rows.Scan(&hash)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, rows.Err()
+}
+
+// Store attachment content a snapshot references and its manifest. Content that is already stored isn't uploaded again.
+// Content that is no longer in the store was removed after the snapshot was taken and is skipped
+func (m *Manager) backupFiles(ctx context.Context, snapshot Snapshot, snapshotDB *sql.DB, files *attachments.Store) error {
+	hashes, err := attachmentHashes(ctx, snapshotDB)
+	if err != nil {
+		return err
+	}
+
+	// Get stored content
+	objects, err := m.Backend.List(ctx, snapshot.User+"/"+filesDir)
+	if err != nil {
+		return err
+	}
+	stored := make(map[string]bool, len(objects))
+	for _, obj := range objects {
+		stored[obj.Key] = true
+	}
+
+	for _, hash := range hashes {
+		key := fileKey(snapshot.User, hash, snapshot.Sealed)
+		if stored[key] {
+			continue
+		}
+
+		// Read content
+		f, err := files.Open(hash)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+
+		// Store content
+		data, err = m.seal(snapshot, data)
+		if err != nil {
+			return err
+		}
+		err = m.Backend.Put(ctx, key, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store manifest. Pruning keeps the content of every manifest
+	manifest, err := m.seal(snapshot, []byte(strings.Join(hashes, "\n")))
+	if err != nil {
+		return err
+	}
+	return m.Backend.Put(ctx, manifestKey(snapshot.Key), bytes.NewReader(manifest))
+}
+
+// Put attachment content a snapshot references back into the store. Content that is in the store is kept,
+// content that isn't backed up, as with snapshots taken before attachments were, is skipped
+func (m *Manager) restoreFiles(ctx context.Context, snapshot Snapshot, snapshotDB *sql.DB, files *attachments.Store) error {
+	hashes, err := attachmentHashes(ctx, snapshotDB)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range hashes {
+		// Keep content that is there
+		f, err := files.Open(hash)
+		if err == nil {
+			f.Close()
+			continue
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		// Get content
+		r, err := m.Backend.Get(ctx, fileKey(snapshot.User, hash, snapshot.Sealed))
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+
+		data, err = m.unseal(snapshot, data)
+		if err != nil {
+			return err
+		}
+
+		// Content is stored by its hash, so it's checked on the way in
+		received, _, err := files.Put(bytes.NewReader(data), math.MaxInt64-1)
+		if err != nil {
+			return err
+		}
+		if received != hash {
+			files.Remove(received)
+			return fmt.Errorf("backup of attachment %s is corrupted", hash)
+		}
+	}
+
+	return nil
+}
+
+// Remove attachment content that no manifest references
+func (m *Manager) pruneFiles(ctx context.Context, objects []Object) error {
+	// Get referenced content of every user
+	referenced := make(map[string]bool)
+	for _, obj := range objects {
+		snapshotKey, ok := strings.CutSuffix(obj.Key, manifestExt)
+		if !ok {
+			continue
+		}
+		snapshot, ok := parseKey(Object{Key: snapshotKey})
+		if !ok {
+			continue
+		}
+
+		r, err := m.Backend.Get(ctx, obj.Key)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+
+		data, err = m.unseal(snapshot, data)
+		if err != nil {
+			return err
+		}
+
+		for _, hash := range strings.Fields(string(data)) {
+			referenced[fileKey(snapshot.User, hash, snapshot.Sealed)] = true
+		}
+	}
+
+	// Remove the rest
+	for _, obj := range objects {
+		if _, ok := parseFileKey(obj.Key); !ok || referenced[obj.Key] {
+			continue
+		}
+
+		err := m.Backend.Delete(ctx, obj.Key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Encrypt data of a sealed snapshot
+func (m *Manager) seal(snapshot Snapshot, data []byte) ([]byte, error) {
+	if !snapshot.Sealed {
+		return data, nil
+	}
+	return m.Sealer.Seal(snapshot.User, data)
+}
+
+// Decrypt data of a sealed snapshot
+func (m *Manager) unseal(snapshot Snapshot, data []byte) ([]byte, error) {
+	if !snapshot.Sealed {
+		return data, nil
+	}
+	if m.Sealer == nil {
+		return nil, errors.New("encrypted backups need the master key")
+	}
+	return m.Sealer.Unseal(snapshot.User, data)
+}
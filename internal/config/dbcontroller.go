@@ -2,11 +2,10 @@ package config
 
 import (
 	"database/sql"
-	"log"
+	"log/slog"
 
 	"github.com/dimitargrozev5/expenses-go-1/internal/ctrlrepo"
-	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
-	"github.com/dimitargrozev5/expenses-go-1/internal/repository"
+	"github.com/dimitargrozev5/expenses-go-1/internal/ratelimit"
 )
 
 // AppConfig holds the application config
@@ -18,10 +17,9 @@ type DBControllerConfig struct {
 	CtrlDBRepo     ctrlrepo.ControllerRepository
 	MigrationsPath string
 	JWTSecretKey   []byte //*ecdsa.PrivateKey
-	InfoLog        *log.Logger
-	ErrorLog       *log.Logger
-	DBConnections  map[string]*driver.DB
-	DBRepos        map[string]repository.DatabaseRepo
+	Logger         *slog.Logger
+	OpenDBs        *OpenDBs
+	RateLimiter    *ratelimit.Limiter
 }
 
 func (c DBControllerConfig) GetJWTSecretKey() []byte {
@@ -1,10 +1,10 @@
 package config
 
 import (
-	"log"
+	"log/slog"
 
-	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
-	"github.com/dimitargrozev5/expenses-go-1/internal/repository"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/userdb"
 )
 
 // AppConfig holds the application config
@@ -13,11 +13,12 @@ type DBNodeConfig struct {
 	InProduction      bool
 	ControllerAddress string
 	DBPath            string
+	UserDBs           *userdb.Store
+	AttachmentQuota   int64
 	JWTSecretKey      []byte //*ecdsa.PrivateKey
-	InfoLog           *log.Logger
-	ErrorLog          *log.Logger
-	DBConnections     map[string]*driver.DB
-	DBRepos           map[string]repository.DatabaseRepo
+	Logger            *slog.Logger
+	OpenDBs           *OpenDBs
+	CtrlClient        models.DatabaseClient
 }
 
 func (c DBNodeConfig) GetJWTSecretKey() []byte {
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"sync"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+	"github.com/dimitargrozev5/expenses-go-1/internal/repository"
+)
+
+// Connection and repo of an open user DB
+type OpenDB struct {
+	Conn *driver.DB
+	Repo repository.DatabaseRepo
+}
+
+// Open user DBs by user key. Safe for concurrent use by RPCs, metrics and shutdown
+type OpenDBs struct {
+	mu  sync.RWMutex
+	dbs map[string]OpenDB
+}
+
+func NewOpenDBs() *OpenDBs {
+	return &OpenDBs{dbs: map[string]OpenDB{}}
+}
+
+// Get open DB of a user
+func (o *OpenDBs) Get(userKey string) (OpenDB, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	db, ok := o.dbs[userKey]
+	return db, ok
+}
+
+// Add DB of a user unless one is already open. Returns false if the DB was not added
+func (o *OpenDBs) Add(userKey string, db OpenDB) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, ok := o.dbs[userKey]; ok {
+		return false
+	}
+
+	o.dbs[userKey] = db
+	return true
+}
+
+// Number of open DBs
+func (o *OpenDBs) Len() int {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return len(o.dbs)
+}
+
+// Copy of the open DBs, so callers can use them without holding the lock
+func (o *OpenDBs) All() map[string]OpenDB {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	dbs := make(map[string]OpenDB, len(o.dbs))
+	for userKey, db := range o.dbs {
+		dbs[userKey] = db
+	}
+	return dbs
+}
+
+// Remove all open DBs and return them for closing
+func (o *OpenDBs) RemoveAll() map[string]OpenDB {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	dbs := o.dbs
+	o.dbs = map[string]OpenDB{}
+	return dbs
+}
@@ -1,15 +1,14 @@
 package config
 
 import (
-	"log"
+	"log/slog"
 
 	"github.com/alexedwards/scs/v2"
 )
 
 // AppConfig holds the application config
 type AppConfig struct {
-	InfoLog      *log.Logger
-	ErrorLog     *log.Logger
+	Logger       *slog.Logger
 	InProduction bool
 	Session      *scs.SessionManager
 	DBPath       string
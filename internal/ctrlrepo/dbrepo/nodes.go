@@ -57,7 +57,7 @@ func (m *sqliteDBRepo) GetActiveNodes() ([]models.DBNode, error) {
 	defer cancel()
 
 	// Define query
-	query := `SELECT id, remote_address, created_at, updated_at FROM db_nodes WHERE LENGTH(remote_address) > 0;`
+	query := `SELECT id, remote_address, created_at, updated_at FROM db_nodes WHERE LENGTH(remote_address) > 0 AND deregistered_at IS NULL;`
 
 	// Get rows
 	rows, err := m.DB.QueryContext(ctx, query)
@@ -139,7 +139,7 @@ func (m *sqliteDBRepo) RegisterNode(params *models.DBNodeData) (*models.GrpcEmpt
 	defer tx.Rollback()
 
 	// Define query to insert account
-	stmt := `UPDATE db_nodes SET remote_address=$1 WHERE id=$2`
+	stmt := `UPDATE db_nodes SET remote_address=$1, deregistered_at=null, updated_at=CURRENT_TIMESTAMP WHERE id=$2`
 
 	// Execute query
 	_, err = tx.ExecContext(ctx, stmt, params.Address, params.ID)
@@ -150,3 +150,28 @@ func (m *sqliteDBRepo) RegisterNode(params *models.DBNodeData) (*models.GrpcEmpt
 	tx.Commit()
 	return nil, nil
 }
+
+func (m *sqliteDBRepo) DeregisterNode(params *models.DBNodeData) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Define query to mark node as stopped
+	stmt := `UPDATE db_nodes SET deregistered_at=CURRENT_TIMESTAMP, updated_at=CURRENT_TIMESTAMP WHERE id=$1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
@@ -0,0 +1,200 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+func (m *sqliteDBRepo) AddSession(userKey, jti, refreshTokenHash, device, remoteAddress string, expiresAt time.Time) (int64, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Define query to insert session
+	stmt := `INSERT INTO sessions (user_key, jti, refresh_token_hash, device, remote_address, expires_at) VALUES ($1, $2, $3, $4, $5, $6)`
+
+	// Execute query
+	res, err := tx.ExecContext(ctx, stmt, userKey, jti, refreshTokenHash, device, remoteAddress, expiresAt)
+	if err != nil {
+		return 0, err
+	}
+
+	// Get last inserted id
+	lid, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	tx.Commit()
+	return lid, nil
+}
+
+func (m *sqliteDBRepo) IsSessionActive(jti string) (bool, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Mark session as seen
+	// If no row is affected the session is expired, revoked or doesn't exist
+	stmt := `UPDATE sessions SET
+				last_seen_at = $1
+			WHERE jti = $2 AND revoked_at IS NULL AND expires_at > $1`
+
+	// Execute query
+	res, err := m.DB.ExecContext(ctx, stmt, time.Now(), jti)
+	if err != nil {
+		return false, err
+	}
+
+	// Get affected rows
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+func (m *sqliteDBRepo) RotateSession(refreshTokenHash, jti, newRefreshTokenHash string, expiresAt time.Time) (models.Session, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return models.Session{}, err
+	}
+	defer tx.Rollback()
+
+	// Get active session by refresh token
+	query := `SELECT id, user_key FROM sessions WHERE refresh_token_hash = $1 AND revoked_at IS NULL AND expires_at > $2`
+
+	// Scan row
+	session := models.Session{}
+	err = tx.QueryRowContext(ctx, query, refreshTokenHash, time.Now()).Scan(&session.ID, &session.UserKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return session, errors.New("invalid refresh token")
+	}
+	if err != nil {
+		return session, err
+	}
+
+	// Replace token identifiers
+	stmt := `UPDATE sessions SET
+				jti = $1,
+				refresh_token_hash = $2,
+				expires_at = $3,
+				last_seen_at = $4,
+				updated_at = $4
+			WHERE id = $5`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, jti, newRefreshTokenHash, expiresAt, time.Now(), session.ID)
+	if err != nil {
+		return session, err
+	}
+
+	tx.Commit()
+
+	session.Jti = jti
+	session.ExpiresAt = expiresAt
+
+	return session, nil
+}
+
+func (m *sqliteDBRepo) GetActiveSessions(userKey string) ([]models.Session, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	query := `SELECT id, user_key, jti, device, remote_address, expires_at, last_seen_at, created_at
+			FROM sessions
+			WHERE user_key = $1 AND revoked_at IS NULL AND expires_at > $2
+			ORDER BY last_seen_at DESC;`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query, userKey, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Set variable for sessions
+	sessions := make([]models.Session, 0)
+
+	// Scan rows
+	for rows.Next() {
+		// Define base models
+		session := models.Session{}
+
+		err = rows.Scan(
+			&session.ID,
+			&session.UserKey,
+			&session.Jti,
+			&session.Device,
+			&session.RemoteAddress,
+			&session.ExpiresAt,
+			&session.LastSeenAt,
+			&session.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Add to sessions
+		sessions = append(sessions, session)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (m *sqliteDBRepo) RevokeSession(userKey string, id int64) error {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	stmt := `UPDATE sessions SET revoked_at = $1, updated_at = $1 WHERE id = $2 AND user_key = $3 AND revoked_at IS NULL`
+
+	// Execute query
+	_, err := m.DB.ExecContext(ctx, stmt, time.Now(), id, userKey)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *sqliteDBRepo) RevokeSessionByJti(jti string) error {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	stmt := `UPDATE sessions SET revoked_at = $1, updated_at = $1 WHERE jti = $2 AND revoked_at IS NULL`
+
+	// Execute query
+	_, err := m.DB.ExecContext(ctx, stmt, time.Now(), jti)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
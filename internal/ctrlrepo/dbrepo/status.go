@@ -3,6 +3,8 @@ package dbrepo
 import (
 	"context"
 	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 )
 
 func (m sqliteDBRepo) GetVersion() (int64, error) {
@@ -22,3 +24,89 @@ func (m sqliteDBRepo) GetVersion() (int64, error) {
 
 	return userVersion, nil
 }
+
+func (m sqliteDBRepo) GetClusterStats() (models.ClusterStats, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	stats := models.ClusterStats{
+		UsersByStatus:    map[string]int64{},
+		UsersByNode:      map[int64]int64{},
+		UsersByDBVersion: map[int64]int64{},
+	}
+
+	// Get node counts
+	row := m.DB.QueryRowContext(ctx, `SELECT COUNT(*), COUNT(CASE WHEN LENGTH(remote_address) > 0 AND deregistered_at IS NULL THEN 1 END) FROM db_nodes`)
+	err := row.Scan(&stats.Nodes, &stats.ActiveNodes)
+	if err != nil {
+		return stats, err
+	}
+
+	// Get active sessions
+	row = m.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM sessions WHERE revoked_at IS NULL AND expires_at > $1`, time.Now())
+	err = row.Scan(&stats.ActiveSessions)
+	if err != nil {
+		return stats, err
+	}
+
+	// Get users by status
+	rows, err := m.DB.QueryContext(ctx, `SELECT s.name, COUNT(u.id) FROM user_status AS s LEFT JOIN users AS u ON u.status = s.id GROUP BY s.id`)
+	if err != nil {
+		return stats, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		var count int64
+		err = rows.Scan(&name, &count)
+		if err != nil {
+			return stats, err
+		}
+		stats.UsersByStatus[name] = count
+	}
+	if err = rows.Err(); err != nil {
+		return stats, err
+	}
+
+	// Get users by node
+	rows, err = m.DB.QueryContext(ctx, `SELECT db_node, COUNT(*) FROM users WHERE db_node IS NOT NULL GROUP BY db_node`)
+	if err != nil {
+		return stats, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var node, count int64
+		err = rows.Scan(&node, &count)
+		if err != nil {
+			return stats, err
+		}
+		stats.UsersByNode[node] = count
+	}
+	if err = rows.Err(); err != nil {
+		return stats, err
+	}
+
+	// Get users by db version
+	rows, err = m.DB.QueryContext(ctx, `SELECT IFNULL(db_version, 0), COUNT(*) FROM users GROUP BY db_version`)
+	if err != nil {
+		return stats, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version, count int64
+		err = rows.Scan(&version, &count)
+		if err != nil {
+			return stats, err
+		}
+		stats.UsersByDBVersion[version] = count
+	}
+	if err = rows.Err(); err != nil {
+		return stats, err
+	}
+
+	return stats, nil
+}
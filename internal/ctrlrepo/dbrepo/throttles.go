@@ -0,0 +1,99 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/ratelimit"
+)
+
+func (m *sqliteDBRepo) GetLoginLockout(scope, key string) (time.Time, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	query := `SELECT locked_until FROM login_throttles WHERE scope = $1 AND throttle_key = $2 AND locked_until > $3`
+
+	// Scan row
+	var lockedUntil time.Time
+	err := m.DB.QueryRowContext(ctx, query, scope, key, time.Now()).Scan(&lockedUntil)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return lockedUntil, nil
+}
+
+func (m *sqliteDBRepo) AddLoginFailure(scope, key string, backoff ratelimit.Backoff) (time.Time, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+
+	// Get earlier failures
+	query := `SELECT failures, last_failure_at FROM login_throttles WHERE scope = $1 AND throttle_key = $2`
+
+	// Scan row
+	var failures int64
+	var lastFailureAt time.Time
+	err = tx.QueryRowContext(ctx, query, scope, key).Scan(&failures, &lastFailureAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, err
+	}
+
+	// Forget old failures
+	if now.Sub(lastFailureAt) > backoff.Window {
+		failures = 0
+	}
+	failures++
+
+	// Get lockout
+	var lockedUntil sql.NullTime
+	if delay := backoff.Delay(failures); delay > 0 {
+		lockedUntil = sql.NullTime{Time: now.Add(delay), Valid: true}
+	}
+
+	// Define query to store failure
+	stmt := `INSERT INTO login_throttles (scope, throttle_key, failures, locked_until, last_failure_at) VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (scope, throttle_key) DO UPDATE SET
+				failures = excluded.failures,
+				locked_until = excluded.locked_until,
+				last_failure_at = excluded.last_failure_at,
+				updated_at = excluded.last_failure_at`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, scope, key, failures, lockedUntil, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	tx.Commit()
+	return lockedUntil.Time, nil
+}
+
+func (m *sqliteDBRepo) ClearLoginFailures(scope, key string) error {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	stmt := `DELETE FROM login_throttles WHERE scope = $1 AND throttle_key = $2`
+
+	// Execute query
+	_, err := m.DB.ExecContext(ctx, stmt, scope, key)
+	return err
+}
@@ -89,3 +89,34 @@ func (m sqliteDBRepo) AddNewUser(email string, password string, version int64) e
 
 	return nil
 }
+
+func (m sqliteDBRepo) GetUserEmails() ([]string, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, `SELECT user_email FROM users ORDER BY id;`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	emails := make([]string, 0)
+	for rows.Next() {
+		var email string
+		err = rows.Scan(&email)
+		if err != nil {
+			return nil, err
+		}
+
+		emails = append(emails, email)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
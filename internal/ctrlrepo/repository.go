@@ -1,19 +1,40 @@
 package ctrlrepo
 
-import "github.com/dimitargrozev5/expenses-go-1/internal/models"
+import (
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/ratelimit"
+)
 
 type ControllerRepository interface {
 	// DB status
 	GetVersion() (int64, error)
+	GetClusterStats() (models.ClusterStats, error)
 
 	// Users
 	GetMinUserVersion() (int64, error)
 	GetMaxUserVersion() (int64, error)
 	AddNewUser(email string, password string, version int64) error
+	GetUserEmails() ([]string, error)
 
 	// Node actions
 	GetNodes() ([]models.DBNode, error)
 	GetActiveNodes() ([]models.DBNode, error)
 	NewNode() (int64, error)
 	RegisterNode(params *models.DBNodeData) (*models.GrpcEmpty, error)
+	DeregisterNode(params *models.DBNodeData) (*models.GrpcEmpty, error)
+
+	// Sessions
+	AddSession(userKey, jti, refreshTokenHash, device, remoteAddress string, expiresAt time.Time) (int64, error)
+	IsSessionActive(jti string) (bool, error)
+	RotateSession(refreshTokenHash, jti, newRefreshTokenHash string, expiresAt time.Time) (models.Session, error)
+	GetActiveSessions(userKey string) ([]models.Session, error)
+	RevokeSession(userKey string, id int64) error
+	RevokeSessionByJti(jti string) error
+
+	// Login throttles
+	GetLoginLockout(scope, key string) (time.Time, error)
+	AddLoginFailure(scope, key string, backoff ratelimit.Backoff) (time.Time, error)
+	ClearLoginFailures(scope, key string) error
 }
@@ -0,0 +1,85 @@
+package dbnoderpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/attachments"
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+// Upload attachment. The first chunk has the attachment details
+func (m *DatabaseServer) UploadAttachment(stream models.Database_UploadAttachmentServer) error {
+	// Get db
+	db, ok := m.GetDB(stream.Context())
+	if !ok {
+		return fmt.Errorf("can't find user db connection")
+	}
+
+	// Get attachment details
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.Info == nil {
+		return domainerr.InvalidArgument("attachment details are required")
+	}
+
+	// Read content from the stream
+	content := attachments.NewChunkReader(first.Data, func() ([]byte, error) {
+		chunk, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return chunk.Data, nil
+	})
+
+	ret, err := db.AddAttachment(first.Info, content)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(ret)
+}
+
+// Download attachment. The first chunk has the attachment details
+func (m *DatabaseServer) DownloadAttachment(params *models.GetAttachmentParams, stream models.Database_DownloadAttachmentServer) error {
+	// Get db
+	db, ok := m.GetDB(stream.Context())
+	if !ok {
+		return fmt.Errorf("can't find user db connection")
+	}
+
+	info, content, err := db.OpenAttachment(params)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	// Send details
+	err = stream.Send(&models.AttachmentChunk{Info: info})
+	if err != nil {
+		return err
+	}
+
+	// Send content
+	return attachments.SendChunks(content, func(data []byte) error {
+		return stream.Send(&models.AttachmentChunk{Data: data})
+	})
+}
+
+func (m *DatabaseServer) DeleteAttachment(ctx context.Context, params *models.DeleteAttachmentParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.DeleteAttachment(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
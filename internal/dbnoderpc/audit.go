@@ -0,0 +1,38 @@
+package dbnoderpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+func (m *DatabaseServer) GetAuditLog(ctx context.Context, params *models.GrpcEmpty) (*models.GetAuditLogReturns, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.GetAuditLog(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) UndoLastAction(ctx context.Context, params *models.GrpcEmpty) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.UndoLastAction(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -2,15 +2,17 @@ package dbnoderpc
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"os"
-	"time"
 
-	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+	"github.com/dimitargrozev5/expenses-go-1/internal/config"
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/jwtutil"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 	"github.com/dimitargrozev5/expenses-go-1/internal/repository/dbrepo"
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/dimitargrozev5/expenses-go-1/internal/userdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (m *DatabaseServer) Authenticate(ctx context.Context, lc *models.LoginCredentials) (*models.LoginToken, error) {
@@ -19,83 +21,255 @@ func (m *DatabaseServer) Authenticate(ctx context.Context, lc *models.LoginCrede
 
 	// validate fields
 	if len(lc.Email) == 0 || len(lc.Password) == 0 {
-		return &loginResponse, fmt.Errorf("email and Password are required")
+		return &loginResponse, domainerr.InvalidArgument("email and password are required")
 	}
 
+	// Get user key
+	key := dbrepo.GetUserKey(lc.Email)
+
+	// Check login throttles before the password is checked
+	err := m.checkLoginAttempt(ctx, key, lc.RemoteAddress)
+	if err != nil {
+		return &loginResponse, err
+	}
+
+	// Get user db file
+	id := m.App.UserDBs.FileID(lc.Email)
+
 	// Check if user DB exists
-	_, err := os.Stat(dbrepo.GetUserDBPath(m.App.DBPath, lc.Email, true))
-	if errors.Is(err, os.ErrNotExist) {
+	if !m.App.UserDBs.Exists(id) {
 
 		// Write to error log
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(ctx, "authentication failed", "error", userdb.ErrNotFound)
+
+		// Count failure
+		m.recordLoginAttempt(ctx, key, lc.RemoteAddress, false)
 
 		// Return error
-		return &loginResponse, fmt.Errorf("invalid login credentials")
+		return &loginResponse, domainerr.New(codes.Unauthenticated, domainerr.ReasonUnauthenticated, "invalid login credentials")
 	}
 
 	// Create user connection
-	dbconn, err := driver.ConnectSQL(dbrepo.GetUserDBPath(m.App.DBPath, lc.Email, false))
+	dbconn, err := m.App.UserDBs.Open(id)
 	if err != nil {
 
 		// Write to error log
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(ctx, "authentication failed", "error", err)
 
 		// Return error
 		return &loginResponse, fmt.Errorf("server error")
 	}
 
 	// Get db repo
-	repo := dbrepo.NewSqliteRepo(m.App, lc.Email, dbconn.SQL)
+	repo := dbrepo.NewSqliteRepo(m.App, id, dbconn.SQL)
 
 	// Authenticate user
 	_, _, dbVersion, err := repo.Authenticate(lc.Password)
 	if err != nil {
 
 		// Write to error log
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(ctx, "authentication failed", "error", err)
 
-		return &loginResponse, fmt.Errorf("invalid login credentials")
+		// Count failure
+		m.recordLoginAttempt(ctx, key, lc.RemoteAddress, false)
+
+		m.App.UserDBs.Close(id, dbconn)
+		return &loginResponse, domainerr.New(codes.Unauthenticated, domainerr.ReasonUnauthenticated, "invalid login credentials")
 	}
 
-	// Get user key
-	key := dbrepo.GetUserKey(lc.Email)
+	// Add connection to repo. Connection of an earlier login is kept, since its requests may be running
+	if !m.App.OpenDBs.Add(key, config.OpenDB{Conn: dbconn, Repo: repo}) {
+		m.App.UserDBs.Close(id, dbconn)
+	}
+
+	// Ask for the second factor before issuing tokens
+	twoFactor, err := repo.GetTwoFactor(nil)
+	if err != nil {
+
+		// Write to error log
+		m.App.Logger.ErrorContext(ctx, "authentication failed", "error", err)
+
+		// Return error
+		return &loginResponse, fmt.Errorf("server error")
+	}
+	if twoFactor.Enabled {
+		challenge, err := jwtutil.Repo.GenerateChallengeToken(key)
+		if err != nil {
+			return &loginResponse, err
+		}
+
+		loginResponse.TwoFactorRequired = true
+		loginResponse.ChallengeToken = challenge
+		return &loginResponse, nil
+	}
+
+	return m.startSession(ctx, key, dbVersion, lc.Device, lc.RemoteAddress)
+}
+
+// Finish login with a one-time password or a recovery code
+func (m *DatabaseServer) VerifyTwoFactor(ctx context.Context, params *models.TwoFactorLoginParams) (*models.LoginToken, error) {
+
+	// Get user from the challenge
+	key, err := jwtutil.Repo.ParseChallengeToken(params.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Codes are throttled like passwords
+	err = m.checkLoginAttempt(ctx, key, params.RemoteAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get db. It's opened by the password step
+	db, ok := m.App.OpenDBs.Get(key)
+	if !ok {
+		return nil, errInvalidToken
+	}
+
+	// Check and use up code
+	_, err = db.Repo.CheckTwoFactor(&models.TwoFactorCodeParams{Code: params.Code})
+	if err != nil {
+
+		// Write to error log
+		m.App.Logger.ErrorContext(ctx, "two-factor authentication failed", "error", err)
+
+		// Count failure
+		m.recordLoginAttempt(ctx, key, params.RemoteAddress, false)
+
+		return nil, err
+	}
+
+	// Get user
+	user, err := db.Repo.GetUser(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.startSession(ctx, key, user.DBVersion, params.Device, params.RemoteAddress)
+}
+
+// Register a session with the DB Controller and issue its tokens
+func (m *DatabaseServer) startSession(ctx context.Context, key string, dbVersion int64, device, remoteAddress string) (*models.LoginToken, error) {
+
+	// Clear failed logins
+	m.recordLoginAttempt(ctx, key, remoteAddress, true)
+
+	// Register session with the DB Controller
+	session, err := m.App.CtrlClient.CreateSession(ctx, &models.CreateSessionParams{
+		UserKey:       key,
+		Device:        device,
+		RemoteAddress: remoteAddress,
+	})
+	if err != nil {
+
+		// Write to error log
+		m.App.Logger.ErrorContext(ctx, "authentication failed", "error", err)
 
-	// Add connection to repo
-	m.App.DBConnections[key] = dbconn
-	m.App.DBRepos[key] = repo
+		// Return error
+		return nil, fmt.Errorf("server error")
+	}
 
 	// Crate JWT to authenticate user
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, //jwt.SigningMethodES256,
-		jwt.MapClaims{
-			"userKey":   key,
-			"dbVersion": dbVersion,
-			"exp":       time.Now().Add(time.Hour * 24).Unix(),
-		})
-	jwt, err := t.SignedString(m.App.JWTSecretKey)
+	jwt, expiresAt, err := jwtutil.Repo.GenerateAccessToken(key, dbVersion, session.Jti)
 	if err != nil {
-		return &loginResponse, err
+		return nil, err
+	}
+
+	return &models.LoginToken{
+		Token:        jwt,
+		RefreshToken: session.RefreshToken,
+		ExpiresAt:    timestamppb.New(expiresAt),
+	}, nil
+}
+
+// Check login throttles kept by the DB Controller. Locked out logins get the retry info of the DB Controller
+func (m *DatabaseServer) checkLoginAttempt(ctx context.Context, key, remoteAddress string) error {
+	_, err := m.App.CtrlClient.CheckLoginAttempt(ctx, &models.LoginAttemptParams{
+		UserKey:       key,
+		RemoteAddress: remoteAddress,
+	})
+	if status.Code(err) == codes.ResourceExhausted {
+		return err
+	}
+	if err != nil {
+
+		// Write to error log
+		m.App.Logger.ErrorContext(ctx, "authentication failed", "error", err)
+
+		// Return error
+		return fmt.Errorf("server error")
 	}
 
-	// Add token to response
-	loginResponse.Token = jwt
+	return nil
+}
 
-	return &loginResponse, nil
+// Record result of a login with the DB Controller. Errors don't fail the login
+func (m *DatabaseServer) recordLoginAttempt(ctx context.Context, key, remoteAddress string, success bool) {
+	_, err := m.App.CtrlClient.RecordLoginAttempt(ctx, &models.LoginAttemptParams{
+		UserKey:       key,
+		RemoteAddress: remoteAddress,
+		Success:       success,
+	})
+	if err != nil {
+		m.App.Logger.ErrorContext(ctx, "can't record login attempt", "error", err)
+	}
 }
 
 // Handle posting to login
 func (m *DatabaseServer) Logout(ctx context.Context, params *models.LogoutParams) (*models.GrpcEmpty, error) {
 
+	// Get current token id
+	jti, ok := ctx.Value("jti").(string)
+	if !ok {
+		return nil, fmt.Errorf("can't find user session")
+	}
+
+	// Get user key
+	userKey, _ := ctx.Value("userKey").(string)
+
+	// Revoke session
+	_, err := m.App.CtrlClient.RevokeUserSession(ctx, &models.RevokeUserSessionParams{UserKey: userKey, Jti: jti})
+	if err != nil {
+		return nil, err
+	}
+
+	// Forget session
+	sessionCache.forget(jti)
+
+	return &models.GrpcEmpty{}, nil
+}
+
+// Exchange refresh token for a new access token
+func (m *DatabaseServer) RefreshToken(ctx context.Context, params *models.RefreshTokenParams) (*models.LoginToken, error) {
+
+	// Rotate session on the DB Controller
+	session, err := m.App.CtrlClient.RotateSession(ctx, &models.RotateSessionParams{RefreshToken: params.RefreshToken})
+	if err != nil {
+		return nil, err
+	}
+
 	// Get db
-	dbconn, ok := m.GetDBConn(ctx)
+	db, ok := m.App.OpenDBs.Get(session.UserKey)
 	if !ok {
-		return nil, fmt.Errorf("can't find user db connection")
+		return nil, errInvalidToken
+	}
+
+	// Get user
+	user, err := db.Repo.GetUser(nil)
+	if err != nil {
+		return nil, err
 	}
 
-	// Close connection
-	err := dbconn.SQL.Close()
+	// Crate JWT to authenticate user
+	jwt, expiresAt, err := jwtutil.Repo.GenerateAccessToken(session.UserKey, user.DBVersion, session.Jti)
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, nil
+	return &models.LoginToken{
+		Token:        jwt,
+		RefreshToken: session.RefreshToken,
+		ExpiresAt:    timestamppb.New(expiresAt),
+	}, nil
 }
@@ -0,0 +1,68 @@
+package dbnoderpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+func (m *DatabaseServer) GetExchangeRates(ctx context.Context, params *models.GrpcEmpty) (*models.GetExchangeRatesReturns, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.GetExchangeRates(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) SetExchangeRates(ctx context.Context, params *models.SetExchangeRatesParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.SetExchangeRates(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) DeleteExchangeRate(ctx context.Context, params *models.DeleteExchangeRateParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.DeleteExchangeRate(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) SetBaseCurrency(ctx context.Context, params *models.SetBaseCurrencyParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.SetBaseCurrency(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
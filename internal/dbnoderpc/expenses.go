@@ -66,3 +66,48 @@ func (m *DatabaseServer) DeleteExpense(ctx context.Context, params *models.Delet
 
 	return ret, nil
 }
+
+func (m *DatabaseServer) AddExpenseRefund(ctx context.Context, params *models.ExpenseRefundParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.AddExpenseRefund(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) DeleteExpenseRefund(ctx context.Context, params *models.DeleteExpenseRefundParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.DeleteExpenseRefund(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) SetExpenseReimbursement(ctx context.Context, params *models.SetExpenseReimbursementParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.SetExpenseReimbursement(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
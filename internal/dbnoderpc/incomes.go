@@ -0,0 +1,68 @@
+package dbnoderpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+func (m *DatabaseServer) GetIncomes(ctx context.Context, params *models.GrpcEmpty) (*models.GetIncomesReturns, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.GetIncomes(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) AddIncome(ctx context.Context, params *models.IncomeParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.AddIncome(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) EditIncome(ctx context.Context, params *models.IncomeParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.EditIncome(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) DeleteIncome(ctx context.Context, params *models.DeleteIncomeParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.DeleteIncome(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
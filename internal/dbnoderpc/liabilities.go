@@ -0,0 +1,68 @@
+package dbnoderpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+func (m *DatabaseServer) GetLiabilities(ctx context.Context, params *models.GrpcEmpty) (*models.GetLiabilitiesReturns, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.GetLiabilities(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) AddLiability(ctx context.Context, params *models.AddLiabilityParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.AddLiability(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) DeleteLiability(ctx context.Context, params *models.DeleteLiabilityParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.DeleteLiability(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) AddLiabilityPayment(ctx context.Context, params *models.LiabilityPaymentParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.AddLiabilityPayment(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
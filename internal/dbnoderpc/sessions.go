@@ -0,0 +1,121 @@
+package dbnoderpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+// How long a session checked with the DB Controller is trusted. Revoking clears the cache of the node
+// that handles the call only, so this bounds how long other nodes accept a revoked session
+const sessionCacheTTL = 3 * time.Second
+
+type cachedSession struct {
+	userKey     string
+	validatedAt time.Time
+}
+
+// Cache of active sessions, so not every call goes to the DB Controller
+type activeSessions struct {
+	mu       sync.Mutex
+	sessions map[string]cachedSession
+}
+
+var sessionCache = &activeSessions{sessions: map[string]cachedSession{}}
+
+// Check if a session was recently validated
+func (c *activeSessions) valid(jti string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.sessions[jti]
+	if !ok {
+		return false
+	}
+
+	if time.Since(s.validatedAt) > sessionCacheTTL {
+		delete(c.sessions, jti)
+		return false
+	}
+
+	return true
+}
+
+// Mark session as validated
+func (c *activeSessions) store(jti, userKey string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sessions[jti] = cachedSession{userKey: userKey, validatedAt: time.Now()}
+}
+
+// Remove a single session
+func (c *activeSessions) forget(jti string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.sessions, jti)
+}
+
+// Remove all sessions of a user
+func (c *activeSessions) forgetUser(userKey string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for jti, s := range c.sessions {
+		if s.userKey == userKey {
+			delete(c.sessions, jti)
+		}
+	}
+}
+
+// Check with the DB Controller if session is still active
+func (m *DatabaseServer) validateSession(ctx context.Context, jti, userKey string) error {
+	if sessionCache.valid(jti) {
+		return nil
+	}
+
+	_, err := m.App.CtrlClient.ValidateSession(ctx, &models.ValidateSessionParams{Jti: jti})
+	if err != nil {
+		return err
+	}
+
+	sessionCache.store(jti, userKey)
+
+	return nil
+}
+
+func (m *DatabaseServer) GetSessions(ctx context.Context, params *models.GrpcEmpty) (*models.GetSessionsReturns, error) {
+	// Get user key
+	userKey, ok := ctx.Value("userKey").(string)
+	if !ok {
+		return nil, fmt.Errorf("can't find user")
+	}
+
+	// Get current token id
+	jti, _ := ctx.Value("jti").(string)
+
+	return m.App.CtrlClient.GetUserSessions(ctx, &models.UserSessionsParams{UserKey: userKey, CurrentJti: jti})
+}
+
+func (m *DatabaseServer) RevokeSession(ctx context.Context, params *models.RevokeSessionParams) (*models.GrpcEmpty, error) {
+	// Get user key
+	userKey, ok := ctx.Value("userKey").(string)
+	if !ok {
+		return nil, fmt.Errorf("can't find user")
+	}
+
+	// Revoke session
+	_, err := m.App.CtrlClient.RevokeUserSession(ctx, &models.RevokeUserSessionParams{UserKey: userKey, ID: params.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoked session must be checked again
+	sessionCache.forgetUser(userKey)
+
+	return &models.GrpcEmpty{}, nil
+}
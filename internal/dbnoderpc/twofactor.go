@@ -0,0 +1,83 @@
+package dbnoderpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+func (m *DatabaseServer) GetTwoFactor(ctx context.Context, params *models.GrpcEmpty) (*models.GrpcTwoFactor, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.GetTwoFactor(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) BeginTwoFactorSetup(ctx context.Context, params *models.GrpcEmpty) (*models.BeginTwoFactorSetupReturns, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.BeginTwoFactorSetup(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) EnableTwoFactor(ctx context.Context, params *models.TwoFactorCodeParams) (*models.RecoveryCodesReturns, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.EnableTwoFactor(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) DisableTwoFactor(ctx context.Context, params *models.TwoFactorCodeParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.DisableTwoFactor(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) RegenerateRecoveryCodes(ctx context.Context, params *models.TwoFactorCodeParams) (*models.RecoveryCodesReturns, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.RegenerateRecoveryCodes(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
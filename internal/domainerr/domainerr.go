@@ -0,0 +1,212 @@
+package domainerr
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain of the error details attached to statuses
+const Domain = "expenses"
+
+// Reasons attached to the error details
+const (
+	ReasonNotFound         = "NOT_FOUND"
+	ReasonInvalidArgument  = "INVALID_ARGUMENT"
+	ReasonConflict         = "CONFLICT"
+	ReasonRuleViolation    = "RULE_VIOLATION"
+	ReasonForeignKey       = "FOREIGN_KEY_VIOLATION"
+	ReasonInternal         = "INTERNAL"
+	ReasonUnauthenticated  = "UNAUTHENTICATED"
+	ReasonPermissionDenied = "PERMISSION_DENIED"
+	ReasonQuotaExceeded    = "QUOTA_EXCEEDED"
+	ReasonRateLimited      = "RATE_LIMITED"
+)
+
+// Error that can be shown to the user
+type Error struct {
+	Code    codes.Code
+	Reason  string
+	Message string
+	Err     error
+
+	// Time after which the call can be retried. Sent as retry info when set
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil && e.Err.Error() != e.Message {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Convert error to a gRPC status with error details
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.Code, e.Message)
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: e.Reason,
+		Domain: Domain,
+	}}
+	if e.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)})
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+// Create new domain error
+func New(code codes.Code, reason, message string) error {
+	return &Error{Code: code, Reason: reason, Message: message}
+}
+
+// Create error for invalid input
+func InvalidArgument(message string) error {
+	return New(codes.InvalidArgument, ReasonInvalidArgument, message)
+}
+
+// Create error for missing records
+func NotFound(message string) error {
+	return New(codes.NotFound, ReasonNotFound, message)
+}
+
+// Create error for calls that are throttled
+func ResourceExhausted(message string, retryAfter time.Duration) error {
+	return &Error{Code: codes.ResourceExhausted, Reason: ReasonRateLimited, Message: message, RetryAfter: retryAfter}
+}
+
+// Map repository errors to domain errors
+func FromSQLite(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// Already mapped
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return err
+	}
+
+	// No rows found
+	if errors.Is(err, sql.ErrNoRows) {
+		return &Error{Code: codes.NotFound, Reason: ReasonNotFound, Message: "record not found", Err: err}
+	}
+
+	// Only constraint errors carry a reason for the user
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) || sqliteErr.Code != sqlite3.ErrConstraint {
+		return err
+	}
+
+	switch sqliteErr.ExtendedCode {
+	case sqlite3.ErrConstraintTrigger:
+		// Message comes from RAISE (ABORT, '...') in a trigger
+		return &Error{Code: codes.FailedPrecondition, Reason: ReasonRuleViolation, Message: sqliteErr.Error(), Err: err}
+	case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+		return &Error{Code: codes.AlreadyExists, Reason: ReasonConflict, Message: "record already exists", Err: err}
+	case sqlite3.ErrConstraintForeignKey:
+		return &Error{Code: codes.FailedPrecondition, Reason: ReasonForeignKey, Message: "record is referenced by other records", Err: err}
+	case sqlite3.ErrConstraintCheck, sqlite3.ErrConstraintNotNull:
+		return &Error{Code: codes.InvalidArgument, Reason: ReasonInvalidArgument, Message: "invalid value", Err: err}
+	}
+
+	return &Error{Code: codes.FailedPrecondition, Reason: ReasonRuleViolation, Message: sqliteErr.Error(), Err: err}
+}
+
+// Convert any error returned by a handler to a gRPC status error
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// Keep errors that are already statuses
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	err = FromSQLite(err)
+
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.GRPCStatus().Err()
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// Get a message that can be shown to the user, or fallback if error isn't a domain error
+func UserMessage(err error, fallback string) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return fallback
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != Domain || info.Reason == ReasonInternal {
+			continue
+		}
+
+		return capitalize(st.Message())
+	}
+
+	return fallback
+}
+
+// Get time after which a throttled call can be retried
+func RetryAfter(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.RetryInfo)
+		if ok {
+			return info.RetryDelay.AsDuration(), true
+		}
+	}
+
+	return 0, false
+}
+
+// Get error reason from status details
+func Reason(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return ""
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == Domain {
+			return info.Reason
+		}
+	}
+
+	return ""
+}
+
+func capitalize(s string) string {
+	if len(s) == 0 {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,159 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// Pages copied in a backup step. Writers get the db between steps
+const stepPages = 256
+
+// Pause between backup steps
+const stepPause = 10 * time.Millisecond
+
+// Copy a db into a new file
+func CopyToFile(ctx context.Context, src *sql.DB, file string) error {
+	dst, err := sql.Open("sqlite3", file)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	return CopyDB(ctx, dst, src)
+}
+
+// Copy a db file into a db. The content of the destination is replaced
+func CopyFromFile(ctx context.Context, file string, dst *sql.DB) error {
+	src, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=ro", file))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	return CopyDB(ctx, dst, src)
+}
+
+// Copy the main db of a connection into another with the online backup API.
+// Copying restarts when the source is written between steps, so the copy is consistent
+func CopyDB(ctx context.Context, dst, src *sql.DB) error {
+	dstConn, err := dst.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer dstConn.Close()
+
+	srcConn, err := src.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer srcConn.Close()
+
+	return dstConn.Raw(func(dstDriverConn any) error {
+		return srcConn.Raw(func(srcDriverConn any) error {
+			dstSqlite, ok := dstDriverConn.(*sqlite3.SQLiteConn)
+			if !ok {
+				return fmt.Errorf("backup destination isn't a sqlite connection")
+			}
+			srcSqlite, ok := srcDriverConn.(*sqlite3.SQLiteConn)
+			if !ok {
+				return fmt.Errorf("backup source isn't a sqlite connection")
+			}
+
+			backup, err := dstSqlite.Backup("main", srcSqlite, "main")
+			if err != nil {
+				return err
+			}
+
+			for {
+				done, err := backup.Step(stepPages)
+				if err != nil {
+					backup.Close()
+					return err
+				}
+				if done {
+					return backup.Close()
+				}
+
+				select {
+				case <-ctx.Done():
+					backup.Close()
+					return ctx.Err()
+				case <-time.After(stepPause):
+				}
+			}
+		})
+	})
+}
+
+// Get a consistent copy of a db as bytes
+func Serialize(ctx context.Context, src *sql.DB) ([]byte, error) {
+	// Copy db to a private in-memory db
+	mem, err := OpenMemory(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer mem.Close()
+
+	err = CopyDB(ctx, mem, src)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := mem.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var data []byte
+	err = conn.Raw(func(driverConn any) error {
+		sqliteConn, ok := driverConn.(*sqlite3.SQLiteConn)
+		if !ok {
+			return fmt.Errorf("can't serialize a connection that isn't sqlite")
+		}
+		data, err = sqliteConn.Serialize("main")
+		return err
+	})
+
+	return data, err
+}
+
+// Open a private in-memory db with the content of a serialized db. Nil data opens an empty db.
+// The db has a single connection, since every connection to :memory: is a separate db
+func OpenMemory(ctx context.Context, data []byte) (*sql.DB, error) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+
+	if data == nil {
+		return db, nil
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn any) error {
+		sqliteConn, ok := driverConn.(*sqlite3.SQLiteConn)
+		if !ok {
+			return fmt.Errorf("can't deserialize into a connection that isn't sqlite")
+		}
+		return sqliteConn.Deserialize(data, "main")
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
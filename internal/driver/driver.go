@@ -12,8 +12,6 @@ type DB struct {
 	SQL *sql.DB
 }
 
-var dbConn = &DB{}
-
 const maxOpenDbConn = 10
 const maxIdleDbConn = 5
 const maxDbLifetime = 5 * time.Minute
@@ -22,14 +20,15 @@ const maxDbLifetime = 5 * time.Minute
 func ConnectSQL(dsn string) (*DB, error) {
 	db, err := NewDatabase(dsn)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	db.SetMaxOpenConns(maxOpenDbConn)
 	db.SetMaxIdleConns(maxIdleDbConn)
 	db.SetConnMaxLifetime(maxDbLifetime)
 
-	dbConn.SQL = db
+	// Every connection gets its own pool
+	dbConn := &DB{SQL: db}
 
 	err = testDB(db)
 	if err != nil {
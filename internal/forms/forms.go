@@ -2,11 +2,14 @@ package forms
 
 import (
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
 )
 
 // Valid return true if there are no errors
@@ -65,9 +68,9 @@ func (f *Form) MinLength(field string, length int) bool {
 	return true
 }
 
-func (f *Form) IsFloat64(field string) bool {
+func (f *Form) IsInt(field string) bool {
 	x := f.Get(field)
-	_, err := strconv.ParseFloat(x, 64)
+	_, err := strconv.ParseInt(x, 10, 64)
 	if err != nil {
 		f.Errors.Add(field, "This field must be a number")
 		return false
@@ -75,25 +78,84 @@ func (f *Form) IsFloat64(field string) bool {
 	return true
 }
 
-func (f *Form) IsInt(field string) bool {
+// Min compares decimals exactly, so 0.1 + 0.2 style rounding can't let values slip through
+func (f *Form) Min(field string, min float64) bool {
 	x := f.Get(field)
-	_, err := strconv.ParseInt(x, 10, 64)
-	if err != nil {
+	val, ok := new(big.Rat).SetString(strings.TrimSpace(x))
+	if !ok {
 		f.Errors.Add(field, "This field must be a number")
 		return false
 	}
+	if val.Cmp(new(big.Rat).SetFloat64(min)) < 0 {
+		f.Errors.Add(field, fmt.Sprintf("Value is too small. Min value is %s", strconv.FormatFloat(min, 'f', -1, 64)))
+		return false
+	}
 	return true
 }
 
-func (f *Form) Min(field string, min float64) bool {
+// IsMoney checks the field is an amount with no more decimals than the currency allows
+func (f *Form) IsMoney(field string, currency string) bool {
 	x := f.Get(field)
-	val, err := strconv.ParseFloat(x, 64)
+	_, err := money.Parse(x, currency)
+	if err == money.ErrPrecision {
+		f.Errors.Add(field, fmt.Sprintf("This field can have at most %d decimal places", money.Exponent(currency)))
+		return false
+	}
 	if err != nil {
-		f.Errors.Add(field, "This field must be a number")
+		f.Errors.Add(field, "This field must be an amount")
+		return false
+	}
+	return true
+}
+
+// MinMoney checks the field is an amount not smaller than min
+func (f *Form) MinMoney(field string, min money.Money) bool {
+	val, err := money.Parse(f.Get(field), min.Currency)
+	if err != nil {
+		f.Errors.Add(field, "This field must be an amount")
+		return false
+	}
+	if val.Amount < min.Amount {
+		f.Errors.Add(field, fmt.Sprintf("Value is too small. Min value is %s", min.Decimal()))
+		return false
+	}
+	return true
+}
+
+// Money returns the field parsed as an amount in the currency. Validate with IsMoney first
+func (f *Form) Money(field string, currency string) money.Money {
+	val, _ := money.Parse(f.Get(field), currency)
+	return val
+}
+
+// IsCurrency checks the field is a three letter currency code
+func (f *Form) IsCurrency(field string) bool {
+	if !money.ValidCurrency(money.Normalize(f.Get(field))) {
+		f.Errors.Add(field, "This field must be a currency code like EUR")
+		return false
+	}
+	return true
+}
+
+// IsRate checks the field is a positive exchange rate
+func (f *Form) IsRate(field string) bool {
+	_, err := money.ParseRate(f.Get(field))
+	if err != nil {
+		f.Errors.Add(field, "This field must be a positive rate like 1.0875")
+		return false
+	}
+	return true
+}
+
+// IsPercent checks the field is a positive percent with at most 2 decimal places
+func (f *Form) IsPercent(field string) bool {
+	val, err := money.ParsePercent(f.Get(field))
+	if err == money.ErrPrecision {
+		f.Errors.Add(field, "This field can have at most 2 decimal places")
 		return false
 	}
-	if val < min {
-		f.Errors.Add(field, fmt.Sprintf("Value is too small. Min value is %0f", val))
+	if err != nil || val < 0 {
+		f.Errors.Add(field, "This field must be a positive percent")
 		return false
 	}
 	return true
@@ -124,6 +186,8 @@ func StringToTime(s string) (time.Time, error) {
 	return time.Parse("2006-01-02T15:04", s)
 }
 
+// Format a time as the value of a datetime-local input. HTML fixes this format and browsers show
+// it in the format of the device, so it doesn't follow the user settings. Shown dates use prefs
 func TimeToString(t time.Time) string {
 	return fmt.Sprintf("%d-%02d-%02dT%02d:%02d", t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute())
 }
@@ -5,7 +5,9 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
 	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 	"github.com/dimitargrozev5/expenses-go-1/views/accountsview"
@@ -17,7 +19,7 @@ func (m *Repository) Accounts(w http.ResponseWriter, r *http.Request) {
 	// Get all accounts
 	accounts, err := m.DBClient.GetAccounts(r.Context(), &models.GetAccountsParams{OrderByPopularity: false})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting accounts")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
@@ -26,7 +28,7 @@ func (m *Repository) Accounts(w http.ResponseWriter, r *http.Request) {
 	// Get tags
 	tags, err := m.DBClient.GetTags(r.Context(), nil)
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting accounts")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
@@ -35,7 +37,7 @@ func (m *Repository) Accounts(w http.ResponseWriter, r *http.Request) {
 	// Get user
 	user, err := m.DBClient.GetUser(r.Context(), nil)
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting accounts")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
@@ -47,6 +49,7 @@ func (m *Repository) Accounts(w http.ResponseWriter, r *http.Request) {
 		Form: map[string]*forms.Form{
 			"add-account":       forms.New(nil),
 			"modify-free-funds": forms.New(nil),
+			"transfer-funds":    forms.New(nil),
 		},
 	}
 
@@ -74,6 +77,8 @@ func (m *Repository) Accounts(w http.ResponseWriter, r *http.Request) {
 	data := accountsview.AccountsData{
 		TemplateData: td,
 		Accounts:     accounts.Accounts,
+		Total:        accounts.Total,
+		MissingRates: accounts.MissingRates,
 		FreeFunds:    user.FreeFunds,
 		Tags:         tags.Tags,
 	}
@@ -94,6 +99,9 @@ func (m *Repository) PostNewAccount(w http.ResponseWriter, r *http.Request) {
 	form := forms.New(r.PostForm)
 	form.Required("name")
 	form.MinLength("name", 4)
+	if form.Get("currency") != "" {
+		form.IsCurrency("currency")
+	}
 
 	if !form.Valid() {
 
@@ -109,12 +117,13 @@ func (m *Repository) PostNewAccount(w http.ResponseWriter, r *http.Request) {
 
 	// Get data
 	name := form.Get("name")
+	currency := strings.ToUpper(strings.TrimSpace(form.Get("currency")))
 
 	// Add expense to database
-	_, err = m.DBClient.AddAccount(r.Context(), &models.AddAccountParams{Name: name})
+	_, err = m.DBClient.AddAccount(r.Context(), &models.AddAccountParams{Name: name, Currency: currency})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
-		m.AddErrorMsg(r, "Failed to add account")
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to add account"))
 		http.Redirect(w, r, "/accounts", http.StatusSeeOther)
 		return
 	}
@@ -135,7 +144,7 @@ func (m *Repository) PostModifyFreeFunds(w http.ResponseWriter, r *http.Request)
 	// Get form and validate fields
 	form := forms.New(r.PostForm)
 	form.Required("amount", "to-account", "tag")
-	form.IsFloat64("amount")
+	form.IsMoney("amount", m.AccountCurrency(r, form.Get("to-account")))
 	form.IsInt("to-account")
 
 	if !form.Valid() {
@@ -151,15 +160,15 @@ func (m *Repository) PostModifyFreeFunds(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Get data
-	amount, _ := strconv.ParseFloat(form.Get("amount"), 64)
+	amount := form.Money("amount", m.AccountCurrency(r, form.Get("to-account")))
 	toAccount, _ := strconv.ParseInt(form.Get("to-account"), 10, 64)
 	tag := form.Get("tag")
 
 	// Add expense to database
-	_, err = m.DBClient.ModifyFreeFunds(r.Context(), &models.ModifyFreeFundsParams{Amount: amount, ToAccountId: toAccount, TagName: tag})
+	_, err = m.DBClient.ModifyFreeFunds(r.Context(), &models.ModifyFreeFundsParams{Amount: models.MoneyToGrpc(amount), ToAccountId: toAccount, TagName: tag})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
-		m.AddErrorMsg(r, "Failed to modify free funds")
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to modify free funds"))
 		http.Redirect(w, r, "/accounts", http.StatusSeeOther)
 		return
 	}
@@ -169,6 +178,63 @@ func (m *Repository) PostModifyFreeFunds(w http.ResponseWriter, r *http.Request)
 	http.Redirect(w, r, "/accounts", http.StatusSeeOther)
 }
 
+func (m *Repository) PostTransferFunds(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	form.Required("amount", "from-account", "to-account")
+	form.IsInt("from-account")
+	form.IsInt("to-account")
+	form.IsMoney("amount", m.AccountCurrency(r, form.Get("from-account")))
+	if form.Get("rate") != "" {
+		form.IsRate("rate")
+	}
+	if form.Get("from-account") == form.Get("to-account") {
+		form.Errors.Add("to-account", "Choose a different account")
+	}
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"transfer-funds": form,
+		})
+
+		// Redirect to accounts
+		http.Redirect(w, r, "/accounts", http.StatusSeeOther)
+		return
+	}
+
+	// Get data
+	amount := form.Money("amount", m.AccountCurrency(r, form.Get("from-account")))
+	fromAccount, _ := strconv.ParseInt(form.Get("from-account"), 10, 64)
+	toAccount, _ := strconv.ParseInt(form.Get("to-account"), 10, 64)
+
+	// Transfer funds
+	_, err = m.DBClient.TransferFunds(r.Context(), &models.TransferFundsParams{
+		FromAccount: &models.GrpcAccount{ID: fromAccount},
+		ToAccount:   &models.GrpcAccount{ID: toAccount},
+		Amount:      models.MoneyToGrpc(amount),
+		Rate:        form.Get("rate"),
+	})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to transfer funds"))
+		http.Redirect(w, r, "/accounts", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Funds transferred")
+	http.Redirect(w, r, "/accounts", http.StatusSeeOther)
+}
+
 func (m *Repository) PostMoveAccount(direction int) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Parse form
@@ -224,8 +290,8 @@ func (m *Repository) PostMoveAccount(direction int) func(w http.ResponseWriter,
 		// Update account position
 		_, err = m.DBClient.ReorderAccount(r.Context(), &models.ReorderAccountParams{Account: account, Direction: int64(direction)})
 		if err != nil {
-			m.App.ErrorLog.Println(err)
-			m.AddErrorMsg(r, "Failed to move account")
+			m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+			m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to move account"))
 			http.Redirect(w, r, "/accounts", http.StatusSeeOther)
 			return
 		}
@@ -254,8 +320,8 @@ func (m *Repository) PostDeleteAccount(w http.ResponseWriter, r *http.Request) {
 	// Delete account from database
 	_, err = m.DBClient.DeleteAccount(r.Context(), &models.DeleteAccountParams{ID: id})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
-		m.AddErrorMsg(r, "Failed to delete account")
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete account"))
 		http.Redirect(w, r, "/accounts", http.StatusSeeOther)
 		return
 	}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/views/activityview"
+)
+
+func (m *Repository) Activity(w http.ResponseWriter, r *http.Request) {
+
+	// Get audit log
+	log, err := m.DBClient.GetAuditLog(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting activity")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get accounts
+	accounts, err := m.DBClient.GetAccounts(r.Context(), &models.GetAccountsParams{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get categories
+	categories, err := m.DBClient.GetCategories(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get names by id
+	accountNames := make(map[int64]string, len(accounts.Accounts))
+	for _, account := range accounts.Accounts {
+		accountNames[account.ID] = account.Name
+	}
+	categoryNames := make(map[int64]string, len(categories.Categories))
+	for _, category := range categories.Categories {
+		categoryNames[category.ID] = category.Name
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Activity",
+		Form:  map[string]*forms.Form{},
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := activityview.ActivityData{
+		TemplateData: td,
+		Entries:      log.Entries,
+		UndoId:       log.UndoId,
+		Currency:     m.Currency(r),
+		Accounts:     accountNames,
+		Categories:   categoryNames,
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+func (m *Repository) PostUndo(w http.ResponseWriter, r *http.Request) {
+
+	// Undo last action
+	_, err := m.DBClient.UndoLastAction(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to undo last action"))
+		http.Redirect(w, r, "/activity", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Last action undone")
+	http.Redirect(w, r, "/activity", http.StatusSeeOther)
+}
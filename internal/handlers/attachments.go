@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/attachments"
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/go-chi/chi"
+)
+
+// Largest file that can be uploaded. The web server limits upload bodies to it, and the DB Node also checks the user's quota
+const MaxUploadSize = 20 * 1024 * 1024
+
+// Content types shown in the browser. Anything else is downloaded
+var inlineTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp", "application/pdf"}
+
+// Attach a file to an expense
+func (m *Repository) PostExpenseAttachment(w http.ResponseWriter, r *http.Request) {
+
+	// Get expense id from route param
+	idParam := chi.URLParam(r, "expenseId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid expense")
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Get file. The form is already parsed by the CSRF check
+	form := forms.New(nil)
+	file, header, err := r.FormFile("file")
+	if err == nil && header.Size > MaxUploadSize {
+		file.Close()
+		err = fmt.Errorf("file is too large")
+	}
+	if err != nil {
+		form.Errors.Add("file", fmt.Sprintf("Choose a file up to %d MB", MaxUploadSize/1024/1024))
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			fmt.Sprintf("attach-%d", id): form,
+		})
+
+		// Redirect to expenses
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+	defer file.Close()
+
+	// Upload file
+	_, err = m.uploadAttachment(r, &models.GrpcAttachment{
+		ExpenseId:   id,
+		Name:        filepath.Base(header.Filename),
+		ContentType: header.Header.Get("Content-Type"),
+	}, file)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to attach file"))
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "File attached")
+	http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+}
+
+// Stream a file to the attachment store
+func (m *Repository) uploadAttachment(r *http.Request, info *models.GrpcAttachment, content io.Reader) (*models.GrpcAttachment, error) {
+	stream, err := m.DBClient.UploadAttachment(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// Send details
+	err = stream.Send(&models.AttachmentChunk{Info: info})
+	if err != nil {
+		_, err = stream.CloseAndRecv()
+		return nil, err
+	}
+
+	// Send content. Errors of the call are returned on close
+	err = attachments.SendChunks(content, func(data []byte) error {
+		return stream.Send(&models.AttachmentChunk{Data: data})
+	})
+	if err != nil && !errors.Is(err, io.EOF) {
+		stream.CloseSend()
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// Download an attachment
+func (m *Repository) Attachment(w http.ResponseWriter, r *http.Request) {
+
+	// Get attachment id from route param
+	idParam := chi.URLParam(r, "attachmentId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	// Open download
+	stream, err := m.DBClient.DownloadAttachment(r.Context(), &models.GetAttachmentParams{ID: id})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		http.Error(w, "Failed to get attachment", http.StatusInternalServerError)
+		return
+	}
+
+	// Get details. Errors of the call come with the first message
+	first, err := stream.Recv()
+	if err != nil || first.Info == nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		if domainerr.Reason(err) == domainerr.ReasonNotFound {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, "Failed to get attachment", http.StatusInternalServerError)
+		return
+	}
+	info := first.Info
+
+	// Set headers. Only safe types are shown in the browser
+	contentType := info.ContentType
+	disposition := "attachment"
+	for _, t := range inlineTypes {
+		if strings.EqualFold(contentType, t) {
+			disposition = "inline"
+		}
+	}
+	if disposition == "attachment" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": info.Name}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+
+	// Copy content
+	_, err = io.Copy(w, attachments.NewChunkReader(first.Data, func() ([]byte, error) {
+		chunk, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return chunk.Data, nil
+	}))
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "attachment download failed", "error", err)
+	}
+}
+
+// Delete an attachment
+func (m *Repository) PostDeleteAttachment(w http.ResponseWriter, r *http.Request) {
+
+	// Get attachment id from route param
+	idParam := chi.URLParam(r, "attachmentId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid attachment")
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Delete attachment
+	_, err = m.DBClient.DeleteAttachment(r.Context(), &models.DeleteAttachmentParams{ID: id})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete attachment"))
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Attachment deleted")
+	http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+}
@@ -7,10 +7,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
 	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
 	"github.com/dimitargrozev5/expenses-go-1/views/categoriesview"
 	"github.com/go-chi/chi"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (m *Repository) Categories(w http.ResponseWriter, r *http.Request) {
@@ -18,7 +21,7 @@ func (m *Repository) Categories(w http.ResponseWriter, r *http.Request) {
 	// Get all categories
 	categories, err := m.DBClient.GetCategoriesOverview(r.Context(), nil)
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting categories")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
@@ -27,7 +30,7 @@ func (m *Repository) Categories(w http.ResponseWriter, r *http.Request) {
 	// Get time periods
 	periods, err := m.DBClient.GetTimePeriods(r.Context(), nil)
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting categories")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
@@ -36,7 +39,7 @@ func (m *Repository) Categories(w http.ResponseWriter, r *http.Request) {
 	// Get user data
 	user, err := m.DBClient.GetUser(r.Context(), nil)
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting categories")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
@@ -66,6 +69,8 @@ func (m *Repository) Categories(w http.ResponseWriter, r *http.Request) {
 		moveUp := fmt.Sprintf("move-up-%d", category.ID)
 		moveDown := fmt.Sprintf("move-down-%d", category.ID)
 		delete := fmt.Sprintf("delete-%d", category.ID)
+		autoReset := fmt.Sprintf("auto-reset-%d", category.ID)
+		goal := fmt.Sprintf("goal-%d", category.ID)
 
 		// Add forms
 		td.Form[moveUp] = forms.NewFromMap(map[string]string{
@@ -75,6 +80,8 @@ func (m *Repository) Categories(w http.ResponseWriter, r *http.Request) {
 			"table_order": fmt.Sprintf("%d", category.TableOrder),
 		})
 		td.Form[delete] = forms.New(nil)
+		td.Form[autoReset] = forms.New(nil)
+		td.Form[goal] = forms.NewFromMap(goalFormValues(category))
 	}
 
 	// Add default data
@@ -97,7 +104,7 @@ func (m *Repository) PostNewCategory(w http.ResponseWriter, r *http.Request) {
 	// Parse form
 	err := r.ParseForm()
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 	}
 
 	// Get form and validate fields
@@ -105,17 +112,20 @@ func (m *Repository) PostNewCategory(w http.ResponseWriter, r *http.Request) {
 	form.Required("name", "budget_input", "spending_limit", "input_interval", "input_period")
 	form.MinLength("name", 4)
 
-	form.IsFloat64("budget_input")
-	form.Min("budget_input", 0)
+	form.IsMoney("budget_input", m.Currency(r))
+	form.MinMoney("budget_input", money.New(0, m.Currency(r)))
 
-	form.IsFloat64("spending_limit")
-	form.Min("spending_limit", 0)
+	form.IsMoney("spending_limit", m.Currency(r))
+	form.MinMoney("spending_limit", money.New(0, m.Currency(r)))
 
 	form.IsInt("input_interval")
 	form.Min("input_interval", 1)
 
 	form.IsInt("input_period")
 
+	rolloverPolicy, rolloverCap := formRollover(form)
+	goalAmount, goalDate := formGoal(form, m.Currency(r))
+
 	if !form.Valid() {
 
 		// Push form to session
@@ -130,22 +140,27 @@ func (m *Repository) PostNewCategory(w http.ResponseWriter, r *http.Request) {
 
 	// Get data
 	name := form.Get("name")
-	budgetInput, _ := strconv.ParseFloat(form.Get("budget_input"), 64)
-	spendingLimit, _ := strconv.ParseFloat(form.Get("spending_limit"), 64)
+	budgetInput := form.Money("budget_input", m.Currency(r))
+	spendingLimit := form.Money("spending_limit", m.Currency(r))
 	inputInterval, _ := strconv.ParseInt(form.Get("input_interval"), 10, 64)
 	inputPeriod, _ := strconv.ParseInt(form.Get("input_period"), 10, 64)
 
 	// Add category to database
 	_, err = m.DBClient.AddCategory(r.Context(), &models.AddCategoryParams{
-		Name:          name,
-		BudgetInput:   budgetInput,
-		SpendingLimit: spendingLimit,
-		InputInterval: inputInterval,
-		InputPeriod:   inputPeriod,
+		Name:           name,
+		BudgetInput:    models.MoneyToGrpc(budgetInput),
+		SpendingLimit:  models.MoneyToGrpc(spendingLimit),
+		InputInterval:  inputInterval,
+		InputPeriod:    inputPeriod,
+		RolloverPolicy: rolloverPolicy,
+		RolloverCap:    rolloverCap,
+		AutoReset:      form.Has("auto_reset", r),
+		GoalAmount:     goalAmount,
+		GoalDate:       goalDate,
 	})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
-		m.AddErrorMsg(r, "Failed to add category")
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to add category"))
 		http.Redirect(w, r, "/categories", http.StatusSeeOther)
 		return
 	}
@@ -160,7 +175,7 @@ func (m *Repository) PostMoveCategory(direction int) func(w http.ResponseWriter,
 		// Parse form
 		err := r.ParseForm()
 		if err != nil {
-			m.App.ErrorLog.Println(err)
+			m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		}
 
 		// Get category id from route param
@@ -204,8 +219,8 @@ func (m *Repository) PostMoveCategory(direction int) func(w http.ResponseWriter,
 		// Update category position
 		_, err = m.DBClient.ReorderCategory(r.Context(), &models.ReorderCategoryParams{CategoryId: id, NewOrder: tableOrder + int64(direction)})
 		if err != nil {
-			m.App.ErrorLog.Println(err)
-			m.AddErrorMsg(r, "Failed to move category")
+			m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+			m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to move category"))
 			http.Redirect(w, r, "/categories", http.StatusSeeOther)
 			return
 		}
@@ -219,7 +234,7 @@ func (m *Repository) PostDeleteCategory(w http.ResponseWriter, r *http.Request)
 	// Parse form
 	err := r.ParseForm()
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 	}
 
 	// Get category id from route param
@@ -234,13 +249,8 @@ func (m *Repository) PostDeleteCategory(w http.ResponseWriter, r *http.Request)
 	// Delete category from database
 	_, err = m.DBClient.DeleteCategory(r.Context(), &models.DeleteCategoryParams{ID: id})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
-		if strings.HasPrefix(err.Error(), "cant delete a category that is used") {
-			m.AddErrorMsg(r, "Can't delete category that is being used")
-		} else {
-			m.AddErrorMsg(r, "Failed to delete category")
-
-		}
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete category"))
 		http.Redirect(w, r, "/categories", http.StatusSeeOther)
 		return
 	}
@@ -250,12 +260,99 @@ func (m *Repository) PostDeleteCategory(w http.ResponseWriter, r *http.Request)
 	http.Redirect(w, r, "/categories", http.StatusSeeOther)
 }
 
+// Set or clear category goal
+func (m *Repository) PostCategoryGoal(w http.ResponseWriter, r *http.Request) {
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+	}
+
+	// Get category id from route param
+	idParam := chi.URLParam(r, "categoryId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid category")
+		http.Redirect(w, r, "/categories", http.StatusSeeOther)
+		return
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	goalAmount, goalDate := formGoal(form, m.Currency(r))
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			fmt.Sprintf("goal-%d", id): form,
+		})
+
+		// Redirect to categories
+		http.Redirect(w, r, "/categories", http.StatusSeeOther)
+		return
+	}
+
+	// Update category in database
+	_, err = m.DBClient.SetCategoryGoal(r.Context(), &models.SetCategoryGoalParams{CategoryId: id, GoalAmount: goalAmount, GoalDate: goalDate})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to set goal"))
+		http.Redirect(w, r, "/categories", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	if goalAmount.GetAmount() > 0 {
+		m.AddFlashMsg(r, "Goal saved")
+	} else {
+		m.AddFlashMsg(r, "Goal removed")
+	}
+	http.Redirect(w, r, "/categories", http.StatusSeeOther)
+}
+
+// Turn automatic period resets on or off
+func (m *Repository) PostCategoryAutoReset(w http.ResponseWriter, r *http.Request) {
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+	}
+
+	// Get category id from route param
+	idParam := chi.URLParam(r, "categoryId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid category")
+		http.Redirect(w, r, "/categories", http.StatusSeeOther)
+		return
+	}
+
+	// Update category in database
+	autoReset := r.PostForm.Get("auto_reset") == "1"
+	_, err = m.DBClient.SetCategoryAutoReset(r.Context(), &models.SetCategoryAutoResetParams{CategoryId: id, AutoReset: autoReset})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to change auto reset"))
+		http.Redirect(w, r, "/categories", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	if autoReset {
+		m.AddFlashMsg(r, "Category will be reset automatically")
+	} else {
+		m.AddFlashMsg(r, "Automatic reset turned off")
+	}
+	http.Redirect(w, r, "/categories", http.StatusSeeOther)
+}
+
 func (m *Repository) PostResetCategories(w http.ResponseWriter, r *http.Request) {
 
 	// Parse form
 	err := r.ParseForm()
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 	}
 
 	// Get form and validate fields
@@ -286,7 +383,7 @@ func (m *Repository) PostResetCategories(w http.ResponseWriter, r *http.Request)
 	// Loop through categories
 	for _, cat := range cats {
 		// Get relevant data
-		category, err := formStringToCategory(cat)
+		category, err := formStringToCategory(cat, m.Currency(r))
 		if err != nil {
 			// Push form to session
 			m.AddForms(r, map[string]*forms.Form{
@@ -309,6 +406,8 @@ func (m *Repository) PostResetCategories(w http.ResponseWriter, r *http.Request)
 		data.InputInterval = category.InputInterval
 		data.InputPeriod = category.InputPeriodId
 		data.SpendingLimit = category.SpendingLimit
+		data.RolloverPolicy = category.RolloverPolicy
+		data.RolloverCap = category.RolloverCap
 
 		resetData = append(resetData, data)
 	}
@@ -316,8 +415,8 @@ func (m *Repository) PostResetCategories(w http.ResponseWriter, r *http.Request)
 	// Reset all categories
 	_, err = m.DBClient.ResetCategories(r.Context(), &models.ResetCategoriesParams{Catgories: resetData})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
-		m.AddErrorMsg(r, "Failed to reset category")
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to reset category"))
 		http.Redirect(w, r, "/categories", http.StatusSeeOther)
 		return
 	}
@@ -327,24 +426,30 @@ func (m *Repository) PostResetCategories(w http.ResponseWriter, r *http.Request)
 	http.Redirect(w, r, "/categories", http.StatusSeeOther)
 }
 
+// Amounts are passed as minor units, so the reset form never rounds them
 func categoryToFormString(c *models.GrpcCategoryOverview) string {
 	return fmt.Sprintf(
-		"%d,%s,%f,%d,%d,%s,%f,%d,%d,%f,%f",
+		"%d,%s,%d,%d,%d,%s,%d,%d,%d,%d,%d,%d,%s,%d,%d,%d",
 		c.ID,
 		c.Name,
-		c.BudgetInput,
+		c.BudgetInput.GetAmount(),
 		c.InputInterval,
 		c.InputPeriodId,
 		c.InputPeriodCaption,
-		c.SpendingLimit,
+		c.SpendingLimit.GetAmount(),
 		c.PeriodStart.AsTime().Unix(),
 		c.PeriodEnd.AsTime().Unix(),
-		c.InitialAmount,
-		c.CurrentAmount,
+		c.InitialAmount.GetAmount(),
+		c.CurrentAmount.GetAmount(),
+		c.SpendingLeft.GetAmount(),
+		c.RolloverPolicy,
+		c.RolloverCap,
+		c.GoalAmount.GetAmount(),
+		c.GoalContribution.GetAmount(),
 	)
 }
 
-func formStringToCategory(c string) (*models.GrpcCategoryOverview, error) {
+func formStringToCategory(c string, currency string) (*models.GrpcCategoryOverview, error) {
 	// Split string to fields
 	fields := strings.Split(c, ",")
 
@@ -352,7 +457,7 @@ func formStringToCategory(c string) (*models.GrpcCategoryOverview, error) {
 	category := &models.GrpcCategoryOverview{}
 
 	// Check fields length
-	if len(fields) < 11 {
+	if len(fields) < 16 {
 		return category, errors.New("wrong format received from frontend")
 	}
 
@@ -364,25 +469,25 @@ func formStringToCategory(c string) (*models.GrpcCategoryOverview, error) {
 	category.ID = id
 
 	// Get amount
-	initialAmount, err := strconv.ParseFloat(fields[9], 64)
+	initialAmount, err := strconv.ParseInt(fields[9], 10, 64)
 	if err != nil {
 		return category, err
 	}
-	category.InitialAmount = initialAmount
+	category.InitialAmount = models.NewGrpcMoney(initialAmount, currency)
 
 	// Get budget input
-	budgetInput, err := strconv.ParseFloat(fields[2], 64)
+	budgetInput, err := strconv.ParseInt(fields[2], 10, 64)
 	if err != nil {
 		return category, err
 	}
-	category.BudgetInput = budgetInput
+	category.BudgetInput = models.NewGrpcMoney(budgetInput, currency)
 
 	// Get spending limit
-	spendingLimit, err := strconv.ParseFloat(fields[6], 64)
+	spendingLimit, err := strconv.ParseInt(fields[6], 10, 64)
 	if err != nil {
 		return category, err
 	}
-	category.SpendingLimit = spendingLimit
+	category.SpendingLimit = models.NewGrpcMoney(spendingLimit, currency)
 
 	// Get input interval
 	inputInterval, err := strconv.ParseInt(fields[3], 10, 64)
@@ -398,5 +503,68 @@ func formStringToCategory(c string) (*models.GrpcCategoryOverview, error) {
 	}
 	category.InputPeriodId = inputPriodId
 
+	// Get rollover policy
+	if !models.ValidRolloverPolicy(fields[12]) {
+		return category, errors.New("unknown rollover policy")
+	}
+	category.RolloverPolicy = fields[12]
+
+	// Get rollover cap
+	rolloverCap, err := strconv.ParseInt(fields[13], 10, 64)
+	if err != nil {
+		return category, err
+	}
+	category.RolloverCap = rolloverCap
+
 	return category, nil
 }
+
+// Validate and get the rollover policy fields. Empty fields use the defaults
+func formRollover(form *forms.Form) (string, int64) {
+	policy := form.Get("rollover_policy")
+	if policy == "" {
+		policy = models.RolloverCarry
+	}
+	if !models.ValidRolloverPolicy(policy) {
+		form.Errors.Add("rollover_policy", "Choose a rollover policy")
+	}
+
+	rolloverCap := int64(1)
+	if form.Get("rollover_cap") != "" && form.IsInt("rollover_cap") && form.Min("rollover_cap", 1) {
+		rolloverCap, _ = strconv.ParseInt(form.Get("rollover_cap"), 10, 64)
+	}
+
+	return policy, rolloverCap
+}
+
+// Validate optional goal fields. An empty amount clears the goal
+func formGoal(form *forms.Form, currency string) (*models.GrpcMoney, *timestamppb.Timestamp) {
+	if form.Get("goal_amount") == "" {
+		return models.NewGrpcMoney(0, currency), nil
+	}
+	if !form.IsMoney("goal_amount", currency) || !form.MinMoney("goal_amount", money.New(0, currency)) {
+		return nil, nil
+	}
+	goalAmount := models.MoneyToGrpc(form.Money("goal_amount", currency))
+
+	// Goal date is optional
+	if form.Get("goal_date") == "" || !form.IsFormDate("goal_date") {
+		return goalAmount, nil
+	}
+	goalDate, _ := forms.StringToTime(form.Get("goal_date"))
+
+	return goalAmount, timestamppb.New(goalDate)
+}
+
+// Get goal form values of a category
+func goalFormValues(c *models.GrpcCategoryOverview) map[string]string {
+	if c.GoalAmount.GetAmount() == 0 {
+		return map[string]string{}
+	}
+
+	values := map[string]string{"goal_amount": c.GoalAmount.Decimal()}
+	if c.GoalDate != nil {
+		values["goal_date"] = forms.TimeToString(c.GoalDate.AsTime())
+	}
+	return values
+}
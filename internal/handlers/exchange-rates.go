@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"github.com/dimitargrozev5/expenses-go-1/views/exchangeratesview"
+	"github.com/go-chi/chi"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Layout of dates in rate forms and imports
+const rateDateLayout = "2006-01-02"
+
+func (m *Repository) ExchangeRates(w http.ResponseWriter, r *http.Request) {
+
+	// Get rates
+	rates, err := m.DBClient.GetExchangeRates(r.Context(), &models.GrpcEmpty{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting exchange rates")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Exchange Rates",
+		Form: map[string]*forms.Form{
+			"add-exchange-rate":     forms.New(nil),
+			"import-exchange-rates": forms.New(nil),
+			"base-currency":         forms.New(nil),
+		},
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := exchangeratesview.ExchangeRatesData{
+		TemplateData: td,
+		Rates:        rates.Rates,
+		BaseCurrency: m.Currency(r),
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+func (m *Repository) PostNewExchangeRate(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	form.Required("from", "to", "rate", "date")
+	form.IsCurrency("from")
+	form.IsCurrency("to")
+	form.IsRate("rate")
+	form.IsDate("date", rateDateLayout)
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"add-exchange-rate": form,
+		})
+
+		// Redirect to exchange rates
+		http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+		return
+	}
+
+	// Get data
+	date, _ := time.Parse(rateDateLayout, form.Get("date"))
+	rate := &models.GrpcExchangeRate{
+		FromCurrency: money.Normalize(form.Get("from")),
+		ToCurrency:   money.Normalize(form.Get("to")),
+		Rate:         form.Get("rate"),
+		Date:         timestamppb.New(date),
+	}
+
+	// Add rate to database
+	_, err = m.DBClient.SetExchangeRates(r.Context(), &models.SetExchangeRatesParams{Rates: []*models.GrpcExchangeRate{rate}})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to add exchange rate"))
+		http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Exchange rate saved")
+	http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+}
+
+func (m *Repository) PostImportExchangeRates(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	form.Required("csv")
+
+	// Parse rows
+	rates, err := parseExchangeRatesCSV(form.Get("csv"))
+	if err != nil {
+		form.Errors.Add("csv", err.Error())
+	}
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"import-exchange-rates": form,
+		})
+
+		// Redirect to exchange rates
+		http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+		return
+	}
+
+	// Add rates to database
+	_, err = m.DBClient.SetExchangeRates(r.Context(), &models.SetExchangeRatesParams{Rates: rates})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to import exchange rates"))
+		http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, fmt.Sprintf("%d exchange rates imported", len(rates)))
+	http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+}
+
+func (m *Repository) PostDeleteExchangeRate(w http.ResponseWriter, r *http.Request) {
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get rate id from route param
+	idParam := chi.URLParam(r, "rateId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid exchange rate")
+		http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+		return
+	}
+
+	// Delete rate from database
+	_, err = m.DBClient.DeleteExchangeRate(r.Context(), &models.DeleteExchangeRateParams{ID: id})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete exchange rate"))
+		http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Exchange rate deleted")
+	http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+}
+
+func (m *Repository) PostBaseCurrency(w http.ResponseWriter, r *http.Request) {
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	form.Required("currency")
+	form.IsCurrency("currency")
+	if form.Get("rate") != "" {
+		form.IsRate("rate")
+	}
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"base-currency": form,
+		})
+
+		// Redirect to exchange rates
+		http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+		return
+	}
+
+	// Change base currency
+	currency := money.Normalize(form.Get("currency"))
+	_, err = m.DBClient.SetBaseCurrency(r.Context(), &models.SetBaseCurrencyParams{Currency: currency, Rate: form.Get("rate")})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to change base currency"))
+		http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+		return
+	}
+
+	// Update cached currency
+	m.App.Session.Put(r.Context(), "currency", currency)
+
+	// Add success message
+	m.AddFlashMsg(r, fmt.Sprintf("Base currency changed to %s", currency))
+	http.Redirect(w, r, "/settings/exchange-rates", http.StatusSeeOther)
+}
+
+// Parse exchange rates from CSV rows of date,from,to,rate. A header row is skipped
+func parseExchangeRatesCSV(s string) ([]*models.GrpcExchangeRate, error) {
+	reader := csv.NewReader(strings.NewReader(s))
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	rates := make([]*models.GrpcExchangeRate, 0)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("Line %d must have date, from, to and rate", line)
+		}
+
+		// Skip header
+		if line == 1 && strings.EqualFold(record[0], "date") {
+			continue
+		}
+
+		// Get date
+		date, err := time.Parse(rateDateLayout, record[0])
+		if err != nil {
+			return nil, fmt.Errorf("Line %d: date must be like 2024-01-31", line)
+		}
+
+		// Check currencies and rate
+		from := money.Normalize(record[1])
+		to := money.Normalize(record[2])
+		if !money.ValidCurrency(from) || !money.ValidCurrency(to) {
+			return nil, fmt.Errorf("Line %d: currencies must be codes like EUR", line)
+		}
+		if _, err := money.ParseRate(record[3]); err != nil {
+			return nil, fmt.Errorf("Line %d: rate must be a positive decimal", line)
+		}
+
+		rates = append(rates, &models.GrpcExchangeRate{
+			FromCurrency: from,
+			ToCurrency:   to,
+			Rate:         record[3],
+			Date:         timestamppb.New(date),
+		})
+	}
+
+	if len(rates) == 0 {
+		return nil, errors.New("No rates found")
+	}
+
+	return rates, nil
+}
@@ -7,9 +7,13 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
 	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"github.com/dimitargrozev5/expenses-go-1/internal/prefs"
 	"github.com/dimitargrozev5/expenses-go-1/views/expensesview"
 	"github.com/go-chi/chi"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -20,7 +24,7 @@ func (m *Repository) Expenses(w http.ResponseWriter, r *http.Request) {
 	// Get accounts
 	accounts, err := m.DBClient.GetAccounts(r.Context(), &models.GetAccountsParams{OrderByPopularity: true})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting expenses")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
@@ -36,7 +40,7 @@ func (m *Repository) Expenses(w http.ResponseWriter, r *http.Request) {
 	// Get categories
 	categories, err := m.DBClient.GetCategories(r.Context(), nil)
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting expenses")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
@@ -52,16 +56,43 @@ func (m *Repository) Expenses(w http.ResponseWriter, r *http.Request) {
 	// Get all expenses
 	expenses, err := m.DBClient.GetExpenses(r.Context(), nil)
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting expenses")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
 	}
 
+	// Get settings
+	settings, err := m.DBClient.GetSettings(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting expenses")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Show expenses in the chosen range. It defaults to the range in settings
+	expenseRange := r.URL.Query().Get("range")
+	if !prefs.ValidExpenseRange(expenseRange) {
+		expenseRange = settings.ExpenseRange
+	}
+	if start, ok := m.Prefs(r).RangeStart(expenseRange, time.Now()); ok {
+		expenses.Expenses = expensesSince(expenses.Expenses, start)
+	}
+
 	// Get all tags
 	tags, err := m.DBClient.GetTags(r.Context(), nil)
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get payees
+	payees, err := m.DBClient.GetPayees(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 		m.AddErrorMsg(r, "Error getting data")
 		http.Redirect(w, r, "/logout", http.StatusSeeOther)
 		return
@@ -96,13 +127,38 @@ func (m *Repository) Expenses(w http.ResponseWriter, r *http.Request) {
 
 		// Add forms
 		td.Form[edit] = forms.NewFromMap(map[string]string{
-			"amount":        fmt.Sprintf("%0.2f", expense.Amount),
+			"amount":        expense.Amount.Decimal(),
 			"tags":          strings.Join(tags, ","),
 			"date":          forms.TimeToString(expense.Date.AsTime()),
 			"from_account":  fmt.Sprintf("%d", expense.FromAccount.ID),
 			"from_category": fmt.Sprintf("%d", expense.FromCategory.ID),
+			"payee":         expense.Payee,
+			"notes":         expense.Notes,
 		})
+
+		// Add split lines
+		for _, split := range expense.Splits {
+			splitTags := make([]string, 0, len(split.Tags))
+			for _, tag := range split.Tags {
+				splitTags = append(splitTags, tag.Name)
+			}
+
+			td.Form[edit].Add("split_amount", split.Amount.Decimal())
+			td.Form[edit].Add("split_from_account", fmt.Sprintf("%d", split.FromAccount.ID))
+			td.Form[edit].Add("split_from_category", fmt.Sprintf("%d", split.FromCategory.ID))
+			td.Form[edit].Add("split_tags", strings.Join(splitTags, ","))
+		}
 		td.Form[delete] = forms.New(nil)
+
+		// Add refund and attachment forms
+		td.Form[fmt.Sprintf("refund-%d", expense.ID)] = forms.New(nil)
+		td.Form[fmt.Sprintf("attach-%d", expense.ID)] = forms.New(nil)
+		reimburse := map[string]string{}
+		if expense.ReimburseFrom != "" {
+			reimburse["counterparty"] = expense.ReimburseFrom
+			reimburse["amount"] = expense.ReimburseAmount.Decimal()
+		}
+		td.Form[fmt.Sprintf("reimburse-%d", expense.ID)] = forms.NewFromMap(reimburse)
 	}
 
 	// Add default data
@@ -112,29 +168,50 @@ func (m *Repository) Expenses(w http.ResponseWriter, r *http.Request) {
 	data := expensesview.ExpensesData{
 		TemplateData: td,
 		Expenses:     expenses.Expenses,
-		Tags:         tags.Tags,
-		Accounts:     accounts.Accounts,
-		Categories:   categories.Categories,
+		Outstanding:  expenses.Outstanding,
+		Payees:       payees.Payees,
+		Attachments: expensesview.AttachmentsUsage{
+			Size:  expenses.AttachmentsSize,
+			Quota: expenses.AttachmentsQuota,
+		},
+		Tags:            tags.Tags,
+		Accounts:        accounts.Accounts,
+		Categories:      categories.Categories,
+		Range:           expenseRange,
+		DefaultAccount:  idOrEmpty(settings.DefaultAccountID),
+		DefaultCategory: idOrEmpty(settings.DefaultCategoryID),
 	}
 
 	// Render view
 	data.View().Render(r.Context(), w)
 }
 
+// Keep expenses from a time on
+func expensesSince(expenses []*models.GrpcExpense, start time.Time) []*models.GrpcExpense {
+	filtered := make([]*models.GrpcExpense, 0, len(expenses))
+	for _, expense := range expenses {
+		if !expense.Date.AsTime().Before(start) {
+			filtered = append(filtered, expense)
+		}
+	}
+	return filtered
+}
+
 func (m *Repository) PostNewExpense(w http.ResponseWriter, r *http.Request) {
 
 	// Parse form
 	err := r.ParseForm()
 	if err != nil {
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 	}
 
 	// Get form and validate fields
 	form := forms.New(r.PostForm)
 	form.Required("amount", "tags", "from_account", "from_category", "date")
-	form.IsFloat64("amount")
+	form.IsMoney("amount", m.AccountCurrency(r, form.Get("from_account")))
 	form.MinLength("tags", 3)
 	form.IsFormDate("date")
+	splits := m.formSplits(r, form)
 
 	if !form.Valid() {
 
@@ -149,7 +226,7 @@ func (m *Repository) PostNewExpense(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get data
-	amount, _ := strconv.ParseFloat(form.Get("amount"), 64)
+	amount := form.Money("amount", m.AccountCurrency(r, form.Get("from_account")))
 	fromAccountId, _ := strconv.ParseInt(form.Get("from_account"), 10, 64)
 	fromCategoryId, _ := strconv.ParseInt(form.Get("from_category"), 10, 64)
 	date, _ := forms.StringToTime(form.Get("date"))
@@ -161,17 +238,18 @@ func (m *Repository) PostNewExpense(w http.ResponseWriter, r *http.Request) {
 	tags := re.Split(form.Get("tags"), -1)
 
 	expense := &models.GrpcExpense{
-		Amount:         amount,
+		Amount:         models.MoneyToGrpc(amount),
 		Date:           timestamppb.New(date),
 		FromAccountId:  fromAccountId,
 		FromCategoryId: fromCategoryId,
+		Notes:          form.Get("notes"),
 	}
 
 	// Add expense to database
-	_, err = m.DBClient.AddExpense(r.Context(), &models.ExpensesParams{Expense: expense, Tags: tags})
+	_, err = m.DBClient.AddExpense(r.Context(), &models.ExpensesParams{Expense: expense, Tags: tags, Splits: splits, Payee: form.Get("payee")})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
-		m.AddErrorMsg(r, "Failed to add expense")
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to add expense"))
 		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
 		return
 	}
@@ -202,9 +280,10 @@ func (m *Repository) PostEditExpense(w http.ResponseWriter, r *http.Request) {
 	// Get form and validate fields
 	form := forms.New(r.PostForm)
 	form.Required("amount", "tags", "from_account", "from_category", "date")
-	form.IsFloat64("amount")
+	form.IsMoney("amount", m.AccountCurrency(r, form.Get("from_account")))
 	form.MinLength("tags", 3)
 	form.IsFormDate("date")
+	splits := m.formSplits(r, form)
 
 	if !form.Valid() {
 
@@ -219,7 +298,7 @@ func (m *Repository) PostEditExpense(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get data
-	amount, _ := strconv.ParseFloat(form.Get("amount"), 64)
+	amount := form.Money("amount", m.AccountCurrency(r, form.Get("from_account")))
 	fromAccountId, _ := strconv.ParseInt(form.Get("from_account"), 10, 64)
 	fromCategoryId, _ := strconv.ParseInt(form.Get("from_category"), 10, 64)
 	date, _ := forms.StringToTime(form.Get("date"))
@@ -232,17 +311,18 @@ func (m *Repository) PostEditExpense(w http.ResponseWriter, r *http.Request) {
 
 	expense := &models.GrpcExpense{
 		ID:             id,
-		Amount:         amount,
+		Amount:         models.MoneyToGrpc(amount),
 		Date:           timestamppb.New(date),
 		FromAccountId:  fromAccountId,
 		FromCategoryId: fromCategoryId,
+		Notes:          form.Get("notes"),
 	}
 
 	// Add expense to database
-	_, err = m.DBClient.EditExpense(r.Context(), &models.ExpensesParams{Expense: expense, Tags: tags})
+	_, err = m.DBClient.EditExpense(r.Context(), &models.ExpensesParams{Expense: expense, Tags: tags, Splits: splits, Payee: form.Get("payee")})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
-		m.AddErrorMsg(r, "Failed to edit expense")
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to edit expense"))
 		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
 		return
 	}
@@ -273,8 +353,8 @@ func (m *Repository) PostDeleteExpense(w http.ResponseWriter, r *http.Request) {
 	// Delete expense from database
 	_, err = m.DBClient.DeleteExpense(r.Context(), &models.DeleteExpenseParams{ID: id})
 	if err != nil {
-		m.App.ErrorLog.Println(err)
-		m.AddErrorMsg(r, "Failed to delete expense")
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete expense"))
 		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
 		return
 	}
@@ -283,3 +363,48 @@ func (m *Repository) PostDeleteExpense(w http.ResponseWriter, r *http.Request) {
 	m.AddFlashMsg(r, "Expense deleted")
 	http.Redirect(w, r, "/expenses", http.StatusSeeOther)
 }
+
+// Validate and get split lines from the form. Lines left empty are skipped
+func (m *Repository) formSplits(r *http.Request, form *forms.Form) []*models.ExpensesParams {
+	amounts := form.Values["split_amount"]
+	accounts := form.Values["split_from_account"]
+	categories := form.Values["split_from_category"]
+	tags := form.Values["split_tags"]
+
+	// Every line must have all fields
+	if len(accounts) != len(amounts) || len(categories) != len(amounts) || len(tags) != len(amounts) {
+		form.Errors.Add("splits", "Split lines are incomplete")
+		return nil
+	}
+
+	// Get tags
+	re := regexp.MustCompile(`,\s*`)
+
+	splits := make([]*models.ExpensesParams, 0, len(amounts))
+	for i := range amounts {
+		// Skip empty lines
+		if strings.TrimSpace(amounts[i]+accounts[i]+categories[i]+tags[i]) == "" {
+			continue
+		}
+
+		// Validate line
+		accountId, accountErr := strconv.ParseInt(accounts[i], 10, 64)
+		categoryId, categoryErr := strconv.ParseInt(categories[i], 10, 64)
+		amount, amountErr := money.Parse(amounts[i], m.AccountCurrency(r, accounts[i]))
+		if accountErr != nil || categoryErr != nil || amountErr != nil || len(strings.TrimSpace(tags[i])) < 3 {
+			form.Errors.Add("splits", fmt.Sprintf("Line %d needs an amount, account, category and tags", i+1))
+			return nil
+		}
+
+		splits = append(splits, &models.ExpensesParams{
+			Expense: &models.GrpcExpense{
+				Amount:         models.MoneyToGrpc(amount),
+				FromAccountId:  accountId,
+				FromCategoryId: categoryId,
+			},
+			Tags: re.Split(strings.TrimSpace(tags[i]), -1),
+		})
+	}
+
+	return splits
+}
@@ -2,11 +2,15 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/dimitargrozev5/expenses-go-1/internal/config"
 	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
 	"github.com/dimitargrozev5/expenses-go-1/internal/helpers"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"github.com/dimitargrozev5/expenses-go-1/internal/prefs"
 	"github.com/dimitargrozev5/expenses-go-1/internal/repository"
 	"github.com/justinas/nosurf"
 )
@@ -67,6 +71,82 @@ func (m *Repository) AddDefaultData(td *models.TemplateData, r *http.Request) *m
 	return td
 }
 
+// Get the currency the user keeps amounts in. It is cached in the session after the first lookup
+func (m *Repository) Currency(r *http.Request) string {
+	// Check session
+	currency := m.App.Session.GetString(r.Context(), "currency")
+	if currency != "" {
+		return currency
+	}
+
+	// Get user
+	user, err := m.DBClient.GetUser(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "can't get user currency", "error", err)
+		return money.DefaultCurrency
+	}
+
+	// Cache currency
+	currency = money.Normalize(user.Currency)
+	m.App.Session.Put(r.Context(), "currency", currency)
+
+	return currency
+}
+
+// Get display preferences of the user. They are cached in the session after the first lookup
+func (m *Repository) Prefs(r *http.Request) prefs.Prefs {
+	// Check session
+	if p, ok := m.App.Session.Get(r.Context(), "preferences").(prefs.Prefs); ok {
+		return p
+	}
+
+	// Get settings
+	settings, err := m.DBClient.GetSettings(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "can't get user settings", "error", err)
+		return prefs.Default()
+	}
+
+	// Cache preferences
+	p := settingsPrefs(settings)
+	m.App.Session.Put(r.Context(), "preferences", p)
+
+	return p
+}
+
+// Get display preferences from settings
+func settingsPrefs(settings *models.GrpcSettings) prefs.Prefs {
+	return prefs.Prefs{
+		Locale:         settings.Locale,
+		NumberFormat:   settings.NumberFormat,
+		DateFormat:     settings.DateFormat,
+		FirstDayOfWeek: time.Weekday(settings.FirstDayOfWeek),
+	}
+}
+
+// Get the currency of an account. Falls back to the base currency
+func (m *Repository) AccountCurrency(r *http.Request, accountId string) string {
+	id, err := strconv.ParseInt(accountId, 10, 64)
+	if err != nil {
+		return m.Currency(r)
+	}
+
+	// Get accounts
+	accounts, err := m.DBClient.GetAccounts(r.Context(), &models.GetAccountsParams{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "can't get account currency", "error", err)
+		return m.Currency(r)
+	}
+
+	for _, account := range accounts.Accounts {
+		if account.ID == id {
+			return money.Normalize(account.CurrentAmount.GetCurrency())
+		}
+	}
+
+	return m.Currency(r)
+}
+
 // Add flash message to session
 func (m *Repository) AddFlashMsg(r *http.Request, msg string) {
 	m.App.Session.Put(r.Context(), "flash", msg)
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/views/incomesview"
+	"github.com/go-chi/chi"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (m *Repository) Incomes(w http.ResponseWriter, r *http.Request) {
+
+	// Get accounts
+	accounts, err := m.DBClient.GetAccounts(r.Context(), &models.GetAccountsParams{OrderByPopularity: true})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting incomes")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// If there are no accounts redirect to accounts page and prompt user to create an account
+	if len(accounts.Accounts) == 0 {
+		m.AddWarningMsg(r, "You have to create a Payment Account before you can add Income")
+		http.Redirect(w, r, "/accounts", http.StatusSeeOther)
+		return
+	}
+
+	// Get all incomes
+	incomes, err := m.DBClient.GetIncomes(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting incomes")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get all tags
+	tags, err := m.DBClient.GetTags(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Income",
+		Form: map[string]*forms.Form{
+			"add-income": forms.New(nil),
+		},
+	}
+
+	// Add forms for incomes
+	for _, income := range incomes.Incomes {
+		// Get form names
+		edit := fmt.Sprintf("edit-%d", income.ID)
+		delete := fmt.Sprintf("delete-%d", income.ID)
+
+		// Get income tags as []string
+		tags := make([]string, 0, len(income.Tags))
+		for _, tag := range income.Tags {
+			tags = append(tags, tag.Name)
+		}
+
+		// Add forms
+		td.Form[edit] = forms.NewFromMap(map[string]string{
+			"amount":     income.Amount.Decimal(),
+			"source":     income.Source,
+			"tags":       strings.Join(tags, ","),
+			"date":       forms.TimeToString(income.Date.AsTime()),
+			"to_account": fmt.Sprintf("%d", income.ToAccountId),
+		})
+		td.Form[delete] = forms.New(nil)
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := incomesview.IncomesData{
+		TemplateData: td,
+		Incomes:      incomes.Incomes,
+		Months:       incomes.Months,
+		Tags:         tags.Tags,
+		Accounts:     accounts.Accounts,
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+func (m *Repository) PostNewIncome(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	income, tags := m.incomeFromForm(r, form)
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"add-income": form,
+		})
+
+		// Redirect to incomes
+		http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+		return
+	}
+
+	// Add income to database
+	_, err = m.DBClient.AddIncome(r.Context(), &models.IncomeParams{Income: income, Tags: tags})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to add income"))
+		http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Income added")
+	http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+}
+
+// Edit income
+func (m *Repository) PostEditIncome(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get income id from route param
+	idParam := chi.URLParam(r, "incomeId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid income")
+		http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+		return
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	income, tags := m.incomeFromForm(r, form)
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			fmt.Sprintf("edit-%d", id): form,
+		})
+
+		// Redirect to incomes
+		http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+		return
+	}
+
+	// Update income in database
+	income.ID = id
+	_, err = m.DBClient.EditIncome(r.Context(), &models.IncomeParams{Income: income, Tags: tags})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to edit income"))
+		http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Income updated")
+	http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+}
+
+// Delete income
+func (m *Repository) PostDeleteIncome(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get income id from route param
+	idParam := chi.URLParam(r, "incomeId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid income")
+		http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+		return
+	}
+
+	// Delete income from database
+	_, err = m.DBClient.DeleteIncome(r.Context(), &models.DeleteIncomeParams{ID: id})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete income"))
+		http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Income deleted")
+	http.Redirect(w, r, "/incomes", http.StatusSeeOther)
+}
+
+// Validate the income form and get the income and its tags
+func (m *Repository) incomeFromForm(r *http.Request, form *forms.Form) (*models.GrpcIncome, []string) {
+	form.Required("amount", "tags", "to_account", "date")
+	form.IsMoney("amount", m.AccountCurrency(r, form.Get("to_account")))
+	form.IsInt("to_account")
+	form.MinLength("tags", 3)
+	form.IsFormDate("date")
+	if !form.Valid() {
+		return nil, nil
+	}
+
+	// Get data
+	amount := form.Money("amount", m.AccountCurrency(r, form.Get("to_account")))
+	toAccountId, _ := strconv.ParseInt(form.Get("to_account"), 10, 64)
+	date, _ := forms.StringToTime(form.Get("date"))
+
+	// Split tags field
+	re := regexp.MustCompile(`,\s*`)
+	tags := re.Split(form.Get("tags"), -1)
+
+	return &models.GrpcIncome{
+		Amount:      models.MoneyToGrpc(amount),
+		Date:        timestamppb.New(date),
+		Source:      strings.TrimSpace(form.Get("source")),
+		ToAccountId: toAccountId,
+	}, tags
+}
@@ -0,0 +1,293 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"github.com/dimitargrozev5/expenses-go-1/views/liabilitiesview"
+	"github.com/go-chi/chi"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (m *Repository) Liabilities(w http.ResponseWriter, r *http.Request) {
+
+	// Get liabilities and net worth
+	liabilities, err := m.DBClient.GetLiabilities(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting liabilities")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get accounts
+	accounts, err := m.DBClient.GetAccounts(r.Context(), &models.GetAccountsParams{OrderByPopularity: true})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get categories
+	categories, err := m.DBClient.GetCategories(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get tags
+	tags, err := m.DBClient.GetTags(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get time periods
+	periods, err := m.DBClient.GetTimePeriods(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Liabilities",
+		Form: map[string]*forms.Form{
+			"add-liability": forms.New(nil),
+		},
+	}
+
+	// Add forms for liabilities
+	for _, liability := range liabilities.Liabilities {
+		// Get form names
+		pay := fmt.Sprintf("pay-%d", liability.ID)
+		delete := fmt.Sprintf("delete-%d", liability.ID)
+
+		// Add forms
+		td.Form[pay] = forms.New(nil)
+		td.Form[delete] = forms.New(nil)
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := liabilitiesview.LiabilitiesData{
+		TemplateData: td,
+		Liabilities:  liabilities,
+		Accounts:     accounts.Accounts,
+		Categories:   categories.Categories,
+		Tags:         tags.Tags,
+		TimePeriods:  periods.TimePeriods,
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+func (m *Repository) PostNewLiability(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	form.Required("kind", "counterparty", "principal", "start_date")
+	if !models.ValidLiabilityKind(form.Get("kind")) {
+		form.Errors.Add("kind", "Choose borrowed or lent")
+	}
+	form.IsMoney("principal", m.Currency(r))
+	form.MinMoney("principal", money.New(1, m.Currency(r)))
+	form.IsFormDate("start_date")
+
+	// Interest rate is a yearly percent, kept in basis points
+	interestRate := int64(0)
+	if form.Get("interest_rate") != "" && form.IsPercent("interest_rate") {
+		interestRate, _ = money.ParsePercent(form.Get("interest_rate"))
+	}
+
+	// Payment schedule is optional
+	paymentAmount := money.New(0, m.Currency(r))
+	if form.Get("payment_amount") != "" && form.IsMoney("payment_amount", m.Currency(r)) && form.MinMoney("payment_amount", money.New(0, m.Currency(r))) {
+		paymentAmount = form.Money("payment_amount", m.Currency(r))
+	}
+	paymentInterval := int64(1)
+	if form.Get("payment_interval") != "" && form.IsInt("payment_interval") && form.Min("payment_interval", 1) {
+		paymentInterval, _ = strconv.ParseInt(form.Get("payment_interval"), 10, 64)
+	}
+	paymentPeriod := int64(0)
+	if form.Get("payment_period") != "" && form.IsInt("payment_period") {
+		paymentPeriod, _ = strconv.ParseInt(form.Get("payment_period"), 10, 64)
+	}
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"add-liability": form,
+		})
+
+		// Redirect to liabilities
+		http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+		return
+	}
+
+	// Get data
+	principal := form.Money("principal", m.Currency(r))
+	startDate, _ := forms.StringToTime(form.Get("start_date"))
+
+	// Add liability to database
+	_, err = m.DBClient.AddLiability(r.Context(), &models.AddLiabilityParams{Liability: &models.GrpcLiability{
+		Kind:            form.Get("kind"),
+		Counterparty:    strings.TrimSpace(form.Get("counterparty")),
+		Principal:       models.MoneyToGrpc(principal),
+		InterestRate:    interestRate,
+		PaymentAmount:   models.MoneyToGrpc(paymentAmount),
+		PaymentInterval: paymentInterval,
+		PaymentPeriodId: paymentPeriod,
+		StartDate:       timestamppb.New(startDate),
+	}})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to add liability"))
+		http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Liability added")
+	http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+}
+
+// Record a payment of a liability
+func (m *Repository) PostLiabilityPayment(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get liability id from route param
+	idParam := chi.URLParam(r, "liabilityId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid liability")
+		http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+		return
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	currency := m.AccountCurrency(r, form.Get("account"))
+	form.Required("amount", "account", "date", "tags")
+	form.IsMoney("amount", currency)
+	form.MinMoney("amount", money.New(1, currency))
+	form.IsInt("account")
+	form.IsFormDate("date")
+	form.MinLength("tags", 3)
+	if form.Get("interest") != "" {
+		form.IsMoney("interest", currency)
+		form.MinMoney("interest", money.New(0, currency))
+	}
+	if form.Get("category") != "" {
+		form.IsInt("category")
+	}
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			fmt.Sprintf("pay-%d", id): form,
+		})
+
+		// Redirect to liabilities
+		http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+		return
+	}
+
+	// Get data
+	amount := form.Money("amount", currency)
+	interest := money.New(0, currency)
+	if form.Get("interest") != "" {
+		interest = form.Money("interest", currency)
+	}
+	accountId, _ := strconv.ParseInt(form.Get("account"), 10, 64)
+	categoryId, _ := strconv.ParseInt(form.Get("category"), 10, 64)
+	date, _ := forms.StringToTime(form.Get("date"))
+
+	// Split tags field
+	re := regexp.MustCompile(`,\s*`)
+	tags := re.Split(form.Get("tags"), -1)
+
+	// Add payment to database
+	_, err = m.DBClient.AddLiabilityPayment(r.Context(), &models.LiabilityPaymentParams{
+		LiabilityId: id,
+		Amount:      models.MoneyToGrpc(amount),
+		Interest:    models.MoneyToGrpc(interest),
+		Date:        timestamppb.New(date),
+		AccountId:   accountId,
+		CategoryId:  categoryId,
+		Tags:        tags,
+	})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to record payment"))
+		http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Payment recorded")
+	http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+}
+
+// Delete liability
+func (m *Repository) PostDeleteLiability(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get liability id from route param
+	idParam := chi.URLParam(r, "liabilityId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid liability")
+		http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+		return
+	}
+
+	// Delete liability from database
+	_, err = m.DBClient.DeleteLiability(r.Context(), &models.DeleteLiabilityParams{ID: id})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete liability"))
+		http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Liability deleted")
+	http.Redirect(w, r, "/liabilities", http.StatusSeeOther)
+}
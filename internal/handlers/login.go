@@ -1,11 +1,19 @@
 package handlers
 
 import (
+	"fmt"
 	"log"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
 	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/views/homeview"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Handle posting to login
@@ -35,16 +43,31 @@ func (m *Repository) PostLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Don't call the DB Node while an earlier login is locked out
+	if m.loginThrottled(w, r) {
+		form.Set("password", "")
+		m.AddForms(r, map[string]*forms.Form{
+			"login": form,
+		})
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
 	// Get user data
 	uEmail := form.Get("email")
 	uPassword := form.Get("password")
 
 	// Authenticate user
-	result, err := m.DBClient.Authenticate(r.Context(), &models.LoginCredentials{Email: uEmail, Password: uPassword})
+	result, err := m.DBClient.Authenticate(r.Context(), &models.LoginCredentials{
+		Email:         uEmail,
+		Password:      uPassword,
+		Device:        r.UserAgent(),
+		RemoteAddress: r.RemoteAddr,
+	})
 	if err != nil {
 
 		// Write to error log
-		m.App.ErrorLog.Println(err)
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
 
 		// Reset password in form
 		form.Set("password", "")
@@ -55,20 +78,199 @@ func (m *Repository) PostLogin(w http.ResponseWriter, r *http.Request) {
 		})
 
 		// Add error message
-		m.AddErrorMsg(r, "Invalid login credentials")
+		if !m.rateLimited(w, r, err) {
+			m.AddErrorMsg(r, "Invalid login credentials")
+		}
 
 		// Redirect to home
 		http.Redirect(w, r, "/", http.StatusSeeOther)
 		return
 	}
 
-	// Store user token in session
+	// Ask for the second factor
+	if result.TwoFactorRequired {
+		m.App.Session.Put(r.Context(), "two_factor_challenge", result.ChallengeToken)
+		m.App.Session.Put(r.Context(), "two_factor_user", uEmail)
+		m.App.Session.Put(r.Context(), "two_factor_remember", form.Get("remember") != "")
+
+		http.Redirect(w, r, "/login/two-factor", http.StatusSeeOther)
+		return
+	}
+
+	// Store user tokens in session
+	m.storeLogin(r, result, uEmail, form.Get("remember") != "")
+
+	// Redirect to home page
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+
+}
+
+// Show second login step
+func (m *Repository) TwoFactorLogin(w http.ResponseWriter, r *http.Request) {
+
+	// Password step must be passed first
+	if !m.App.Session.Exists(r.Context(), "two_factor_challenge") {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Two-factor authentication",
+		Form: map[string]*forms.Form{
+			"two-factor": forms.New(nil),
+		},
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := homeview.TwoFactorData{
+		TemplateData: td,
+		Email:        m.App.Session.GetString(r.Context(), "two_factor_user"),
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+// Handle posting to second login step
+func (m *Repository) PostTwoFactorLogin(w http.ResponseWriter, r *http.Request) {
+
+	// Get challenge of the password step
+	challenge := m.App.Session.GetString(r.Context(), "two_factor_challenge")
+	if challenge == "" {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	form.Required("code")
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"two-factor": form,
+		})
+
+		// Redirect to second step
+		http.Redirect(w, r, "/login/two-factor", http.StatusSeeOther)
+		return
+	}
+
+	// Don't call the DB Node while an earlier attempt is locked out
+	if m.loginThrottled(w, r) {
+		http.Redirect(w, r, "/login/two-factor", http.StatusSeeOther)
+		return
+	}
+
+	// Verify code
+	result, err := m.DBClient.VerifyTwoFactor(r.Context(), &models.TwoFactorLoginParams{
+		ChallengeToken: challenge,
+		Code:           form.Get("code"),
+		Device:         r.UserAgent(),
+		RemoteAddress:  r.RemoteAddr,
+	})
+	if status.Code(err) == codes.Unauthenticated {
+
+		// Challenge expired. Start over
+		m.App.Session.Remove(r.Context(), "two_factor_challenge")
+		m.App.Session.Remove(r.Context(), "two_factor_user")
+		m.App.Session.Remove(r.Context(), "two_factor_remember")
+		m.AddErrorMsg(r, "Login expired. Please log in again")
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+	if err != nil {
+
+		// Write to error log
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+
+		// Add error message
+		if !m.rateLimited(w, r, err) {
+			m.AddErrorMsg(r, domainerr.UserMessage(err, "Invalid code"))
+		}
+
+		// Redirect to second step
+		http.Redirect(w, r, "/login/two-factor", http.StatusSeeOther)
+		return
+	}
+
+	// Renew session token
+	_ = m.App.Session.RenewToken(r.Context())
+
+	// Store user tokens in session
+	email := m.App.Session.PopString(r.Context(), "two_factor_user")
+	remember := m.App.Session.PopBool(r.Context(), "two_factor_remember")
+	m.App.Session.Remove(r.Context(), "two_factor_challenge")
+	m.storeLogin(r, result, email, remember)
+
+	// Redirect to home page
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+// Store tokens of a finished login in session. Remembered sessions keep their cookie when the browser closes
+func (m *Repository) storeLogin(r *http.Request, result *models.LoginToken, email string, remember bool) {
 	m.App.Session.Put(r.Context(), "user_token", result.Token)
+	m.App.Session.Put(r.Context(), "refresh_token", result.RefreshToken)
+	m.App.Session.Put(r.Context(), "user_key", email)
+	m.App.Session.RememberMe(r.Context(), remember)
 
 	// Flash message to user
 	m.AddFlashMsg(r, "Logged in successfully")
+}
 
-	// Redirect to home page
-	http.Redirect(w, r, "/", http.StatusSeeOther)
+// Check if logins from this session are locked out. Sets Retry-After and shows the wait
+func (m *Repository) loginThrottled(w http.ResponseWriter, r *http.Request) bool {
+	wait := time.Until(time.Unix(m.App.Session.GetInt64(r.Context(), "login_retry_at"), 0))
+	if wait <= 0 {
+		return false
+	}
+
+	m.setRetryAfter(w, r, wait)
+	return true
+}
 
+// Check if a login was throttled by the DB Controller. The wait is kept in session, so it's respected by later logins
+func (m *Repository) rateLimited(w http.ResponseWriter, r *http.Request, err error) bool {
+	wait, ok := domainerr.RetryAfter(err)
+	if !ok {
+		return false
+	}
+
+	// Session values are gob encoded, so the time is kept as unix seconds
+	m.App.Session.Put(r.Context(), "login_retry_at", time.Now().Add(wait).Unix())
+	m.setRetryAfter(w, r, wait)
+	return true
+}
+
+// Set Retry-After header and tell the user how long to wait
+func (m *Repository) setRetryAfter(w http.ResponseWriter, r *http.Request, wait time.Duration) {
+	seconds := int(math.Ceil(wait.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+
+	m.AddErrorMsg(r, fmt.Sprintf("Too many login attempts. Please try again in %s", waitText(seconds)))
+}
+
+// Get wait in words, rounded up to minutes after the first minute
+func waitText(seconds int) string {
+	if seconds <= 60 {
+		return plural(seconds, "second")
+	}
+	return plural((seconds+59)/60, "minute")
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
 }
@@ -2,11 +2,19 @@ package handlers
 
 import (
 	"net/http"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 )
 
 // Handle posting to login
 func (m *Repository) Logout(w http.ResponseWriter, r *http.Request) {
 
+	// Revoke session
+	_, err := m.DBClient.Logout(r.Context(), &models.LogoutParams{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+	}
+
 	// Destroy session and renew session token
 	_ = m.App.Session.Destroy(r.Context())
 	_ = m.App.Session.RenewToken(r.Context())
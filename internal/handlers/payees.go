@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/views/payeesview"
+	"github.com/go-chi/chi"
+)
+
+func (m *Repository) Payees(w http.ResponseWriter, r *http.Request) {
+
+	// Get payees
+	payees, err := m.DBClient.GetPayees(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting payees")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get accounts
+	accounts, err := m.DBClient.GetAccounts(r.Context(), &models.GetAccountsParams{OrderByPopularity: true})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get categories
+	categories, err := m.DBClient.GetCategories(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get tags
+	tags, err := m.DBClient.GetTags(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Payees",
+		Form: map[string]*forms.Form{
+			"add-payee": forms.New(nil),
+		},
+	}
+
+	// Add forms for payees
+	for _, payee := range payees.Payees {
+		td.Form[fmt.Sprintf("edit-%d", payee.ID)] = forms.NewFromMap(map[string]string{
+			"name":             payee.Name,
+			"default_account":  formID(payee.DefaultAccountId),
+			"default_category": formID(payee.DefaultCategoryId),
+			"default_tags":     strings.Join(payee.DefaultTags, ","),
+		})
+		td.Form[fmt.Sprintf("delete-%d", payee.ID)] = forms.New(nil)
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := payeesview.PayeesData{
+		TemplateData: td,
+		Payees:       payees.Payees,
+		Accounts:     accounts.Accounts,
+		Categories:   categories.Categories,
+		Tags:         tags.Tags,
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+func (m *Repository) PostNewPayee(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	payee := formPayee(form)
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"add-payee": form,
+		})
+
+		// Redirect to payees
+		http.Redirect(w, r, "/payees", http.StatusSeeOther)
+		return
+	}
+
+	// Add payee to database
+	_, err = m.DBClient.AddPayee(r.Context(), &models.PayeeParams{Payee: payee})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to add payee"))
+		http.Redirect(w, r, "/payees", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Payee added")
+	http.Redirect(w, r, "/payees", http.StatusSeeOther)
+}
+
+func (m *Repository) PostEditPayee(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get payee id from route param
+	idParam := chi.URLParam(r, "payeeId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid payee")
+		http.Redirect(w, r, "/payees", http.StatusSeeOther)
+		return
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	payee := formPayee(form)
+	payee.ID = id
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			fmt.Sprintf("edit-%d", id): form,
+		})
+
+		// Redirect to payees
+		http.Redirect(w, r, "/payees", http.StatusSeeOther)
+		return
+	}
+
+	// Update payee
+	_, err = m.DBClient.EditPayee(r.Context(), &models.PayeeParams{Payee: payee})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to edit payee"))
+		http.Redirect(w, r, "/payees", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Payee updated")
+	http.Redirect(w, r, "/payees", http.StatusSeeOther)
+}
+
+func (m *Repository) PostDeletePayee(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get payee id from route param
+	idParam := chi.URLParam(r, "payeeId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid payee")
+		http.Redirect(w, r, "/payees", http.StatusSeeOther)
+		return
+	}
+
+	// Delete payee from database
+	_, err = m.DBClient.DeletePayee(r.Context(), &models.DeletePayeeParams{ID: id})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete payee"))
+		http.Redirect(w, r, "/payees", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Payee deleted")
+	http.Redirect(w, r, "/payees", http.StatusSeeOther)
+}
+
+// Validate and get payee fields. Defaults are optional
+func formPayee(form *forms.Form) *models.GrpcPayee {
+	form.Required("name")
+
+	payee := &models.GrpcPayee{Name: form.Get("name"), DefaultTags: []string{}}
+	if form.Get("default_account") != "" && form.IsInt("default_account") {
+		payee.DefaultAccountId, _ = strconv.ParseInt(form.Get("default_account"), 10, 64)
+	}
+	if form.Get("default_category") != "" && form.IsInt("default_category") {
+		payee.DefaultCategoryId, _ = strconv.ParseInt(form.Get("default_category"), 10, 64)
+	}
+	if form.Get("default_tags") != "" {
+		re := regexp.MustCompile(`,\s*`)
+		payee.DefaultTags = re.Split(form.Get("default_tags"), -1)
+	}
+
+	return payee
+}
+
+// Form value of an optional id
+func formID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", id)
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"github.com/go-chi/chi"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Add refund or reimbursement of an expense
+func (m *Repository) PostExpenseRefund(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get expense id from route param
+	idParam := chi.URLParam(r, "expenseId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid expense")
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	currency := m.AccountCurrency(r, form.Get("to_account"))
+	form.Required("kind", "amount", "to_account", "date")
+	form.IsMoney("amount", currency)
+	form.MinMoney("amount", money.New(1, currency))
+	form.IsInt("to_account")
+	form.IsFormDate("date")
+	if !models.ValidRefundKind(form.Get("kind")) {
+		form.Errors.Add("kind", "Choose refund or reimbursement")
+	}
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			fmt.Sprintf("refund-%d", id): form,
+		})
+
+		// Redirect to expenses
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Get data
+	amount := form.Money("amount", currency)
+	accountId, _ := strconv.ParseInt(form.Get("to_account"), 10, 64)
+	date, _ := forms.StringToTime(form.Get("date"))
+
+	// Add refund to database
+	_, err = m.DBClient.AddExpenseRefund(r.Context(), &models.ExpenseRefundParams{
+		Refund: &models.GrpcExpenseRefund{
+			ExpenseId:    id,
+			Kind:         form.Get("kind"),
+			Counterparty: form.Get("counterparty"),
+			Amount:       models.MoneyToGrpc(amount),
+			ToAccountId:  accountId,
+			Date:         timestamppb.New(date),
+		},
+	})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to add refund"))
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Refund added")
+	http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+}
+
+// Delete refund
+func (m *Repository) PostDeleteExpenseRefund(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get refund id from route param
+	idParam := chi.URLParam(r, "refundId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid refund")
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Delete refund from database
+	_, err = m.DBClient.DeleteExpenseRefund(r.Context(), &models.DeleteExpenseRefundParams{ID: id})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete refund"))
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Refund deleted")
+	http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+}
+
+// Set or clear the awaited reimbursement of an expense
+func (m *Repository) PostExpenseReimbursement(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get expense id from route param
+	idParam := chi.URLParam(r, "expenseId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid expense")
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Get form and validate fields. An empty amount expects the whole expense
+	form := forms.New(r.PostForm)
+	currency := m.Currency(r)
+	if form.Get("amount") != "" {
+		form.IsMoney("amount", currency)
+		form.MinMoney("amount", money.New(1, currency))
+	}
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			fmt.Sprintf("reimburse-%d", id): form,
+		})
+
+		// Redirect to expenses
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Get data
+	amount := money.New(0, currency)
+	if form.Get("amount") != "" {
+		amount = form.Money("amount", currency)
+	}
+
+	// Update expense
+	_, err = m.DBClient.SetExpenseReimbursement(r.Context(), &models.SetExpenseReimbursementParams{
+		ExpenseId:    id,
+		Counterparty: form.Get("counterparty"),
+		Amount:       models.MoneyToGrpc(amount),
+	})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to update reimbursement"))
+		http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	if form.Get("counterparty") == "" {
+		m.AddFlashMsg(r, "Reimbursement cleared")
+	} else {
+		m.AddFlashMsg(r, "Awaiting reimbursement")
+	}
+	http.Redirect(w, r, "/expenses", http.StatusSeeOther)
+}
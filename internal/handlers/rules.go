@@ -0,0 +1,291 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/views/rulesview"
+	"github.com/go-chi/chi"
+)
+
+func (m *Repository) Rules(w http.ResponseWriter, r *http.Request) {
+
+	// Get rules
+	rules, err := m.DBClient.GetRules(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting rules")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get accounts
+	accounts, err := m.DBClient.GetAccounts(r.Context(), &models.GetAccountsParams{OrderByPopularity: true})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get categories
+	categories, err := m.DBClient.GetCategories(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get tags
+	tags, err := m.DBClient.GetTags(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting data")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Rules",
+		Form: map[string]*forms.Form{
+			"add-rule": forms.NewFromMap(map[string]string{"enabled": "1"}),
+		},
+	}
+
+	// Add forms for rules
+	for _, rule := range rules.Rules {
+		form := forms.NewFromMap(map[string]string{
+			"name":         rule.Name,
+			"min_amount":   formAmount(rule.MinAmount),
+			"max_amount":   formAmount(rule.MaxAmount),
+			"text":         rule.Text,
+			"account":      formID(rule.AccountId),
+			"add_tags":     strings.Join(rule.AddTags, ","),
+			"set_category": formID(rule.SetCategoryId),
+			"set_account":  formID(rule.SetAccountId),
+		})
+		if rule.Enabled {
+			form.Set("enabled", "1")
+		}
+		for day := 0; day < 7; day++ {
+			if rule.Weekdays&(1<<day) != 0 {
+				form.Add("weekdays", strconv.Itoa(day))
+			}
+		}
+		td.Form[fmt.Sprintf("edit-%d", rule.ID)] = form
+		td.Form[fmt.Sprintf("delete-%d", rule.ID)] = forms.New(nil)
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := rulesview.RulesData{
+		TemplateData: td,
+		Rules:        rules.Rules,
+		Accounts:     accounts.Accounts,
+		Categories:   categories.Categories,
+		Tags:         tags.Tags,
+	}
+
+	// Test a rule against past expenses
+	if testId, err := strconv.ParseInt(r.URL.Query().Get("test"), 10, 64); err == nil {
+		for _, rule := range rules.Rules {
+			if rule.ID != testId {
+				continue
+			}
+
+			test, err := m.DBClient.TestRule(r.Context(), &models.RuleParams{Rule: rule})
+			if err != nil {
+				m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+				m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to test rule"))
+				http.Redirect(w, r, "/rules", http.StatusSeeOther)
+				return
+			}
+			data.Tested = rule
+			data.Test = test
+		}
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+func (m *Repository) PostNewRule(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	rule := m.formRule(r, form)
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"add-rule": form,
+		})
+
+		// Redirect to rules
+		http.Redirect(w, r, "/rules", http.StatusSeeOther)
+		return
+	}
+
+	// Add rule to database
+	_, err = m.DBClient.AddRule(r.Context(), &models.RuleParams{Rule: rule})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to add rule"))
+		http.Redirect(w, r, "/rules", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Rule added")
+	http.Redirect(w, r, "/rules", http.StatusSeeOther)
+}
+
+func (m *Repository) PostEditRule(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get rule id from route param
+	idParam := chi.URLParam(r, "ruleId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid rule")
+		http.Redirect(w, r, "/rules", http.StatusSeeOther)
+		return
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	rule := m.formRule(r, form)
+	rule.ID = id
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			fmt.Sprintf("edit-%d", id): form,
+		})
+
+		// Redirect to rules
+		http.Redirect(w, r, "/rules", http.StatusSeeOther)
+		return
+	}
+
+	// Update rule
+	_, err = m.DBClient.EditRule(r.Context(), &models.RuleParams{Rule: rule})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to edit rule"))
+		http.Redirect(w, r, "/rules", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Rule updated")
+	http.Redirect(w, r, "/rules", http.StatusSeeOther)
+}
+
+func (m *Repository) PostDeleteRule(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get rule id from route param
+	idParam := chi.URLParam(r, "ruleId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid rule")
+		http.Redirect(w, r, "/rules", http.StatusSeeOther)
+		return
+	}
+
+	// Delete rule from database
+	_, err = m.DBClient.DeleteRule(r.Context(), &models.DeleteRuleParams{ID: id})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to delete rule"))
+		http.Redirect(w, r, "/rules", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Rule deleted")
+	http.Redirect(w, r, "/rules", http.StatusSeeOther)
+}
+
+// Validate and get rule fields. Amounts are in the base currency, empty conditions match everything
+func (m *Repository) formRule(r *http.Request, form *forms.Form) *models.GrpcRule {
+	form.Required("name")
+
+	currency := m.Currency(r)
+	rule := &models.GrpcRule{
+		Name:    form.Get("name"),
+		Enabled: form.Get("enabled") == "1",
+		Text:    form.Get("text"),
+		AddTags: []string{},
+	}
+	if form.Get("min_amount") != "" && form.IsMoney("min_amount", currency) {
+		rule.MinAmount = models.MoneyToGrpc(form.Money("min_amount", currency))
+	}
+	if form.Get("max_amount") != "" && form.IsMoney("max_amount", currency) {
+		rule.MaxAmount = models.MoneyToGrpc(form.Money("max_amount", currency))
+	}
+	if form.Get("account") != "" && form.IsInt("account") {
+		rule.AccountId, _ = strconv.ParseInt(form.Get("account"), 10, 64)
+	}
+	for _, day := range form.Values["weekdays"] {
+		d, err := strconv.Atoi(day)
+		if err != nil || d < 0 || d > 6 {
+			form.Errors.Add("weekdays", "Invalid day")
+			continue
+		}
+		rule.Weekdays |= 1 << d
+	}
+	if form.Get("add_tags") != "" {
+		re := regexp.MustCompile(`,\s*`)
+		rule.AddTags = re.Split(form.Get("add_tags"), -1)
+	}
+	if form.Get("set_category") != "" && form.IsInt("set_category") {
+		rule.SetCategoryId, _ = strconv.ParseInt(form.Get("set_category"), 10, 64)
+	}
+	if form.Get("set_account") != "" && form.IsInt("set_account") {
+		rule.SetAccountId, _ = strconv.ParseInt(form.Get("set_account"), 10, 64)
+	}
+	if len(rule.AddTags) == 0 && rule.SetCategoryId == 0 && rule.SetAccountId == 0 {
+		form.Errors.Add("add_tags", "Add tags or set a category or an account")
+	}
+
+	return rule
+}
+
+// Form value of an optional amount
+func formAmount(amount *models.GrpcMoney) string {
+	if amount == nil {
+		return ""
+	}
+	return amount.Decimal()
+}
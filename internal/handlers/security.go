@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/helpers"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/views/securityview"
+)
+
+func (m *Repository) Security(w http.ResponseWriter, r *http.Request) {
+
+	// Get two-factor status
+	twoFactor, err := m.DBClient.GetTwoFactor(r.Context(), &models.GrpcEmpty{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting security settings")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Security",
+		Form: map[string]*forms.Form{
+			"enable-two-factor":  forms.New(nil),
+			"disable-two-factor": forms.New(nil),
+			"recovery-codes":     forms.New(nil),
+		},
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := securityview.SecurityData{
+		TemplateData: td,
+		TwoFactor:    twoFactor,
+	}
+
+	// Get started setup
+	if !twoFactor.Enabled {
+		data.SetupSecret = m.App.Session.GetString(r.Context(), "two_factor_setup_secret")
+		uri := m.App.Session.GetString(r.Context(), "two_factor_setup_uri")
+		if uri != "" {
+			data.QRCode, err = helpers.QRCodeSVG(uri)
+			if err != nil {
+				m.App.Logger.ErrorContext(r.Context(), "can't render qr code", "error", err)
+			}
+		}
+	}
+
+	// Recovery codes are shown once
+	codes := m.App.Session.PopString(r.Context(), "recovery_codes")
+	if codes != "" {
+		data.RecoveryCodes = strings.Split(codes, "\n")
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+func (m *Repository) PostTwoFactorSetup(w http.ResponseWriter, r *http.Request) {
+
+	// Create pending secret
+	setup, err := m.DBClient.BeginTwoFactorSetup(r.Context(), &models.GrpcEmpty{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to start two-factor setup"))
+		http.Redirect(w, r, "/settings/security", http.StatusSeeOther)
+		return
+	}
+
+	// Keep secret until the setup is confirmed
+	m.App.Session.Put(r.Context(), "two_factor_setup_secret", setup.Secret)
+	m.App.Session.Put(r.Context(), "two_factor_setup_uri", setup.URI)
+
+	http.Redirect(w, r, "/settings/security", http.StatusSeeOther)
+}
+
+func (m *Repository) PostEnableTwoFactor(w http.ResponseWriter, r *http.Request) {
+
+	// Get and validate code
+	form, ok := m.twoFactorCodeForm(w, r, "enable-two-factor")
+	if !ok {
+		return
+	}
+
+	// Enable two-factor authentication
+	codes, err := m.DBClient.EnableTwoFactor(r.Context(), &models.TwoFactorCodeParams{Code: form.Get("code")})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to enable two-factor authentication"))
+		http.Redirect(w, r, "/settings/security", http.StatusSeeOther)
+		return
+	}
+
+	// Forget setup
+	m.App.Session.Remove(r.Context(), "two_factor_setup_secret")
+	m.App.Session.Remove(r.Context(), "two_factor_setup_uri")
+
+	// Show recovery codes
+	m.App.Session.Put(r.Context(), "recovery_codes", strings.Join(codes.Codes, "\n"))
+
+	m.AddFlashMsg(r, "Two-factor authentication enabled")
+	http.Redirect(w, r, "/settings/security", http.StatusSeeOther)
+}
+
+func (m *Repository) PostDisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+
+	// Get and validate code
+	form, ok := m.twoFactorCodeForm(w, r, "disable-two-factor")
+	if !ok {
+		return
+	}
+
+	// Disable two-factor authentication
+	_, err := m.DBClient.DisableTwoFactor(r.Context(), &models.TwoFactorCodeParams{Code: form.Get("code")})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to disable two-factor authentication"))
+		http.Redirect(w, r, "/settings/security", http.StatusSeeOther)
+		return
+	}
+
+	m.AddFlashMsg(r, "Two-factor authentication disabled")
+	http.Redirect(w, r, "/settings/security", http.StatusSeeOther)
+}
+
+func (m *Repository) PostRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+
+	// Get and validate code
+	form, ok := m.twoFactorCodeForm(w, r, "recovery-codes")
+	if !ok {
+		return
+	}
+
+	// Replace recovery codes
+	codes, err := m.DBClient.RegenerateRecoveryCodes(r.Context(), &models.TwoFactorCodeParams{Code: form.Get("code")})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to create recovery codes"))
+		http.Redirect(w, r, "/settings/security", http.StatusSeeOther)
+		return
+	}
+
+	// Show recovery codes
+	m.App.Session.Put(r.Context(), "recovery_codes", strings.Join(codes.Codes, "\n"))
+
+	m.AddFlashMsg(r, "New recovery codes created")
+	http.Redirect(w, r, "/settings/security", http.StatusSeeOther)
+}
+
+// Parse a form with a one-time password. Invalid forms are pushed to session and redirected
+func (m *Repository) twoFactorCodeForm(w http.ResponseWriter, r *http.Request, name string) (*forms.Form, bool) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	form.Required("code")
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			name: form,
+		})
+
+		// Redirect to security page
+		http.Redirect(w, r, "/settings/security", http.StatusSeeOther)
+		return nil, false
+	}
+
+	return form, true
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/views/sessionsview"
+	"github.com/go-chi/chi"
+)
+
+func (m *Repository) Sessions(w http.ResponseWriter, r *http.Request) {
+
+	// Get active sessions
+	sessions, err := m.DBClient.GetSessions(r.Context(), &models.GrpcEmpty{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting sessions")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Sessions",
+		Form:  map[string]*forms.Form{},
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := sessionsview.SessionsData{
+		TemplateData: td,
+		Sessions:     sessions.Sessions,
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+func (m *Repository) PostRevokeSession(w http.ResponseWriter, r *http.Request) {
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		log.Println(err)
+	}
+
+	// Get session id from route param
+	idParam := chi.URLParam(r, "sessionId")
+	id, err := strconv.ParseInt(idParam, 10, 64)
+	if idParam == "" || err != nil {
+		m.AddErrorMsg(r, "Invalid session")
+		http.Redirect(w, r, "/settings/sessions", http.StatusSeeOther)
+		return
+	}
+
+	// Get active sessions
+	sessions, err := m.DBClient.GetSessions(r.Context(), &models.GrpcEmpty{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to revoke session"))
+		http.Redirect(w, r, "/settings/sessions", http.StatusSeeOther)
+		return
+	}
+
+	// Check if current session is revoked
+	current := false
+	for _, session := range sessions.Sessions {
+		if session.ID == id && session.Current {
+			current = true
+		}
+	}
+
+	// Log out of current session
+	if current {
+		m.Logout(w, r)
+		return
+	}
+
+	// Revoke session
+	_, err = m.DBClient.RevokeSession(r.Context(), &models.RevokeSessionParams{ID: id})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to revoke session"))
+		http.Redirect(w, r, "/settings/sessions", http.StatusSeeOther)
+		return
+	}
+
+	// Add success message
+	m.AddFlashMsg(r, "Session revoked")
+	http.Redirect(w, r, "/settings/sessions", http.StatusSeeOther)
+}
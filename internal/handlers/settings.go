@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/forms"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"github.com/dimitargrozev5/expenses-go-1/internal/prefs"
+	"github.com/dimitargrozev5/expenses-go-1/views/settingsview"
+)
+
+func (m *Repository) Settings(w http.ResponseWriter, r *http.Request) {
+
+	// Get settings
+	settings, err := m.DBClient.GetSettings(r.Context(), &models.GrpcEmpty{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting settings")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get accounts
+	accounts, err := m.DBClient.GetAccounts(r.Context(), &models.GetAccountsParams{})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting settings")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get categories
+	categories, err := m.DBClient.GetCategories(r.Context(), nil)
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, "Error getting settings")
+		http.Redirect(w, r, "/logout", http.StatusSeeOther)
+		return
+	}
+
+	// Get template data
+	td := models.TemplateData{
+		Title: "Settings",
+		Form: map[string]*forms.Form{
+			"settings": forms.NewFromMap(map[string]string{
+				"locale":            settings.Locale,
+				"number_format":     settings.NumberFormat,
+				"date_format":       settings.DateFormat,
+				"first_day_of_week": fmt.Sprint(settings.FirstDayOfWeek),
+				"default_account":   idOrEmpty(settings.DefaultAccountID),
+				"default_category":  idOrEmpty(settings.DefaultCategoryID),
+				"expense_range":     settings.ExpenseRange,
+				"currency":          settings.BaseCurrency,
+			}),
+		},
+	}
+
+	// Add default data
+	m.AddDefaultData(&td, r)
+
+	// Setup page data
+	data := settingsview.SettingsData{
+		TemplateData: td,
+		Accounts:     accounts.Accounts,
+		Categories:   categories.Categories,
+	}
+
+	// Render view
+	data.View().Render(r.Context(), w)
+}
+
+func (m *Repository) PostSettings(w http.ResponseWriter, r *http.Request) {
+
+	// Parse form
+	err := r.ParseForm()
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+	}
+
+	// Get form and validate fields
+	form := forms.New(r.PostForm)
+	form.Required("currency", "first_day_of_week", "expense_range")
+	form.IsCurrency("currency")
+	if form.Get("rate") != "" {
+		form.IsRate("rate")
+	}
+	if !prefs.ValidLocale(form.Get("locale")) {
+		form.Errors.Add("locale", "Unsupported locale")
+	}
+	if !prefs.ValidNumberFormat(form.Get("number_format")) {
+		form.Errors.Add("number_format", "Unsupported number format")
+	}
+	if !prefs.ValidDateFormat(form.Get("date_format")) {
+		form.Errors.Add("date_format", "Unsupported date format")
+	}
+	if !prefs.ValidExpenseRange(form.Get("expense_range")) {
+		form.Errors.Add("expense_range", "Unsupported range")
+	}
+
+	// Parse numbers
+	firstDay, err := strconv.ParseInt(form.Get("first_day_of_week"), 10, 64)
+	if err != nil || firstDay < 0 || firstDay > 6 {
+		form.Errors.Add("first_day_of_week", "Choose a day of the week")
+	}
+	account, ok := parseOptionalID(form.Get("default_account"))
+	if !ok {
+		form.Errors.Add("default_account", "Choose an account")
+	}
+	category, ok := parseOptionalID(form.Get("default_category"))
+	if !ok {
+		form.Errors.Add("default_category", "Choose a category")
+	}
+
+	if !form.Valid() {
+
+		// Push form to session
+		m.AddForms(r, map[string]*forms.Form{
+			"settings": form,
+		})
+
+		// Redirect to settings
+		http.Redirect(w, r, "/settings/preferences", http.StatusSeeOther)
+		return
+	}
+
+	// Update settings
+	currency := money.Normalize(form.Get("currency"))
+	_, err = m.DBClient.UpdateSettings(r.Context(), &models.UpdateSettingsParams{
+		Settings: &models.GrpcSettings{
+			BaseCurrency:      currency,
+			Locale:            form.Get("locale"),
+			NumberFormat:      form.Get("number_format"),
+			DateFormat:        form.Get("date_format"),
+			FirstDayOfWeek:    firstDay,
+			DefaultAccountID:  account,
+			DefaultCategoryID: category,
+			ExpenseRange:      form.Get("expense_range"),
+		},
+		Rate: form.Get("rate"),
+	})
+	if err != nil {
+		m.App.Logger.ErrorContext(r.Context(), "request failed", "error", err)
+		m.AddErrorMsg(r, domainerr.UserMessage(err, "Failed to save settings"))
+		m.AddForms(r, map[string]*forms.Form{
+			"settings": form,
+		})
+		http.Redirect(w, r, "/settings/preferences", http.StatusSeeOther)
+		return
+	}
+
+	// Update cached currency and preferences
+	m.App.Session.Put(r.Context(), "currency", currency)
+	m.App.Session.Remove(r.Context(), "preferences")
+
+	// Add success message
+	m.AddFlashMsg(r, "Settings saved")
+	http.Redirect(w, r, "/settings/preferences", http.StatusSeeOther)
+}
+
+// Format an optional id for a select. Zero is no selection
+func idOrEmpty(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return fmt.Sprint(id)
+}
+
+// Parse an optional id from a select. Empty is zero
+func parseOptionalID(s string) (int64, bool) {
+	if s == "" {
+		return 0, true
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	return id, err == nil && id > 0
+}
@@ -1,7 +1,6 @@
 package helpers
 
 import (
-	"fmt"
 	"net/http"
 	"runtime/debug"
 
@@ -16,12 +15,11 @@ func NewHelpers(a *config.AppConfig) {
 
 func ClientError(w http.ResponseWriter, status int) {
 	http.Error(w, http.StatusText(status), status)
-	app.ErrorLog.Println("Client error with status of", status)
+	app.Logger.Error("client error", "status", status)
 }
 
 func ServerError(w http.ResponseWriter, err error) {
-	trace := fmt.Sprintf("%s\n%s", err.Error(), debug.Stack())
-	app.ErrorLog.Println(trace)
+	app.Logger.Error("server error", "error", err, "stack", string(debug.Stack()))
 	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 }
 
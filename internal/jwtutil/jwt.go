@@ -1,12 +1,26 @@
 package jwtutil
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
 	"github.com/dimitargrozev5/expenses-go-1/internal/config"
 	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// Access tokens are short lived and get renewed with the refresh token
+const AccessTokenLifetime = 15 * time.Minute
+const RefreshTokenLifetime = 30 * 24 * time.Hour
+
+// Challenge tokens carry a checked password to the second login step
+const ChallengeTokenLifetime = 5 * time.Minute
+const challengePurpose = "2fa"
+
 type JWTUtilRepo struct {
 	App config.Config
 }
@@ -28,6 +42,50 @@ func (j *JWTUtilRepo) Generate(claims jwt.MapClaims) (string, error) {
 	return jwt, nil
 }
 
+// Generate access token for a user session
+func (j *JWTUtilRepo) GenerateAccessToken(userKey string, dbVersion int64, jti string) (string, time.Time, error) {
+	// Get expiry
+	expiresAt := time.Now().Add(AccessTokenLifetime)
+
+	// Sign token
+	token, err := j.Generate(jwt.MapClaims{
+		"jti":       jti,
+		"userKey":   userKey,
+		"dbVersion": dbVersion,
+		"exp":       expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", expiresAt, err
+	}
+
+	return token, expiresAt, nil
+}
+
+// Generate challenge token for a user that passed the password check. It has no token id, so it's not accepted as an access token
+func (j *JWTUtilRepo) GenerateChallengeToken(userKey string) (string, error) {
+	return j.Generate(jwt.MapClaims{
+		"userKey": userKey,
+		"purpose": challengePurpose,
+		"exp":     time.Now().Add(ChallengeTokenLifetime).Unix(),
+	})
+}
+
+// Get user key of a challenge token
+func (j *JWTUtilRepo) ParseChallengeToken(token string) (string, error) {
+	claims, err := j.Parse(token)
+	if err != nil {
+		return "", err
+	}
+
+	purpose, _ := claims["purpose"].(string)
+	userKey, _ := claims["userKey"].(string)
+	if purpose != challengePurpose || userKey == "" {
+		return "", status.Errorf(codes.Unauthenticated, "invalid token")
+	}
+
+	return userKey, nil
+}
+
 func (j *JWTUtilRepo) Parse(token string) (jwt.MapClaims, error) {
 
 	errInvalidToken := status.Errorf(codes.Unauthenticated, "invalid token")
@@ -48,3 +106,29 @@ func (j *JWTUtilRepo) Parse(token string) (jwt.MapClaims, error) {
 
 	return claims, nil
 }
+
+// Generate a random token identifier
+func NewJTI() (string, error) {
+	return randomString(16)
+}
+
+// Generate a random opaque refresh token
+func NewRefreshToken() (string, error) {
+	return randomString(32)
+}
+
+// Refresh tokens are stored hashed
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(size int) (string, error) {
+	b := make([]byte, size)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
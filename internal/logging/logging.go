@@ -0,0 +1,133 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+// Request id is sent between services in this metadata key
+const RequestIDMetadataKey = "x-request-id"
+
+// Request id is returned to the browser in this header
+const RequestIDHeader = "X-Request-Id"
+
+type ctxKey int
+
+const (
+	requestIDKey ctxKey = iota
+	fieldsKey
+)
+
+// Fields added to every log record of a request
+type fields struct {
+	mu    sync.Mutex
+	attrs []slog.Attr
+}
+
+// Create JSON logger for a service
+func New(w io.Writer, service string) *slog.Logger {
+	handler := slog.NewJSONHandler(w, &slog.HandlerOptions{AddSource: true})
+	return slog.New(contextHandler{handler}).With("service", service)
+}
+
+// Handler that adds request details from the context to every record
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); len(id) > 0 {
+		r.AddAttrs(slog.String("requestId", id))
+	}
+
+	if f, ok := ctx.Value(fieldsKey).(*fields); ok {
+		f.mu.Lock()
+		r.AddAttrs(f.attrs...)
+		f.mu.Unlock()
+	}
+
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+		r.AddAttrs(slog.String("traceId", spanCtx.TraceID().String()), slog.String("spanId", spanCtx.SpanID().String()))
+	}
+
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+// Generate new request id
+func NewRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Store request id in context
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// Get request id from context
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// Add a set of fields that handlers further down the chain can fill
+func WithFields(ctx context.Context) context.Context {
+	return context.WithValue(ctx, fieldsKey, &fields{})
+}
+
+// Add fields to every following log record of the request
+func AddFields(ctx context.Context, args ...any) {
+	f, ok := ctx.Value(fieldsKey).(*fields)
+	if !ok {
+		return
+	}
+
+	// Convert arguments the same way slog does
+	r := slog.Record{}
+	r.Add(args...)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r.Attrs(func(a slog.Attr) bool {
+		f.attrs = append(f.attrs, a)
+		return true
+	})
+}
+
+// Add request id to outgoing gRPC metadata
+func OutgoingContext(ctx context.Context) context.Context {
+	id := RequestID(ctx)
+	if len(id) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, id)
+}
+
+// Get request id from incoming gRPC metadata, or start a new one
+func IncomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if ids := md.Get(RequestIDMetadataKey); len(ids) > 0 && len(ids[0]) > 0 {
+			return WithRequestID(ctx, ids[0])
+		}
+	}
+
+	return WithRequestID(ctx, NewRequestID())
+}
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/sysinfo"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Reports sizes of sqlite files in a directory. Encrypted user dbs are counted too
+type dbFilesCollector struct {
+	dir   string
+	size  *prometheus.Desc
+	total *prometheus.Desc
+	count *prometheus.Desc
+}
+
+func NewDBFilesCollector(dir string) prometheus.Collector {
+	return &dbFilesCollector{
+		dir:   dir,
+		size:  prometheus.NewDesc("db_file_size_bytes", "Size of a sqlite database file.", []string{"file"}, nil),
+		total: prometheus.NewDesc("db_files_size_bytes_total", "Combined size of all sqlite database files.", nil, nil),
+		count: prometheus.NewDesc("db_files", "Number of sqlite database files.", nil, nil),
+	}
+}
+
+func (c *dbFilesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.size
+	ch <- c.total
+	ch <- c.count
+}
+
+func (c *dbFilesCollector) Collect(ch chan<- prometheus.Metric) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	var total, count float64
+	for _, entry := range entries {
+		if entry.IsDir() || !(strings.HasSuffix(entry.Name(), ".db") || strings.HasSuffix(entry.Name(), ".db.enc")) {
+			continue
+		}
+
+		info, err := os.Stat(filepath.Join(c.dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+
+		size := float64(info.Size())
+		total += size
+		count++
+
+		ch <- prometheus.MustNewConstMetric(c.size, prometheus.GaugeValue, size, entry.Name())
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, total)
+	ch <- prometheus.MustNewConstMetric(c.count, prometheus.GaugeValue, count)
+}
+
+// Reports node resources the same way they are sent to the DB Controller
+type sysinfoCollector struct {
+	totalMemory  *prometheus.Desc
+	freeMemory   *prometheus.Desc
+	totalStorage *prometheus.Desc
+	freeStorage  *prometheus.Desc
+	cpuLoad      *prometheus.Desc
+}
+
+func NewSysinfoCollector() prometheus.Collector {
+	return &sysinfoCollector{
+		totalMemory:  prometheus.NewDesc("node_total_memory_mb", "Total memory of the node in MB.", nil, nil),
+		freeMemory:   prometheus.NewDesc("node_free_memory_mb", "Free memory of the node in MB.", nil, nil),
+		totalStorage: prometheus.NewDesc("node_total_storage_mb", "Total storage of the node in MB.", nil, nil),
+		freeStorage:  prometheus.NewDesc("node_free_storage_mb", "Free storage of the node in MB.", nil, nil),
+		cpuLoad:      prometheus.NewDesc("node_cpu_load_percent", "CPU load of the node.", nil, nil),
+	}
+}
+
+func (c *sysinfoCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.totalMemory
+	ch <- c.freeMemory
+	ch <- c.totalStorage
+	ch <- c.freeStorage
+	ch <- c.cpuLoad
+}
+
+func (c *sysinfoCollector) Collect(ch chan<- prometheus.Metric) {
+	props := sysinfo.Overview()
+
+	ch <- prometheus.MustNewConstMetric(c.totalMemory, prometheus.GaugeValue, props.TotalMemoryMB)
+	ch <- prometheus.MustNewConstMetric(c.freeMemory, prometheus.GaugeValue, props.FreeMemoryMB)
+	ch <- prometheus.MustNewConstMetric(c.totalStorage, prometheus.GaugeValue, props.TotalStorageMB)
+	ch <- prometheus.MustNewConstMetric(c.freeStorage, prometheus.GaugeValue, props.FreeStorageMB)
+	ch <- prometheus.MustNewConstMetric(c.cpuLoad, prometheus.GaugeValue, props.CpuLoadPercent)
+}
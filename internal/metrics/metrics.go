@@ -0,0 +1,171 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcServerHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Total number of RPCs completed on the server.",
+	}, []string{"method", "code"})
+
+	grpcServerSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Latency of RPCs handled by the server.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	grpcClientHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_handled_total",
+		Help: "Total number of RPCs completed by the client.",
+	}, []string{"method", "code"})
+
+	grpcClientSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_client_handling_seconds",
+		Help:    "Latency of RPCs made by the client.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total number of HTTP requests by route and status.",
+	}, []string{"method", "route", "status"})
+
+	httpSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Latency of HTTP requests by route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	dbErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_errors_total",
+		Help: "Total number of database errors returned to callers by reason.",
+	}, []string{"reason"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		grpcServerHandled,
+		grpcServerSeconds,
+		grpcClientHandled,
+		grpcClientSeconds,
+		httpRequests,
+		httpSeconds,
+		dbErrors,
+	)
+}
+
+// Handler serving all registered metrics
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Serve metrics on a separate address
+func Serve(addr string, logger *slog.Logger) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	srv := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+
+	go func() {
+		err := srv.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			logger.Error("metrics server stopped", "error", err)
+		}
+	}()
+
+	return srv
+}
+
+// Register metrics collected by the caller
+func MustRegister(collectors ...prometheus.Collector) {
+	prometheus.MustRegister(collectors...)
+}
+
+// Record RPCs handled by the server
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+
+	m, err := handler(ctx, req)
+
+	grpcServerSeconds.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+	grpcServerHandled.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+
+	// Count errors coming from the database
+	if reason := domainerr.Reason(err); len(reason) > 0 {
+		dbErrors.WithLabelValues(reason).Inc()
+	}
+
+	return m, err
+}
+
+// Record streams handled by the server
+func StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+
+	err := handler(srv, ss)
+
+	grpcServerSeconds.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+	grpcServerHandled.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+
+	return err
+}
+
+// Record RPCs made by the client
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	grpcClientSeconds.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	grpcClientHandled.WithLabelValues(method, status.Code(err).String()).Inc()
+
+	return err
+}
+
+// Record streams opened by the client. Streams are timed until they are opened
+func StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	start := time.Now()
+
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+
+	grpcClientSeconds.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	grpcClientHandled.WithLabelValues(method, status.Code(err).String()).Inc()
+
+	return stream, err
+}
+
+// Record HTTP requests by chi route
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		// Get route pattern once routing is done
+		route := chi.RouteContext(r.Context()).RoutePattern()
+		if len(route) == 0 {
+			route = "unmatched"
+		}
+
+		httpSeconds.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+		httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(ww.Status())).Inc()
+	})
+}
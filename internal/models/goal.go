@@ -0,0 +1,41 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Get the input needed at every reset from now until the goal date to save the remaining amount.
+// Unit is the period of the time_periods table (YEARS, MONTHS or DAYS)
+func GoalContribution(remaining int64, now, goalDate time.Time, interval int64, unit string) int64 {
+	if remaining <= 0 {
+		return 0
+	}
+
+	// Count resets until the goal date, including the one now
+	resets := int64(0)
+	if interval > 0 {
+		for t := now; !t.After(goalDate); t = addPeriod(t, interval, unit) {
+			resets++
+		}
+	}
+	if resets < 1 {
+		return remaining
+	}
+
+	// Round up so the goal is reached on time
+	return (remaining + resets - 1) / resets
+}
+
+// Add input periods to time
+func addPeriod(t time.Time, interval int64, unit string) time.Time {
+	n := int(interval)
+	switch strings.TrimSpace(unit) {
+	case "YEARS":
+		return t.AddDate(n, 0, 0)
+	case "MONTHS":
+		return t.AddDate(0, n, 0)
+	default:
+		return t.AddDate(0, 0, n)
+	}
+}
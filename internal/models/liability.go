@@ -0,0 +1,23 @@
+package models
+
+// Kinds of liabilities. Borrowed money is owed by the user, lent money is owed to the user
+const (
+	LiabilityBorrowed = "borrowed"
+	LiabilityLent     = "lent"
+)
+
+// Check if kind is a known liability kind
+func ValidLiabilityKind(kind string) bool {
+	return kind == LiabilityBorrowed || kind == LiabilityLent
+}
+
+// Kinds of expense refunds. Refunds come from the seller, reimbursements from whoever covers the expense
+const (
+	RefundRefund        = "refund"
+	RefundReimbursement = "reimbursement"
+)
+
+// Check if kind is a known refund kind
+func ValidRefundKind(kind string) bool {
+	return kind == RefundRefund || kind == RefundReimbursement
+}
@@ -13,6 +13,30 @@ type DBNode struct {
 	UpdatedAt     sql.NullTime
 }
 
+// Cluster overview kept by the DB Controller
+type ClusterStats struct {
+	Nodes            int64
+	ActiveNodes      int64
+	UsersByStatus    map[string]int64
+	UsersByNode      map[int64]int64
+	UsersByDBVersion map[int64]int64
+	ActiveSessions   int64
+}
+
+// User session
+type Session struct {
+	ID            int64
+	UserKey       string
+	Jti           string
+	Device        string
+	RemoteAddress string
+	ExpiresAt     time.Time
+	LastSeenAt    time.Time
+	RevokedAt     sql.NullTime
+	CreatedAt     time.Time
+	UpdatedAt     sql.NullTime
+}
+
 /**
 		TODO: From here down possibly depricated
 **/
@@ -114,8 +114,10 @@ type LoginCredentials struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Device        string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	RemoteAddress string `protobuf:"bytes,4,opt,name=remoteAddress,proto3" json:"remoteAddress,omitempty"`
 }
 
 func (x *LoginCredentials) Reset() {
@@ -164,12 +166,32 @@ func (x *LoginCredentials) GetPassword() string {
 	return ""
 }
 
+func (x *LoginCredentials) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *LoginCredentials) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+// Users with two-factor authentication get a challenge token instead of the tokens.
+// It's exchanged for the tokens with a one-time password
 type LoginToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token             string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken      string                 `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	TwoFactorRequired bool                   `protobuf:"varint,4,opt,name=twoFactorRequired,proto3" json:"twoFactorRequired,omitempty"`
+	ChallengeToken    string                 `protobuf:"bytes,5,opt,name=challengeToken,proto3" json:"challengeToken,omitempty"`
 }
 
 func (x *LoginToken) Reset() {
@@ -211,14 +233,48 @@ func (x *LoginToken) GetToken() string {
 	return ""
 }
 
-type LogoutParams struct {
+func (x *LoginToken) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *LoginToken) GetTwoFactorRequired() bool {
+	if x != nil {
+		return x.TwoFactorRequired
+	}
+	return false
+}
+
+func (x *LoginToken) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+type TwoFactorLoginParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challengeToken,proto3" json:"challengeToken,omitempty"`
+	// One-time password or recovery code
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Device        string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	RemoteAddress string `protobuf:"bytes,4,opt,name=remoteAddress,proto3" json:"remoteAddress,omitempty"`
 }
 
-func (x *LogoutParams) Reset() {
-	*x = LogoutParams{}
+func (x *TwoFactorLoginParams) Reset() {
+	*x = TwoFactorLoginParams{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -226,13 +282,13 @@ func (x *LogoutParams) Reset() {
 	}
 }
 
-func (x *LogoutParams) String() string {
+func (x *TwoFactorLoginParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutParams) ProtoMessage() {}
+func (*TwoFactorLoginParams) ProtoMessage() {}
 
-func (x *LogoutParams) ProtoReflect() protoreflect.Message {
+func (x *TwoFactorLoginParams) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,28 +300,47 @@ func (x *LogoutParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutParams.ProtoReflect.Descriptor instead.
-func (*LogoutParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use TwoFactorLoginParams.ProtoReflect.Descriptor instead.
+func (*TwoFactorLoginParams) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{4}
 }
 
-// User
-type GrpcUser struct {
+func (x *TwoFactorLoginParams) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *TwoFactorLoginParams) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TwoFactorLoginParams) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *TwoFactorLoginParams) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+type LogoutParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Email     string                 `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
-	Password  string                 `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
-	DBVersion int64                  `protobuf:"varint,4,opt,name=DBVersion,proto3" json:"DBVersion,omitempty"`
-	FreeFunds float64                `protobuf:"fixed64,5,opt,name=FreeFunds,proto3" json:"FreeFunds,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
 }
 
-func (x *GrpcUser) Reset() {
-	*x = GrpcUser{}
+func (x *LogoutParams) Reset() {
+	*x = LogoutParams{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -273,13 +348,13 @@ func (x *GrpcUser) Reset() {
 	}
 }
 
-func (x *GrpcUser) String() string {
+func (x *LogoutParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrpcUser) ProtoMessage() {}
+func (*LogoutParams) ProtoMessage() {}
 
-func (x *GrpcUser) ProtoReflect() protoreflect.Message {
+func (x *LogoutParams) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,95 +366,90 @@ func (x *GrpcUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrpcUser.ProtoReflect.Descriptor instead.
-func (*GrpcUser) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogoutParams.ProtoReflect.Descriptor instead.
+func (*LogoutParams) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GrpcUser) GetID() int64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
+type RefreshTokenParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GrpcUser) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
 }
 
-func (x *GrpcUser) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *RefreshTokenParams) Reset() {
+	*x = RefreshTokenParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GrpcUser) GetDBVersion() int64 {
-	if x != nil {
-		return x.DBVersion
-	}
-	return 0
+func (x *RefreshTokenParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GrpcUser) GetFreeFunds() float64 {
-	if x != nil {
-		return x.FreeFunds
+func (*RefreshTokenParams) ProtoMessage() {}
+
+func (x *RefreshTokenParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GrpcUser) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use RefreshTokenParams.ProtoReflect.Descriptor instead.
+func (*RefreshTokenParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GrpcUser) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *RefreshTokenParams) GetRefreshToken() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.RefreshToken
 	}
-	return nil
+	return ""
 }
 
-// Expenses
-type GrpcExpense struct {
+// Sessions
+type GrpcSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID             int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Amount         float64                `protobuf:"fixed64,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
-	Date           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=Date,proto3" json:"Date,omitempty"`
-	Tags           []*GrpcTag             `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
-	FromAccountId  int64                  `protobuf:"varint,5,opt,name=FromAccountId,proto3" json:"FromAccountId,omitempty"`
-	FromAccount    *GrpcAccount           `protobuf:"bytes,6,opt,name=FromAccount,proto3" json:"FromAccount,omitempty"`
-	FromCategoryId int64                  `protobuf:"varint,7,opt,name=FromCategoryId,proto3" json:"FromCategoryId,omitempty"`
-	FromCategory   *GrpcCategory          `protobuf:"bytes,8,opt,name=FromCategory,proto3" json:"FromCategory,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Device        string                 `protobuf:"bytes,2,opt,name=Device,proto3" json:"Device,omitempty"`
+	RemoteAddress string                 `protobuf:"bytes,3,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	Current       bool                   `protobuf:"varint,4,opt,name=Current,proto3" json:"Current,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=LastSeenAt,proto3" json:"LastSeenAt,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
 }
 
-func (x *GrpcExpense) Reset() {
-	*x = GrpcExpense{}
+func (x *GrpcSession) Reset() {
+	*x = GrpcSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[6]
+		mi := &file_models_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrpcExpense) String() string {
+func (x *GrpcSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrpcExpense) ProtoMessage() {}
+func (*GrpcSession) ProtoMessage() {}
 
-func (x *GrpcExpense) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[6]
+func (x *GrpcSession) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,111 +460,90 @@ func (x *GrpcExpense) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrpcExpense.ProtoReflect.Descriptor instead.
-func (*GrpcExpense) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GrpcSession.ProtoReflect.Descriptor instead.
+func (*GrpcSession) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GrpcExpense) GetID() int64 {
+func (x *GrpcSession) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GrpcExpense) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *GrpcExpense) GetDate() *timestamppb.Timestamp {
+func (x *GrpcSession) GetDevice() string {
 	if x != nil {
-		return x.Date
+		return x.Device
 	}
-	return nil
+	return ""
 }
 
-func (x *GrpcExpense) GetTags() []*GrpcTag {
+func (x *GrpcSession) GetRemoteAddress() string {
 	if x != nil {
-		return x.Tags
+		return x.RemoteAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *GrpcExpense) GetFromAccountId() int64 {
+func (x *GrpcSession) GetCurrent() bool {
 	if x != nil {
-		return x.FromAccountId
+		return x.Current
 	}
-	return 0
+	return false
 }
 
-func (x *GrpcExpense) GetFromAccount() *GrpcAccount {
+func (x *GrpcSession) GetLastSeenAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FromAccount
+		return x.LastSeenAt
 	}
 	return nil
 }
 
-func (x *GrpcExpense) GetFromCategoryId() int64 {
-	if x != nil {
-		return x.FromCategoryId
-	}
-	return 0
-}
-
-func (x *GrpcExpense) GetFromCategory() *GrpcCategory {
+func (x *GrpcSession) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FromCategory
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *GrpcExpense) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GrpcSession) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *GrpcExpense) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-// Tags
-type GrpcTag struct {
+// Session identifiers issued by the DB Controller
+type GrpcSessionToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID         int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name       string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	UsageCount int64                  `protobuf:"varint,3,opt,name=UsageCount,proto3" json:"UsageCount,omitempty"`
-	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+	ID           int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserKey      string                 `protobuf:"bytes,2,opt,name=UserKey,proto3" json:"UserKey,omitempty"`
+	Jti          string                 `protobuf:"bytes,3,opt,name=Jti,proto3" json:"Jti,omitempty"`
+	RefreshToken string                 `protobuf:"bytes,4,opt,name=RefreshToken,proto3" json:"RefreshToken,omitempty"`
+	ExpiresAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
-func (x *GrpcTag) Reset() {
-	*x = GrpcTag{}
+func (x *GrpcSessionToken) Reset() {
+	*x = GrpcSessionToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[7]
+		mi := &file_models_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrpcTag) String() string {
+func (x *GrpcSessionToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrpcTag) ProtoMessage() {}
+func (*GrpcSessionToken) ProtoMessage() {}
 
-func (x *GrpcTag) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[7]
+func (x *GrpcSessionToken) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,76 +554,73 @@ func (x *GrpcTag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrpcTag.ProtoReflect.Descriptor instead.
-func (*GrpcTag) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GrpcSessionToken.ProtoReflect.Descriptor instead.
+func (*GrpcSessionToken) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GrpcTag) GetID() int64 {
+func (x *GrpcSessionToken) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GrpcTag) GetName() string {
+func (x *GrpcSessionToken) GetUserKey() string {
 	if x != nil {
-		return x.Name
+		return x.UserKey
 	}
 	return ""
 }
 
-func (x *GrpcTag) GetUsageCount() int64 {
+func (x *GrpcSessionToken) GetJti() string {
 	if x != nil {
-		return x.UsageCount
+		return x.Jti
 	}
-	return 0
+	return ""
 }
 
-func (x *GrpcTag) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GrpcSessionToken) GetRefreshToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RefreshToken
 	}
-	return nil
+	return ""
 }
 
-func (x *GrpcTag) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GrpcSessionToken) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-// Expense-Tag relation
-type GrpcExpenseToTagRealtion struct {
+// Money in minor units of its currency (e.g. cents)
+type GrpcMoney struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	ExpenseId int64                  `protobuf:"varint,2,opt,name=ExpenseId,proto3" json:"ExpenseId,omitempty"`
-	TagId     int64                  `protobuf:"varint,3,opt,name=TagId,proto3" json:"TagId,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+	Amount   int64  `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Currency string `protobuf:"bytes,2,opt,name=Currency,proto3" json:"Currency,omitempty"`
 }
 
-func (x *GrpcExpenseToTagRealtion) Reset() {
-	*x = GrpcExpenseToTagRealtion{}
+func (x *GrpcMoney) Reset() {
+	*x = GrpcMoney{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[8]
+		mi := &file_models_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrpcExpenseToTagRealtion) String() string {
+func (x *GrpcMoney) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrpcExpenseToTagRealtion) ProtoMessage() {}
+func (*GrpcMoney) ProtoMessage() {}
 
-func (x *GrpcExpenseToTagRealtion) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[8]
+func (x *GrpcMoney) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,78 +631,58 @@ func (x *GrpcExpenseToTagRealtion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrpcExpenseToTagRealtion.ProtoReflect.Descriptor instead.
-func (*GrpcExpenseToTagRealtion) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GrpcMoney.ProtoReflect.Descriptor instead.
+func (*GrpcMoney) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GrpcExpenseToTagRealtion) GetID() int64 {
+func (x *GrpcMoney) GetAmount() int64 {
 	if x != nil {
-		return x.ID
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *GrpcExpenseToTagRealtion) GetExpenseId() int64 {
+func (x *GrpcMoney) GetCurrency() string {
 	if x != nil {
-		return x.ExpenseId
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *GrpcExpenseToTagRealtion) GetTagId() int64 {
-	if x != nil {
-		return x.TagId
-	}
-	return 0
-}
-
-func (x *GrpcExpenseToTagRealtion) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *GrpcExpenseToTagRealtion) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-// Accounts
-type GrpcAccount struct {
+// User
+type GrpcUser struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	CurrentAmount float64                `protobuf:"fixed64,3,opt,name=CurrentAmount,proto3" json:"CurrentAmount,omitempty"`
-	UsageCount    int64                  `protobuf:"varint,4,opt,name=UsageCount,proto3" json:"UsageCount,omitempty"`
-	TableOrder    int64                  `protobuf:"varint,5,opt,name=TableOrder,proto3" json:"TableOrder,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
+	Password  string                 `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
+	DBVersion int64                  `protobuf:"varint,4,opt,name=DBVersion,proto3" json:"DBVersion,omitempty"`
+	FreeFunds *GrpcMoney             `protobuf:"bytes,5,opt,name=FreeFunds,proto3" json:"FreeFunds,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+	Currency  string                 `protobuf:"bytes,8,opt,name=Currency,proto3" json:"Currency,omitempty"`
 }
 
-func (x *GrpcAccount) Reset() {
-	*x = GrpcAccount{}
+func (x *GrpcUser) Reset() {
+	*x = GrpcUser{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[9]
+		mi := &file_models_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrpcAccount) String() string {
+func (x *GrpcUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrpcAccount) ProtoMessage() {}
+func (*GrpcUser) ProtoMessage() {}
 
-func (x *GrpcAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[9]
+func (x *GrpcUser) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,96 +693,119 @@ func (x *GrpcAccount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrpcAccount.ProtoReflect.Descriptor instead.
-func (*GrpcAccount) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GrpcUser.ProtoReflect.Descriptor instead.
+func (*GrpcUser) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GrpcAccount) GetID() int64 {
+func (x *GrpcUser) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GrpcAccount) GetName() string {
+func (x *GrpcUser) GetEmail() string {
 	if x != nil {
-		return x.Name
+		return x.Email
 	}
 	return ""
 }
 
-func (x *GrpcAccount) GetCurrentAmount() float64 {
+func (x *GrpcUser) GetPassword() string {
 	if x != nil {
-		return x.CurrentAmount
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-func (x *GrpcAccount) GetUsageCount() int64 {
+func (x *GrpcUser) GetDBVersion() int64 {
 	if x != nil {
-		return x.UsageCount
+		return x.DBVersion
 	}
 	return 0
 }
 
-func (x *GrpcAccount) GetTableOrder() int64 {
+func (x *GrpcUser) GetFreeFunds() *GrpcMoney {
 	if x != nil {
-		return x.TableOrder
+		return x.FreeFunds
 	}
-	return 0
+	return nil
 }
 
-func (x *GrpcAccount) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GrpcUser) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *GrpcAccount) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GrpcUser) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-// Categories
-type GrpcCategory struct {
+func (x *GrpcUser) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Expenses
+type GrpcExpense struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	BudgetInput   float64                `protobuf:"fixed64,3,opt,name=BudgetInput,proto3" json:"BudgetInput,omitempty"`
-	LastInputDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=LastInputDate,proto3" json:"LastInputDate,omitempty"` // time.Duration InputInterval = 5;
-	SpendingLimit float64                `protobuf:"fixed64,6,opt,name=SpendingLimit,proto3" json:"SpendingLimit,omitempty"`
-	SpendingLeft  float64                `protobuf:"fixed64,7,opt,name=SpendingLeft,proto3" json:"SpendingLeft,omitempty"`
-	InitialAmount float64                `protobuf:"fixed64,8,opt,name=InitialAmount,proto3" json:"InitialAmount,omitempty"`
-	CurrentAmount float64                `protobuf:"fixed64,9,opt,name=CurrentAmount,proto3" json:"CurrentAmount,omitempty"`
-	TableOrder    int64                  `protobuf:"varint,10,opt,name=TableOrder,proto3" json:"TableOrder,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+	ID             int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Amount         *GrpcMoney             `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Date           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=Date,proto3" json:"Date,omitempty"`
+	Tags           []*GrpcTag             `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	FromAccountId  int64                  `protobuf:"varint,5,opt,name=FromAccountId,proto3" json:"FromAccountId,omitempty"`
+	FromAccount    *GrpcAccount           `protobuf:"bytes,6,opt,name=FromAccount,proto3" json:"FromAccount,omitempty"`
+	FromCategoryId int64                  `protobuf:"varint,7,opt,name=FromCategoryId,proto3" json:"FromCategoryId,omitempty"`
+	FromCategory   *GrpcCategory          `protobuf:"bytes,8,opt,name=FromCategory,proto3" json:"FromCategory,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+	// Amount in the base currency
+	BaseAmount *GrpcMoney `protobuf:"bytes,11,opt,name=BaseAmount,proto3" json:"BaseAmount,omitempty"`
+	// Lines of a split expense reference the expense they belong to
+	ParentId int64          `protobuf:"varint,12,opt,name=ParentId,proto3" json:"ParentId,omitempty"`
+	Splits   []*GrpcExpense `protobuf:"bytes,13,rep,name=Splits,proto3" json:"Splits,omitempty"`
+	// Refunds and reimbursements of the expense
+	Refunds []*GrpcExpenseRefund `protobuf:"bytes,14,rep,name=Refunds,proto3" json:"Refunds,omitempty"`
+	// Counterparty and expected amount, in the base currency, of an awaited reimbursement
+	ReimburseFrom   string     `protobuf:"bytes,15,opt,name=ReimburseFrom,proto3" json:"ReimburseFrom,omitempty"`
+	ReimburseAmount *GrpcMoney `protobuf:"bytes,16,opt,name=ReimburseAmount,proto3" json:"ReimburseAmount,omitempty"`
+	Outstanding     *GrpcMoney `protobuf:"bytes,17,opt,name=Outstanding,proto3" json:"Outstanding,omitempty"`
+	// Optional payee
+	PayeeId int64  `protobuf:"varint,18,opt,name=PayeeId,proto3" json:"PayeeId,omitempty"`
+	Payee   string `protobuf:"bytes,19,opt,name=Payee,proto3" json:"Payee,omitempty"`
+	// Free text notes and receipts
+	Notes       string            `protobuf:"bytes,20,opt,name=Notes,proto3" json:"Notes,omitempty"`
+	Attachments []*GrpcAttachment `protobuf:"bytes,21,rep,name=Attachments,proto3" json:"Attachments,omitempty"`
 }
 
-func (x *GrpcCategory) Reset() {
-	*x = GrpcCategory{}
+func (x *GrpcExpense) Reset() {
+	*x = GrpcExpense{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[10]
+		mi := &file_models_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrpcCategory) String() string {
+func (x *GrpcExpense) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrpcCategory) ProtoMessage() {}
+func (*GrpcExpense) ProtoMessage() {}
 
-func (x *GrpcCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[10]
+func (x *GrpcExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,126 +816,191 @@ func (x *GrpcCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrpcCategory.ProtoReflect.Descriptor instead.
-func (*GrpcCategory) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GrpcExpense.ProtoReflect.Descriptor instead.
+func (*GrpcExpense) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GrpcCategory) GetID() int64 {
+func (x *GrpcExpense) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GrpcCategory) GetName() string {
+func (x *GrpcExpense) GetAmount() *GrpcMoney {
 	if x != nil {
-		return x.Name
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
-func (x *GrpcCategory) GetBudgetInput() float64 {
+func (x *GrpcExpense) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BudgetInput
+		return x.Date
 	}
-	return 0
+	return nil
 }
 
-func (x *GrpcCategory) GetLastInputDate() *timestamppb.Timestamp {
+func (x *GrpcExpense) GetTags() []*GrpcTag {
 	if x != nil {
-		return x.LastInputDate
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *GrpcCategory) GetSpendingLimit() float64 {
+func (x *GrpcExpense) GetFromAccountId() int64 {
 	if x != nil {
-		return x.SpendingLimit
+		return x.FromAccountId
 	}
 	return 0
 }
 
-func (x *GrpcCategory) GetSpendingLeft() float64 {
+func (x *GrpcExpense) GetFromAccount() *GrpcAccount {
 	if x != nil {
-		return x.SpendingLeft
+		return x.FromAccount
 	}
-	return 0
+	return nil
 }
 
-func (x *GrpcCategory) GetInitialAmount() float64 {
+func (x *GrpcExpense) GetFromCategoryId() int64 {
 	if x != nil {
-		return x.InitialAmount
+		return x.FromCategoryId
 	}
 	return 0
 }
 
-func (x *GrpcCategory) GetCurrentAmount() float64 {
+func (x *GrpcExpense) GetFromCategory() *GrpcCategory {
 	if x != nil {
-		return x.CurrentAmount
+		return x.FromCategory
 	}
-	return 0
+	return nil
 }
 
-func (x *GrpcCategory) GetTableOrder() int64 {
+func (x *GrpcExpense) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TableOrder
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GrpcExpense) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GrpcExpense) GetBaseAmount() *GrpcMoney {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return nil
+}
+
+func (x *GrpcExpense) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
 	}
 	return 0
 }
 
-func (x *GrpcCategory) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GrpcExpense) GetSplits() []*GrpcExpense {
 	if x != nil {
-		return x.CreatedAt
+		return x.Splits
 	}
 	return nil
 }
 
-func (x *GrpcCategory) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GrpcExpense) GetRefunds() []*GrpcExpenseRefund {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Refunds
 	}
 	return nil
 }
 
-type GrpcCategoryOverview struct {
+func (x *GrpcExpense) GetReimburseFrom() string {
+	if x != nil {
+		return x.ReimburseFrom
+	}
+	return ""
+}
+
+func (x *GrpcExpense) GetReimburseAmount() *GrpcMoney {
+	if x != nil {
+		return x.ReimburseAmount
+	}
+	return nil
+}
+
+func (x *GrpcExpense) GetOutstanding() *GrpcMoney {
+	if x != nil {
+		return x.Outstanding
+	}
+	return nil
+}
+
+func (x *GrpcExpense) GetPayeeId() int64 {
+	if x != nil {
+		return x.PayeeId
+	}
+	return 0
+}
+
+func (x *GrpcExpense) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+func (x *GrpcExpense) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *GrpcExpense) GetAttachments() []*GrpcAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Attachment details. The content is in the attachment store
+type GrpcAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                 int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	BudgetInput        float64                `protobuf:"fixed64,3,opt,name=BudgetInput,proto3" json:"BudgetInput,omitempty"`
-	InputInterval      int64                  `protobuf:"varint,4,opt,name=InputInterval,proto3" json:"InputInterval,omitempty"`
-	InputPeriodId      int64                  `protobuf:"varint,5,opt,name=InputPeriodId,proto3" json:"InputPeriodId,omitempty"`
-	InputPeriodCaption string                 `protobuf:"bytes,6,opt,name=InputPeriodCaption,proto3" json:"InputPeriodCaption,omitempty"`
-	SpendingLimit      float64                `protobuf:"fixed64,7,opt,name=SpendingLimit,proto3" json:"SpendingLimit,omitempty"`
-	SpendingLeft       float64                `protobuf:"fixed64,8,opt,name=SpendingLeft,proto3" json:"SpendingLeft,omitempty"`
-	PeriodStart        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=PeriodStart,proto3" json:"PeriodStart,omitempty"`
-	PeriodEnd          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=PeriodEnd,proto3" json:"PeriodEnd,omitempty"`
-	InitialAmount      float64                `protobuf:"fixed64,11,opt,name=InitialAmount,proto3" json:"InitialAmount,omitempty"`
-	CurrentAmount      float64                `protobuf:"fixed64,12,opt,name=CurrentAmount,proto3" json:"CurrentAmount,omitempty"`
-	CanBeDeleted       bool                   `protobuf:"varint,13,opt,name=CanBeDeleted,proto3" json:"CanBeDeleted,omitempty"`
-	TableOrder         int64                  `protobuf:"varint,14,opt,name=TableOrder,proto3" json:"TableOrder,omitempty"`
+	ID          int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ExpenseId   int64  `protobuf:"varint,2,opt,name=ExpenseId,proto3" json:"ExpenseId,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	Size        int64  `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
+	// SHA-256 of the content
+	Hash      string                 `protobuf:"bytes,6,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
 }
 
-func (x *GrpcCategoryOverview) Reset() {
-	*x = GrpcCategoryOverview{}
+func (x *GrpcAttachment) Reset() {
+	*x = GrpcAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[11]
+		mi := &file_models_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrpcCategoryOverview) String() string {
+func (x *GrpcAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrpcCategoryOverview) ProtoMessage() {}
+func (*GrpcAttachment) ProtoMessage() {}
 
-func (x *GrpcCategoryOverview) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[11]
+func (x *GrpcAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,139 +1011,209 @@ func (x *GrpcCategoryOverview) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrpcCategoryOverview.ProtoReflect.Descriptor instead.
-func (*GrpcCategoryOverview) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GrpcAttachment.ProtoReflect.Descriptor instead.
+func (*GrpcAttachment) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GrpcCategoryOverview) GetID() int64 {
+func (x *GrpcAttachment) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GrpcCategoryOverview) GetName() string {
+func (x *GrpcAttachment) GetExpenseId() int64 {
 	if x != nil {
-		return x.Name
+		return x.ExpenseId
 	}
-	return ""
+	return 0
 }
 
-func (x *GrpcCategoryOverview) GetBudgetInput() float64 {
+func (x *GrpcAttachment) GetName() string {
 	if x != nil {
-		return x.BudgetInput
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GrpcCategoryOverview) GetInputInterval() int64 {
+func (x *GrpcAttachment) GetContentType() string {
 	if x != nil {
-		return x.InputInterval
+		return x.ContentType
 	}
-	return 0
+	return ""
 }
 
-func (x *GrpcCategoryOverview) GetInputPeriodId() int64 {
+func (x *GrpcAttachment) GetSize() int64 {
 	if x != nil {
-		return x.InputPeriodId
+		return x.Size
 	}
 	return 0
 }
 
-func (x *GrpcCategoryOverview) GetInputPeriodCaption() string {
+func (x *GrpcAttachment) GetHash() string {
 	if x != nil {
-		return x.InputPeriodCaption
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *GrpcCategoryOverview) GetSpendingLimit() float64 {
+func (x *GrpcAttachment) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SpendingLimit
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Payees
+type GrpcPayee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID   int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Zero when there is no default
+	DefaultAccountId  int64    `protobuf:"varint,3,opt,name=DefaultAccountId,proto3" json:"DefaultAccountId,omitempty"`
+	DefaultCategoryId int64    `protobuf:"varint,4,opt,name=DefaultCategoryId,proto3" json:"DefaultCategoryId,omitempty"`
+	DefaultTags       []string `protobuf:"bytes,5,rep,name=DefaultTags,proto3" json:"DefaultTags,omitempty"`
+	UsageCount        int64    `protobuf:"varint,6,opt,name=UsageCount,proto3" json:"UsageCount,omitempty"`
+	// Values to prefill expenses with. Defaults or the values of the most recent expense
+	AccountId  int64    `protobuf:"varint,7,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	CategoryId int64    `protobuf:"varint,8,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	Tags       []string `protobuf:"bytes,9,rep,name=Tags,proto3" json:"Tags,omitempty"`
+}
+
+func (x *GrpcPayee) Reset() {
+	*x = GrpcPayee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrpcPayee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcPayee) ProtoMessage() {}
+
+func (x *GrpcPayee) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcPayee.ProtoReflect.Descriptor instead.
+func (*GrpcPayee) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GrpcPayee) GetID() int64 {
+	if x != nil {
+		return x.ID
 	}
 	return 0
 }
 
-func (x *GrpcCategoryOverview) GetSpendingLeft() float64 {
+func (x *GrpcPayee) GetName() string {
 	if x != nil {
-		return x.SpendingLeft
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GrpcPayee) GetDefaultAccountId() int64 {
+	if x != nil {
+		return x.DefaultAccountId
 	}
 	return 0
 }
 
-func (x *GrpcCategoryOverview) GetPeriodStart() *timestamppb.Timestamp {
+func (x *GrpcPayee) GetDefaultCategoryId() int64 {
 	if x != nil {
-		return x.PeriodStart
+		return x.DefaultCategoryId
 	}
-	return nil
+	return 0
 }
 
-func (x *GrpcCategoryOverview) GetPeriodEnd() *timestamppb.Timestamp {
+func (x *GrpcPayee) GetDefaultTags() []string {
 	if x != nil {
-		return x.PeriodEnd
+		return x.DefaultTags
 	}
 	return nil
 }
 
-func (x *GrpcCategoryOverview) GetInitialAmount() float64 {
+func (x *GrpcPayee) GetUsageCount() int64 {
 	if x != nil {
-		return x.InitialAmount
+		return x.UsageCount
 	}
 	return 0
 }
 
-func (x *GrpcCategoryOverview) GetCurrentAmount() float64 {
+func (x *GrpcPayee) GetAccountId() int64 {
 	if x != nil {
-		return x.CurrentAmount
+		return x.AccountId
 	}
 	return 0
 }
 
-func (x *GrpcCategoryOverview) GetCanBeDeleted() bool {
+func (x *GrpcPayee) GetCategoryId() int64 {
 	if x != nil {
-		return x.CanBeDeleted
+		return x.CategoryId
 	}
-	return false
+	return 0
 }
 
-func (x *GrpcCategoryOverview) GetTableOrder() int64 {
+func (x *GrpcPayee) GetTags() []string {
 	if x != nil {
-		return x.TableOrder
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-type GrpcResetCategoryData struct {
+type GrpcExpenseRefund struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount        float64 `protobuf:"fixed64,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
-	CategoryId    int64   `protobuf:"varint,2,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
-	BudgetInput   float64 `protobuf:"fixed64,3,opt,name=BudgetInput,proto3" json:"BudgetInput,omitempty"`
-	InputInterval int64   `protobuf:"varint,4,opt,name=InputInterval,proto3" json:"InputInterval,omitempty"`
-	InputPeriod   int64   `protobuf:"varint,5,opt,name=InputPeriod,proto3" json:"InputPeriod,omitempty"`
-	SpendingLimit float64 `protobuf:"fixed64,6,opt,name=SpendingLimit,proto3" json:"SpendingLimit,omitempty"`
-}
-
-func (x *GrpcResetCategoryData) Reset() {
-	*x = GrpcResetCategoryData{}
+	ID        int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ExpenseId int64 `protobuf:"varint,2,opt,name=ExpenseId,proto3" json:"ExpenseId,omitempty"`
+	// refund or reimbursement
+	Kind         string `protobuf:"bytes,3,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Counterparty string `protobuf:"bytes,4,opt,name=Counterparty,proto3" json:"Counterparty,omitempty"`
+	// Amount in the account currency
+	Amount      *GrpcMoney             `protobuf:"bytes,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	BaseAmount  *GrpcMoney             `protobuf:"bytes,6,opt,name=BaseAmount,proto3" json:"BaseAmount,omitempty"`
+	ToAccountId int64                  `protobuf:"varint,7,opt,name=ToAccountId,proto3" json:"ToAccountId,omitempty"`
+	ToAccount   *GrpcAccount           `protobuf:"bytes,8,opt,name=ToAccount,proto3" json:"ToAccount,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=Date,proto3" json:"Date,omitempty"`
+}
+
+func (x *GrpcExpenseRefund) Reset() {
+	*x = GrpcExpenseRefund{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[12]
+		mi := &file_models_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrpcResetCategoryData) String() string {
+func (x *GrpcExpenseRefund) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrpcResetCategoryData) ProtoMessage() {}
+func (*GrpcExpenseRefund) ProtoMessage() {}
 
-func (x *GrpcResetCategoryData) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[12]
+func (x *GrpcExpenseRefund) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,83 +1224,102 @@ func (x *GrpcResetCategoryData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrpcResetCategoryData.ProtoReflect.Descriptor instead.
-func (*GrpcResetCategoryData) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GrpcExpenseRefund.ProtoReflect.Descriptor instead.
+func (*GrpcExpenseRefund) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GrpcResetCategoryData) GetAmount() float64 {
+func (x *GrpcExpenseRefund) GetID() int64 {
 	if x != nil {
-		return x.Amount
+		return x.ID
 	}
 	return 0
 }
 
-func (x *GrpcResetCategoryData) GetCategoryId() int64 {
+func (x *GrpcExpenseRefund) GetExpenseId() int64 {
 	if x != nil {
-		return x.CategoryId
+		return x.ExpenseId
 	}
 	return 0
 }
 
-func (x *GrpcResetCategoryData) GetBudgetInput() float64 {
+func (x *GrpcExpenseRefund) GetKind() string {
 	if x != nil {
-		return x.BudgetInput
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *GrpcResetCategoryData) GetInputInterval() int64 {
+func (x *GrpcExpenseRefund) GetCounterparty() string {
 	if x != nil {
-		return x.InputInterval
+		return x.Counterparty
 	}
-	return 0
+	return ""
 }
 
-func (x *GrpcResetCategoryData) GetInputPeriod() int64 {
+func (x *GrpcExpenseRefund) GetAmount() *GrpcMoney {
 	if x != nil {
-		return x.InputPeriod
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *GrpcResetCategoryData) GetSpendingLimit() float64 {
+func (x *GrpcExpenseRefund) GetBaseAmount() *GrpcMoney {
 	if x != nil {
-		return x.SpendingLimit
+		return x.BaseAmount
+	}
+	return nil
+}
+
+func (x *GrpcExpenseRefund) GetToAccountId() int64 {
+	if x != nil {
+		return x.ToAccountId
 	}
 	return 0
 }
 
-// Time periods
-type GrpcTimePeriod struct {
+func (x *GrpcExpenseRefund) GetToAccount() *GrpcAccount {
+	if x != nil {
+		return x.ToAccount
+	}
+	return nil
+}
+
+func (x *GrpcExpenseRefund) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+// Outstanding reimbursements of a counterparty
+type GrpcOutstandingReimbursement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Period    string                 `protobuf:"bytes,2,opt,name=Period,proto3" json:"Period,omitempty"`
-	Caption   string                 `protobuf:"bytes,3,opt,name=Caption,proto3" json:"Caption,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+	Counterparty string     `protobuf:"bytes,1,opt,name=Counterparty,proto3" json:"Counterparty,omitempty"`
+	Amount       *GrpcMoney `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Expenses     int64      `protobuf:"varint,3,opt,name=Expenses,proto3" json:"Expenses,omitempty"`
 }
 
-func (x *GrpcTimePeriod) Reset() {
-	*x = GrpcTimePeriod{}
+func (x *GrpcOutstandingReimbursement) Reset() {
+	*x = GrpcOutstandingReimbursement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[13]
+		mi := &file_models_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrpcTimePeriod) String() string {
+func (x *GrpcOutstandingReimbursement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrpcTimePeriod) ProtoMessage() {}
+func (*GrpcOutstandingReimbursement) ProtoMessage() {}
 
-func (x *GrpcTimePeriod) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[13]
+func (x *GrpcOutstandingReimbursement) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,74 +1330,69 @@ func (x *GrpcTimePeriod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrpcTimePeriod.ProtoReflect.Descriptor instead.
-func (*GrpcTimePeriod) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *GrpcTimePeriod) GetID() int64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *GrpcTimePeriod) GetPeriod() string {
-	if x != nil {
-		return x.Period
-	}
-	return ""
+// Deprecated: Use GrpcOutstandingReimbursement.ProtoReflect.Descriptor instead.
+func (*GrpcOutstandingReimbursement) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GrpcTimePeriod) GetCaption() string {
+func (x *GrpcOutstandingReimbursement) GetCounterparty() string {
 	if x != nil {
-		return x.Caption
+		return x.Counterparty
 	}
 	return ""
 }
 
-func (x *GrpcTimePeriod) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GrpcOutstandingReimbursement) GetAmount() *GrpcMoney {
 	if x != nil {
-		return x.CreatedAt
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *GrpcTimePeriod) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GrpcOutstandingReimbursement) GetExpenses() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Expenses
 	}
-	return nil
+	return 0
 }
 
-// Functions params
-type ModifyFreeFundsParams struct {
+// Incomes
+type GrpcIncome struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount      float64 `protobuf:"fixed64,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
-	ToAccountId int64   `protobuf:"varint,2,opt,name=ToAccountId,proto3" json:"ToAccountId,omitempty"`
-	TagName     string  `protobuf:"bytes,3,opt,name=TagName,proto3" json:"TagName,omitempty"`
-}
-
-func (x *ModifyFreeFundsParams) Reset() {
-	*x = ModifyFreeFundsParams{}
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Amount in the account currency
+	Amount *GrpcMoney `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// Amount added to free funds, in the base currency
+	BaseAmount  *GrpcMoney             `protobuf:"bytes,3,opt,name=BaseAmount,proto3" json:"BaseAmount,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=Date,proto3" json:"Date,omitempty"`
+	Source      string                 `protobuf:"bytes,5,opt,name=Source,proto3" json:"Source,omitempty"`
+	Tags        []*GrpcTag             `protobuf:"bytes,6,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	ToAccountId int64                  `protobuf:"varint,7,opt,name=ToAccountId,proto3" json:"ToAccountId,omitempty"`
+	ToAccount   *GrpcAccount           `protobuf:"bytes,8,opt,name=ToAccount,proto3" json:"ToAccount,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+}
+
+func (x *GrpcIncome) Reset() {
+	*x = GrpcIncome{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[14]
+		mi := &file_models_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModifyFreeFundsParams) String() string {
+func (x *GrpcIncome) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyFreeFundsParams) ProtoMessage() {}
+func (*GrpcIncome) ProtoMessage() {}
 
-func (x *ModifyFreeFundsParams) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[14]
+func (x *GrpcIncome) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,104 +1403,111 @@ func (x *ModifyFreeFundsParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyFreeFundsParams.ProtoReflect.Descriptor instead.
-func (*ModifyFreeFundsParams) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GrpcIncome.ProtoReflect.Descriptor instead.
+func (*GrpcIncome) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ModifyFreeFundsParams) GetAmount() float64 {
+func (x *GrpcIncome) GetID() int64 {
 	if x != nil {
-		return x.Amount
+		return x.ID
 	}
 	return 0
 }
 
-func (x *ModifyFreeFundsParams) GetToAccountId() int64 {
+func (x *GrpcIncome) GetAmount() *GrpcMoney {
 	if x != nil {
-		return x.ToAccountId
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *ModifyFreeFundsParams) GetTagName() string {
+func (x *GrpcIncome) GetBaseAmount() *GrpcMoney {
 	if x != nil {
-		return x.TagName
+		return x.BaseAmount
 	}
-	return ""
+	return nil
 }
 
-type GetTagsReturns struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tags []*GrpcTag `protobuf:"bytes,1,rep,name=Tags,proto3" json:"Tags,omitempty"`
+func (x *GrpcIncome) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
 }
 
-func (x *GetTagsReturns) Reset() {
-	*x = GetTagsReturns{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GrpcIncome) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
+	return ""
 }
 
-func (x *GetTagsReturns) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GrpcIncome) GetTags() []*GrpcTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (*GetTagsReturns) ProtoMessage() {}
+func (x *GrpcIncome) GetToAccountId() int64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
 
-func (x *GetTagsReturns) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GrpcIncome) GetToAccount() *GrpcAccount {
+	if x != nil {
+		return x.ToAccount
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetTagsReturns.ProtoReflect.Descriptor instead.
-func (*GetTagsReturns) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{15}
+func (x *GrpcIncome) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetTagsReturns) GetTags() []*GrpcTag {
+func (x *GrpcIncome) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Tags
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type GetExpensesReturns struct {
+// Incomes and expenses of a month in the base currency
+type GrpcMonthlySummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expenses []*GrpcExpense `protobuf:"bytes,1,rep,name=Expenses,proto3" json:"Expenses,omitempty"`
+	// Month as 2006-01
+	Month    string     `protobuf:"bytes,1,opt,name=Month,proto3" json:"Month,omitempty"`
+	Income   *GrpcMoney `protobuf:"bytes,2,opt,name=Income,proto3" json:"Income,omitempty"`
+	Expenses *GrpcMoney `protobuf:"bytes,3,opt,name=Expenses,proto3" json:"Expenses,omitempty"`
+	Net      *GrpcMoney `protobuf:"bytes,4,opt,name=Net,proto3" json:"Net,omitempty"`
 }
 
-func (x *GetExpensesReturns) Reset() {
-	*x = GetExpensesReturns{}
+func (x *GrpcMonthlySummary) Reset() {
+	*x = GrpcMonthlySummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[16]
+		mi := &file_models_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetExpensesReturns) String() string {
+func (x *GrpcMonthlySummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpensesReturns) ProtoMessage() {}
+func (*GrpcMonthlySummary) ProtoMessage() {}
 
-func (x *GetExpensesReturns) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[16]
+func (x *GrpcMonthlySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,44 +1518,81 @@ func (x *GetExpensesReturns) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpensesReturns.ProtoReflect.Descriptor instead.
-func (*GetExpensesReturns) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GrpcMonthlySummary.ProtoReflect.Descriptor instead.
+func (*GrpcMonthlySummary) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetExpensesReturns) GetExpenses() []*GrpcExpense {
+func (x *GrpcMonthlySummary) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *GrpcMonthlySummary) GetIncome() *GrpcMoney {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+func (x *GrpcMonthlySummary) GetExpenses() *GrpcMoney {
 	if x != nil {
 		return x.Expenses
 	}
 	return nil
 }
 
-type ExpensesParams struct {
+func (x *GrpcMonthlySummary) GetNet() *GrpcMoney {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+// Liabilities
+// Amounts are in the base currency
+type GrpcLiability struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expense *GrpcExpense `protobuf:"bytes,1,opt,name=Expense,proto3" json:"Expense,omitempty"`
-	Tags    []string     `protobuf:"bytes,2,rep,name=Tags,proto3" json:"Tags,omitempty"`
-}
-
-func (x *ExpensesParams) Reset() {
-	*x = ExpensesParams{}
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// borrowed or lent
+	Kind         string     `protobuf:"bytes,2,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Counterparty string     `protobuf:"bytes,3,opt,name=Counterparty,proto3" json:"Counterparty,omitempty"`
+	Principal    *GrpcMoney `protobuf:"bytes,4,opt,name=Principal,proto3" json:"Principal,omitempty"`
+	Balance      *GrpcMoney `protobuf:"bytes,5,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	// Yearly interest rate in basis points
+	InterestRate int64 `protobuf:"varint,6,opt,name=InterestRate,proto3" json:"InterestRate,omitempty"`
+	// Payment schedule. PaymentPeriodId is zero when there is no schedule
+	PaymentAmount        *GrpcMoney              `protobuf:"bytes,7,opt,name=PaymentAmount,proto3" json:"PaymentAmount,omitempty"`
+	PaymentInterval      int64                   `protobuf:"varint,8,opt,name=PaymentInterval,proto3" json:"PaymentInterval,omitempty"`
+	PaymentPeriodId      int64                   `protobuf:"varint,9,opt,name=PaymentPeriodId,proto3" json:"PaymentPeriodId,omitempty"`
+	PaymentPeriodCaption string                  `protobuf:"bytes,10,opt,name=PaymentPeriodCaption,proto3" json:"PaymentPeriodCaption,omitempty"`
+	StartDate            *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=StartDate,proto3" json:"StartDate,omitempty"`
+	NextPayment          *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=NextPayment,proto3,oneof" json:"NextPayment,omitempty"`
+	Payments             []*GrpcLiabilityPayment `protobuf:"bytes,13,rep,name=Payments,proto3" json:"Payments,omitempty"`
+}
+
+func (x *GrpcLiability) Reset() {
+	*x = GrpcLiability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[17]
+		mi := &file_models_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExpensesParams) String() string {
+func (x *GrpcLiability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpensesParams) ProtoMessage() {}
+func (*GrpcLiability) ProtoMessage() {}
 
-func (x *ExpensesParams) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[17]
+func (x *GrpcLiability) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1361,82 +1603,118 @@ func (x *ExpensesParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpensesParams.ProtoReflect.Descriptor instead.
-func (*ExpensesParams) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GrpcLiability.ProtoReflect.Descriptor instead.
+func (*GrpcLiability) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ExpensesParams) GetExpense() *GrpcExpense {
+func (x *GrpcLiability) GetID() int64 {
 	if x != nil {
-		return x.Expense
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GrpcLiability) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GrpcLiability) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *GrpcLiability) GetPrincipal() *GrpcMoney {
+	if x != nil {
+		return x.Principal
 	}
 	return nil
 }
 
-func (x *ExpensesParams) GetTags() []string {
+func (x *GrpcLiability) GetBalance() *GrpcMoney {
 	if x != nil {
-		return x.Tags
+		return x.Balance
 	}
 	return nil
 }
 
-type DeleteExpenseParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GrpcLiability) GetInterestRate() int64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
 
-	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+func (x *GrpcLiability) GetPaymentAmount() *GrpcMoney {
+	if x != nil {
+		return x.PaymentAmount
+	}
+	return nil
 }
 
-func (x *DeleteExpenseParams) Reset() {
-	*x = DeleteExpenseParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GrpcLiability) GetPaymentInterval() int64 {
+	if x != nil {
+		return x.PaymentInterval
 	}
+	return 0
 }
 
-func (x *DeleteExpenseParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GrpcLiability) GetPaymentPeriodId() int64 {
+	if x != nil {
+		return x.PaymentPeriodId
+	}
+	return 0
 }
 
-func (*DeleteExpenseParams) ProtoMessage() {}
+func (x *GrpcLiability) GetPaymentPeriodCaption() string {
+	if x != nil {
+		return x.PaymentPeriodCaption
+	}
+	return ""
+}
 
-func (x *DeleteExpenseParams) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GrpcLiability) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteExpenseParams.ProtoReflect.Descriptor instead.
-func (*DeleteExpenseParams) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{18}
+func (x *GrpcLiability) GetNextPayment() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextPayment
+	}
+	return nil
 }
 
-func (x *DeleteExpenseParams) GetID() int64 {
+func (x *GrpcLiability) GetPayments() []*GrpcLiabilityPayment {
 	if x != nil {
-		return x.ID
+		return x.Payments
 	}
-	return 0
+	return nil
 }
 
-type GetAccountsParams struct {
+type GrpcLiabilityPayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderByPopularity bool `protobuf:"varint,1,opt,name=OrderByPopularity,proto3" json:"OrderByPopularity,omitempty"`
+	ID       int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Amount   *GrpcMoney             `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Interest *GrpcMoney             `protobuf:"bytes,3,opt,name=Interest,proto3" json:"Interest,omitempty"`
+	Date     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=Date,proto3" json:"Date,omitempty"`
+	// Expense of a borrowed liability or income of a lent one
+	ExpenseId int64 `protobuf:"varint,5,opt,name=ExpenseId,proto3" json:"ExpenseId,omitempty"`
+	IncomeId  int64 `protobuf:"varint,6,opt,name=IncomeId,proto3" json:"IncomeId,omitempty"`
 }
 
-func (x *GetAccountsParams) Reset() {
-	*x = GetAccountsParams{}
+func (x *GrpcLiabilityPayment) Reset() {
+	*x = GrpcLiabilityPayment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1444,13 +1722,13 @@ func (x *GetAccountsParams) Reset() {
 	}
 }
 
-func (x *GetAccountsParams) String() string {
+func (x *GrpcLiabilityPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountsParams) ProtoMessage() {}
+func (*GrpcLiabilityPayment) ProtoMessage() {}
 
-func (x *GetAccountsParams) ProtoReflect() protoreflect.Message {
+func (x *GrpcLiabilityPayment) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1462,28 +1740,73 @@ func (x *GetAccountsParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountsParams.ProtoReflect.Descriptor instead.
-func (*GetAccountsParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcLiabilityPayment.ProtoReflect.Descriptor instead.
+func (*GrpcLiabilityPayment) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetAccountsParams) GetOrderByPopularity() bool {
+func (x *GrpcLiabilityPayment) GetID() int64 {
 	if x != nil {
-		return x.OrderByPopularity
+		return x.ID
 	}
-	return false
+	return 0
 }
 
-type GetAccountsReturns struct {
+func (x *GrpcLiabilityPayment) GetAmount() *GrpcMoney {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *GrpcLiabilityPayment) GetInterest() *GrpcMoney {
+	if x != nil {
+		return x.Interest
+	}
+	return nil
+}
+
+func (x *GrpcLiabilityPayment) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GrpcLiabilityPayment) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *GrpcLiabilityPayment) GetIncomeId() int64 {
+	if x != nil {
+		return x.IncomeId
+	}
+	return 0
+}
+
+// Audit log entry. Before and after are JSON, empty when the record didn't exist
+type GrpcAuditEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Accounts []*GrpcAccount `protobuf:"bytes,1,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+	ID       int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Action   string `protobuf:"bytes,2,opt,name=Action,proto3" json:"Action,omitempty"`
+	Entity   string `protobuf:"bytes,3,opt,name=Entity,proto3" json:"Entity,omitempty"`
+	EntityId int64  `protobuf:"varint,4,opt,name=EntityId,proto3" json:"EntityId,omitempty"`
+	Before   string `protobuf:"bytes,5,opt,name=Before,proto3" json:"Before,omitempty"`
+	After    string `protobuf:"bytes,6,opt,name=After,proto3" json:"After,omitempty"`
+	// Entry reverted by this undo
+	UndoOf    int64                  `protobuf:"varint,7,opt,name=UndoOf,proto3" json:"UndoOf,omitempty"`
+	Undone    bool                   `protobuf:"varint,8,opt,name=Undone,proto3" json:"Undone,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
 }
 
-func (x *GetAccountsReturns) Reset() {
-	*x = GetAccountsReturns{}
+func (x *GrpcAuditEntry) Reset() {
+	*x = GrpcAuditEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1491,13 +1814,13 @@ func (x *GetAccountsReturns) Reset() {
 	}
 }
 
-func (x *GetAccountsReturns) String() string {
+func (x *GrpcAuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountsReturns) ProtoMessage() {}
+func (*GrpcAuditEntry) ProtoMessage() {}
 
-func (x *GetAccountsReturns) ProtoReflect() protoreflect.Message {
+func (x *GrpcAuditEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1509,28 +1832,91 @@ func (x *GetAccountsReturns) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountsReturns.ProtoReflect.Descriptor instead.
-func (*GetAccountsReturns) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcAuditEntry.ProtoReflect.Descriptor instead.
+func (*GrpcAuditEntry) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetAccountsReturns) GetAccounts() []*GrpcAccount {
+func (x *GrpcAuditEntry) GetID() int64 {
 	if x != nil {
-		return x.Accounts
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GrpcAuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *GrpcAuditEntry) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *GrpcAuditEntry) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *GrpcAuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *GrpcAuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *GrpcAuditEntry) GetUndoOf() int64 {
+	if x != nil {
+		return x.UndoOf
+	}
+	return 0
+}
+
+func (x *GrpcAuditEntry) GetUndone() bool {
+	if x != nil {
+		return x.Undone
+	}
+	return false
+}
+
+func (x *GrpcAuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type AddAccountParams struct {
+// Cached value that doesn't match the value recomputed from its records. Currency is empty for counts
+type GrpcDrift struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Entity   string `protobuf:"bytes,1,opt,name=Entity,proto3" json:"Entity,omitempty"`
+	EntityId int64  `protobuf:"varint,2,opt,name=EntityId,proto3" json:"EntityId,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Field    string `protobuf:"bytes,4,opt,name=Field,proto3" json:"Field,omitempty"`
+	Stored   int64  `protobuf:"varint,5,opt,name=Stored,proto3" json:"Stored,omitempty"`
+	Expected int64  `protobuf:"varint,6,opt,name=Expected,proto3" json:"Expected,omitempty"`
+	Currency string `protobuf:"bytes,7,opt,name=Currency,proto3" json:"Currency,omitempty"`
 }
 
-func (x *AddAccountParams) Reset() {
-	*x = AddAccountParams{}
+func (x *GrpcDrift) Reset() {
+	*x = GrpcDrift{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1538,13 +1924,13 @@ func (x *AddAccountParams) Reset() {
 	}
 }
 
-func (x *AddAccountParams) String() string {
+func (x *GrpcDrift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAccountParams) ProtoMessage() {}
+func (*GrpcDrift) ProtoMessage() {}
 
-func (x *AddAccountParams) ProtoReflect() protoreflect.Message {
+func (x *GrpcDrift) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1556,29 +1942,86 @@ func (x *AddAccountParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAccountParams.ProtoReflect.Descriptor instead.
-func (*AddAccountParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcDrift.ProtoReflect.Descriptor instead.
+func (*GrpcDrift) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AddAccountParams) GetName() string {
+func (x *GrpcDrift) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *GrpcDrift) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *GrpcDrift) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type EditAccountNameParams struct {
+func (x *GrpcDrift) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *GrpcDrift) GetStored() int64 {
+	if x != nil {
+		return x.Stored
+	}
+	return 0
+}
+
+func (x *GrpcDrift) GetExpected() int64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *GrpcDrift) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Rules tag and categorise new expenses
+type GrpcRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID   int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-}
-
-func (x *EditAccountNameParams) Reset() {
-	*x = EditAccountNameParams{}
+	ID      int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	// Conditions. Empty conditions match any expense
+	// Amount range in the base currency
+	MinAmount *GrpcMoney `protobuf:"bytes,4,opt,name=MinAmount,proto3" json:"MinAmount,omitempty"`
+	MaxAmount *GrpcMoney `protobuf:"bytes,5,opt,name=MaxAmount,proto3" json:"MaxAmount,omitempty"`
+	// Text found in the payee
+	Text      string `protobuf:"bytes,6,opt,name=Text,proto3" json:"Text,omitempty"`
+	AccountId int64  `protobuf:"varint,7,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	// Bit mask of weekdays, with Sunday as the first bit
+	Weekdays int64 `protobuf:"varint,8,opt,name=Weekdays,proto3" json:"Weekdays,omitempty"`
+	// Actions
+	AddTags       []string `protobuf:"bytes,9,rep,name=AddTags,proto3" json:"AddTags,omitempty"`
+	SetCategoryId int64    `protobuf:"varint,10,opt,name=SetCategoryId,proto3" json:"SetCategoryId,omitempty"`
+	SetAccountId  int64    `protobuf:"varint,11,opt,name=SetAccountId,proto3" json:"SetAccountId,omitempty"`
+}
+
+func (x *GrpcRule) Reset() {
+	*x = GrpcRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1586,13 +2029,13 @@ func (x *EditAccountNameParams) Reset() {
 	}
 }
 
-func (x *EditAccountNameParams) String() string {
+func (x *GrpcRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditAccountNameParams) ProtoMessage() {}
+func (*GrpcRule) ProtoMessage() {}
 
-func (x *EditAccountNameParams) ProtoReflect() protoreflect.Message {
+func (x *GrpcRule) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1604,35 +2047,103 @@ func (x *EditAccountNameParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditAccountNameParams.ProtoReflect.Descriptor instead.
-func (*EditAccountNameParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcRule.ProtoReflect.Descriptor instead.
+func (*GrpcRule) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *EditAccountNameParams) GetID() int64 {
+func (x *GrpcRule) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *EditAccountNameParams) GetName() string {
+func (x *GrpcRule) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type DeleteAccountParams struct {
+func (x *GrpcRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GrpcRule) GetMinAmount() *GrpcMoney {
+	if x != nil {
+		return x.MinAmount
+	}
+	return nil
+}
+
+func (x *GrpcRule) GetMaxAmount() *GrpcMoney {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return nil
+}
+
+func (x *GrpcRule) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *GrpcRule) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GrpcRule) GetWeekdays() int64 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return 0
+}
+
+func (x *GrpcRule) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *GrpcRule) GetSetCategoryId() int64 {
+	if x != nil {
+		return x.SetCategoryId
+	}
+	return 0
+}
+
+func (x *GrpcRule) GetSetAccountId() int64 {
+	if x != nil {
+		return x.SetAccountId
+	}
+	return 0
+}
+
+// Tags
+type GrpcTag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ID         int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	UsageCount int64                  `protobuf:"varint,3,opt,name=UsageCount,proto3" json:"UsageCount,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
 }
 
-func (x *DeleteAccountParams) Reset() {
-	*x = DeleteAccountParams{}
+func (x *GrpcTag) Reset() {
+	*x = GrpcTag{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1640,13 +2151,13 @@ func (x *DeleteAccountParams) Reset() {
 	}
 }
 
-func (x *DeleteAccountParams) String() string {
+func (x *GrpcTag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountParams) ProtoMessage() {}
+func (*GrpcTag) ProtoMessage() {}
 
-func (x *DeleteAccountParams) ProtoReflect() protoreflect.Message {
+func (x *GrpcTag) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1658,30 +2169,61 @@ func (x *DeleteAccountParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountParams.ProtoReflect.Descriptor instead.
-func (*DeleteAccountParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcTag.ProtoReflect.Descriptor instead.
+func (*GrpcTag) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteAccountParams) GetID() int64 {
+func (x *GrpcTag) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-type TransferFundsParams struct {
+func (x *GrpcTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GrpcTag) GetUsageCount() int64 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *GrpcTag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GrpcTag) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Expense-Tag relation
+type GrpcExpenseToTagRealtion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromAccount *GrpcAccount `protobuf:"bytes,1,opt,name=FromAccount,proto3" json:"FromAccount,omitempty"`
-	ToAccount   *GrpcAccount `protobuf:"bytes,2,opt,name=ToAccount,proto3" json:"ToAccount,omitempty"`
-	Amount      float64      `protobuf:"fixed64,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ExpenseId int64                  `protobuf:"varint,2,opt,name=ExpenseId,proto3" json:"ExpenseId,omitempty"`
+	TagId     int64                  `protobuf:"varint,3,opt,name=TagId,proto3" json:"TagId,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
 }
 
-func (x *TransferFundsParams) Reset() {
-	*x = TransferFundsParams{}
+func (x *GrpcExpenseToTagRealtion) Reset() {
+	*x = GrpcExpenseToTagRealtion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1689,13 +2231,13 @@ func (x *TransferFundsParams) Reset() {
 	}
 }
 
-func (x *TransferFundsParams) String() string {
+func (x *GrpcExpenseToTagRealtion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferFundsParams) ProtoMessage() {}
+func (*GrpcExpenseToTagRealtion) ProtoMessage() {}
 
-func (x *TransferFundsParams) ProtoReflect() protoreflect.Message {
+func (x *GrpcExpenseToTagRealtion) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1707,43 +2249,65 @@ func (x *TransferFundsParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferFundsParams.ProtoReflect.Descriptor instead.
-func (*TransferFundsParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcExpenseToTagRealtion.ProtoReflect.Descriptor instead.
+func (*GrpcExpenseToTagRealtion) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *TransferFundsParams) GetFromAccount() *GrpcAccount {
+func (x *GrpcExpenseToTagRealtion) GetID() int64 {
 	if x != nil {
-		return x.FromAccount
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-func (x *TransferFundsParams) GetToAccount() *GrpcAccount {
+func (x *GrpcExpenseToTagRealtion) GetExpenseId() int64 {
 	if x != nil {
-		return x.ToAccount
+		return x.ExpenseId
 	}
-	return nil
+	return 0
 }
 
-func (x *TransferFundsParams) GetAmount() float64 {
+func (x *GrpcExpenseToTagRealtion) GetTagId() int64 {
 	if x != nil {
-		return x.Amount
+		return x.TagId
 	}
 	return 0
 }
 
-type ReorderAccountParams struct {
+func (x *GrpcExpenseToTagRealtion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GrpcExpenseToTagRealtion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Accounts
+type GrpcAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account   *GrpcAccount `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
-	Direction int64        `protobuf:"varint,2,opt,name=Direction,proto3" json:"Direction,omitempty"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	CurrentAmount *GrpcMoney             `protobuf:"bytes,3,opt,name=CurrentAmount,proto3" json:"CurrentAmount,omitempty"`
+	UsageCount    int64                  `protobuf:"varint,4,opt,name=UsageCount,proto3" json:"UsageCount,omitempty"`
+	TableOrder    int64                  `protobuf:"varint,5,opt,name=TableOrder,proto3" json:"TableOrder,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
+	// Current amount in the base currency, unset when there is no exchange rate
+	BaseAmount *GrpcMoney `protobuf:"bytes,8,opt,name=BaseAmount,proto3" json:"BaseAmount,omitempty"`
 }
 
-func (x *ReorderAccountParams) Reset() {
-	*x = ReorderAccountParams{}
+func (x *GrpcAccount) Reset() {
+	*x = GrpcAccount{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1751,13 +2315,13 @@ func (x *ReorderAccountParams) Reset() {
 	}
 }
 
-func (x *ReorderAccountParams) String() string {
+func (x *GrpcAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReorderAccountParams) ProtoMessage() {}
+func (*GrpcAccount) ProtoMessage() {}
 
-func (x *ReorderAccountParams) ProtoReflect() protoreflect.Message {
+func (x *GrpcAccount) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1769,39 +2333,85 @@ func (x *ReorderAccountParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReorderAccountParams.ProtoReflect.Descriptor instead.
-func (*ReorderAccountParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcAccount.ProtoReflect.Descriptor instead.
+func (*GrpcAccount) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ReorderAccountParams) GetAccount() *GrpcAccount {
+func (x *GrpcAccount) GetID() int64 {
 	if x != nil {
-		return x.Account
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GrpcAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GrpcAccount) GetCurrentAmount() *GrpcMoney {
+	if x != nil {
+		return x.CurrentAmount
 	}
 	return nil
 }
 
-func (x *ReorderAccountParams) GetDirection() int64 {
+func (x *GrpcAccount) GetUsageCount() int64 {
 	if x != nil {
-		return x.Direction
+		return x.UsageCount
 	}
 	return 0
 }
 
-type AddCategoryParams struct {
+func (x *GrpcAccount) GetTableOrder() int64 {
+	if x != nil {
+		return x.TableOrder
+	}
+	return 0
+}
+
+func (x *GrpcAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GrpcAccount) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GrpcAccount) GetBaseAmount() *GrpcMoney {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return nil
+}
+
+// Exchange rates
+type GrpcExchangeRate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name          string  `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	BudgetInput   float64 `protobuf:"fixed64,2,opt,name=BudgetInput,proto3" json:"BudgetInput,omitempty"`
-	SpendingLimit float64 `protobuf:"fixed64,3,opt,name=SpendingLimit,proto3" json:"SpendingLimit,omitempty"`
-	InputInterval int64   `protobuf:"varint,4,opt,name=InputInterval,proto3" json:"InputInterval,omitempty"`
-	InputPeriod   int64   `protobuf:"varint,5,opt,name=InputPeriod,proto3" json:"InputPeriod,omitempty"`
+	ID           int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FromCurrency string `protobuf:"bytes,2,opt,name=FromCurrency,proto3" json:"FromCurrency,omitempty"`
+	ToCurrency   string `protobuf:"bytes,3,opt,name=ToCurrency,proto3" json:"ToCurrency,omitempty"`
+	// Exact decimal, price of one unit of FromCurrency in ToCurrency
+	Rate      string                 `protobuf:"bytes,4,opt,name=Rate,proto3" json:"Rate,omitempty"`
+	Date      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=Date,proto3" json:"Date,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
 }
 
-func (x *AddCategoryParams) Reset() {
-	*x = AddCategoryParams{}
+func (x *GrpcExchangeRate) Reset() {
+	*x = GrpcExchangeRate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1809,13 +2419,13 @@ func (x *AddCategoryParams) Reset() {
 	}
 }
 
-func (x *AddCategoryParams) String() string {
+func (x *GrpcExchangeRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCategoryParams) ProtoMessage() {}
+func (*GrpcExchangeRate) ProtoMessage() {}
 
-func (x *AddCategoryParams) ProtoReflect() protoreflect.Message {
+func (x *GrpcExchangeRate) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1827,57 +2437,81 @@ func (x *AddCategoryParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCategoryParams.ProtoReflect.Descriptor instead.
-func (*AddCategoryParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcExchangeRate.ProtoReflect.Descriptor instead.
+func (*GrpcExchangeRate) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AddCategoryParams) GetName() string {
+func (x *GrpcExchangeRate) GetID() int64 {
 	if x != nil {
-		return x.Name
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *AddCategoryParams) GetBudgetInput() float64 {
+func (x *GrpcExchangeRate) GetFromCurrency() string {
 	if x != nil {
-		return x.BudgetInput
+		return x.FromCurrency
 	}
-	return 0
+	return ""
 }
 
-func (x *AddCategoryParams) GetSpendingLimit() float64 {
+func (x *GrpcExchangeRate) GetToCurrency() string {
 	if x != nil {
-		return x.SpendingLimit
+		return x.ToCurrency
 	}
-	return 0
+	return ""
 }
 
-func (x *AddCategoryParams) GetInputInterval() int64 {
+func (x *GrpcExchangeRate) GetRate() string {
 	if x != nil {
-		return x.InputInterval
+		return x.Rate
 	}
-	return 0
+	return ""
 }
 
-func (x *AddCategoryParams) GetInputPeriod() int64 {
+func (x *GrpcExchangeRate) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.InputPeriod
+		return x.Date
 	}
-	return 0
+	return nil
 }
 
-type ReorderCategoryParams struct {
+func (x *GrpcExchangeRate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GrpcExchangeRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Categories
+type GrpcCategory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CategoryId int64 `protobuf:"varint,1,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
-	NewOrder   int64 `protobuf:"varint,2,opt,name=NewOrder,proto3" json:"NewOrder,omitempty"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	BudgetInput   *GrpcMoney             `protobuf:"bytes,3,opt,name=BudgetInput,proto3" json:"BudgetInput,omitempty"`
+	LastInputDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=LastInputDate,proto3" json:"LastInputDate,omitempty"` // time.Duration InputInterval = 5;
+	SpendingLimit *GrpcMoney             `protobuf:"bytes,6,opt,name=SpendingLimit,proto3" json:"SpendingLimit,omitempty"`
+	SpendingLeft  *GrpcMoney             `protobuf:"bytes,7,opt,name=SpendingLeft,proto3" json:"SpendingLeft,omitempty"`
+	InitialAmount *GrpcMoney             `protobuf:"bytes,8,opt,name=InitialAmount,proto3" json:"InitialAmount,omitempty"`
+	CurrentAmount *GrpcMoney             `protobuf:"bytes,9,opt,name=CurrentAmount,proto3" json:"CurrentAmount,omitempty"`
+	TableOrder    int64                  `protobuf:"varint,10,opt,name=TableOrder,proto3" json:"TableOrder,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
 }
 
-func (x *ReorderCategoryParams) Reset() {
-	*x = ReorderCategoryParams{}
+func (x *GrpcCategory) Reset() {
+	*x = GrpcCategory{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1885,13 +2519,13 @@ func (x *ReorderCategoryParams) Reset() {
 	}
 }
 
-func (x *ReorderCategoryParams) String() string {
+func (x *GrpcCategory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReorderCategoryParams) ProtoMessage() {}
+func (*GrpcCategory) ProtoMessage() {}
 
-func (x *ReorderCategoryParams) ProtoReflect() protoreflect.Message {
+func (x *GrpcCategory) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1903,144 +2537,136 @@ func (x *ReorderCategoryParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReorderCategoryParams.ProtoReflect.Descriptor instead.
-func (*ReorderCategoryParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcCategory.ProtoReflect.Descriptor instead.
+func (*GrpcCategory) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ReorderCategoryParams) GetCategoryId() int64 {
+func (x *GrpcCategory) GetID() int64 {
 	if x != nil {
-		return x.CategoryId
+		return x.ID
 	}
 	return 0
 }
 
-func (x *ReorderCategoryParams) GetNewOrder() int64 {
+func (x *GrpcCategory) GetName() string {
 	if x != nil {
-		return x.NewOrder
+		return x.Name
 	}
-	return 0
-}
-
-type DeleteCategoryParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	return ""
 }
 
-func (x *DeleteCategoryParams) Reset() {
-	*x = DeleteCategoryParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GrpcCategory) GetBudgetInput() *GrpcMoney {
+	if x != nil {
+		return x.BudgetInput
 	}
+	return nil
 }
 
-func (x *DeleteCategoryParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteCategoryParams) ProtoMessage() {}
-
-func (x *DeleteCategoryParams) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GrpcCategory) GetLastInputDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastInputDate
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteCategoryParams.ProtoReflect.Descriptor instead.
-func (*DeleteCategoryParams) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{28}
+	return nil
 }
 
-func (x *DeleteCategoryParams) GetID() int64 {
+func (x *GrpcCategory) GetSpendingLimit() *GrpcMoney {
 	if x != nil {
-		return x.ID
+		return x.SpendingLimit
 	}
-	return 0
+	return nil
 }
 
-type ResetCategoriesParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Catgories []*GrpcResetCategoryData `protobuf:"bytes,1,rep,name=catgories,proto3" json:"catgories,omitempty"`
+func (x *GrpcCategory) GetSpendingLeft() *GrpcMoney {
+	if x != nil {
+		return x.SpendingLeft
+	}
+	return nil
 }
 
-func (x *ResetCategoriesParams) Reset() {
-	*x = ResetCategoriesParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GrpcCategory) GetInitialAmount() *GrpcMoney {
+	if x != nil {
+		return x.InitialAmount
 	}
+	return nil
 }
 
-func (x *ResetCategoriesParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GrpcCategory) GetCurrentAmount() *GrpcMoney {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return nil
 }
 
-func (*ResetCategoriesParams) ProtoMessage() {}
-
-func (x *ResetCategoriesParams) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GrpcCategory) GetTableOrder() int64 {
+	if x != nil {
+		return x.TableOrder
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ResetCategoriesParams.ProtoReflect.Descriptor instead.
-func (*ResetCategoriesParams) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{29}
+func (x *GrpcCategory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *ResetCategoriesParams) GetCatgories() []*GrpcResetCategoryData {
+func (x *GrpcCategory) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Catgories
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type GetCategoriesCountReturns struct {
+type GrpcCategoryOverview struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int64 `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+	ID                 int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	BudgetInput        *GrpcMoney             `protobuf:"bytes,3,opt,name=BudgetInput,proto3" json:"BudgetInput,omitempty"`
+	InputInterval      int64                  `protobuf:"varint,4,opt,name=InputInterval,proto3" json:"InputInterval,omitempty"`
+	InputPeriodId      int64                  `protobuf:"varint,5,opt,name=InputPeriodId,proto3" json:"InputPeriodId,omitempty"`
+	InputPeriodCaption string                 `protobuf:"bytes,6,opt,name=InputPeriodCaption,proto3" json:"InputPeriodCaption,omitempty"`
+	SpendingLimit      *GrpcMoney             `protobuf:"bytes,7,opt,name=SpendingLimit,proto3" json:"SpendingLimit,omitempty"`
+	SpendingLeft       *GrpcMoney             `protobuf:"bytes,8,opt,name=SpendingLeft,proto3" json:"SpendingLeft,omitempty"`
+	PeriodStart        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=PeriodStart,proto3" json:"PeriodStart,omitempty"`
+	PeriodEnd          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=PeriodEnd,proto3" json:"PeriodEnd,omitempty"`
+	InitialAmount      *GrpcMoney             `protobuf:"bytes,11,opt,name=InitialAmount,proto3" json:"InitialAmount,omitempty"`
+	CurrentAmount      *GrpcMoney             `protobuf:"bytes,12,opt,name=CurrentAmount,proto3" json:"CurrentAmount,omitempty"`
+	CanBeDeleted       bool                   `protobuf:"varint,13,opt,name=CanBeDeleted,proto3" json:"CanBeDeleted,omitempty"`
+	TableOrder         int64                  `protobuf:"varint,14,opt,name=TableOrder,proto3" json:"TableOrder,omitempty"`
+	// What happens with leftover funds on reset. RolloverCap is N for the cap policy
+	RolloverPolicy string `protobuf:"bytes,15,opt,name=RolloverPolicy,proto3" json:"RolloverPolicy,omitempty"`
+	RolloverCap    int64  `protobuf:"varint,16,opt,name=RolloverCap,proto3" json:"RolloverCap,omitempty"`
+	// Reset automatically when the period ends. Due when free funds were not enough
+	AutoReset bool `protobuf:"varint,17,opt,name=AutoReset,proto3" json:"AutoReset,omitempty"`
+	ResetDue  bool `protobuf:"varint,18,opt,name=ResetDue,proto3" json:"ResetDue,omitempty"`
+	// Goal categories save GoalAmount until GoalDate. GoalContribution is the input needed at every reset
+	GoalAmount       *GrpcMoney             `protobuf:"bytes,19,opt,name=GoalAmount,proto3" json:"GoalAmount,omitempty"`
+	GoalDate         *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=GoalDate,proto3" json:"GoalDate,omitempty"`
+	GoalContribution *GrpcMoney             `protobuf:"bytes,21,opt,name=GoalContribution,proto3" json:"GoalContribution,omitempty"`
 }
 
-func (x *GetCategoriesCountReturns) Reset() {
-	*x = GetCategoriesCountReturns{}
+func (x *GrpcCategoryOverview) Reset() {
+	*x = GrpcCategoryOverview{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[30]
+		mi := &file_models_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCategoriesCountReturns) String() string {
+func (x *GrpcCategoryOverview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoriesCountReturns) ProtoMessage() {}
+func (*GrpcCategoryOverview) ProtoMessage() {}
 
-func (x *GetCategoriesCountReturns) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[30]
+func (x *GrpcCategoryOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,137 +2677,190 @@ func (x *GetCategoriesCountReturns) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoriesCountReturns.ProtoReflect.Descriptor instead.
-func (*GetCategoriesCountReturns) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GrpcCategoryOverview.ProtoReflect.Descriptor instead.
+func (*GrpcCategoryOverview) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetCategoriesCountReturns) GetCount() int64 {
+func (x *GrpcCategoryOverview) GetID() int64 {
 	if x != nil {
-		return x.Count
+		return x.ID
 	}
 	return 0
 }
 
-type GetCategoriesReturns struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Categories []*GrpcCategory `protobuf:"bytes,1,rep,name=Categories,proto3" json:"Categories,omitempty"`
+func (x *GrpcCategoryOverview) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetCategoriesReturns) Reset() {
-	*x = GetCategoriesReturns{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GrpcCategoryOverview) GetBudgetInput() *GrpcMoney {
+	if x != nil {
+		return x.BudgetInput
 	}
+	return nil
 }
 
-func (x *GetCategoriesReturns) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GrpcCategoryOverview) GetInputInterval() int64 {
+	if x != nil {
+		return x.InputInterval
+	}
+	return 0
 }
 
-func (*GetCategoriesReturns) ProtoMessage() {}
-
-func (x *GetCategoriesReturns) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GrpcCategoryOverview) GetInputPeriodId() int64 {
+	if x != nil {
+		return x.InputPeriodId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCategoriesReturns.ProtoReflect.Descriptor instead.
-func (*GetCategoriesReturns) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{31}
+func (x *GrpcCategoryOverview) GetInputPeriodCaption() string {
+	if x != nil {
+		return x.InputPeriodCaption
+	}
+	return ""
 }
 
-func (x *GetCategoriesReturns) GetCategories() []*GrpcCategory {
+func (x *GrpcCategoryOverview) GetSpendingLimit() *GrpcMoney {
 	if x != nil {
-		return x.Categories
+		return x.SpendingLimit
 	}
 	return nil
 }
 
-type GetCategoriesOverviewReturns struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Categories []*GrpcCategoryOverview `protobuf:"bytes,1,rep,name=Categories,proto3" json:"Categories,omitempty"`
+func (x *GrpcCategoryOverview) GetSpendingLeft() *GrpcMoney {
+	if x != nil {
+		return x.SpendingLeft
+	}
+	return nil
 }
 
-func (x *GetCategoriesOverviewReturns) Reset() {
-	*x = GetCategoriesOverviewReturns{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GrpcCategoryOverview) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
 	}
+	return nil
 }
 
-func (x *GetCategoriesOverviewReturns) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (x *GrpcCategoryOverview) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
 
-func (*GetCategoriesOverviewReturns) ProtoMessage() {}
+func (x *GrpcCategoryOverview) GetInitialAmount() *GrpcMoney {
+	if x != nil {
+		return x.InitialAmount
+	}
+	return nil
+}
 
-func (x *GetCategoriesOverviewReturns) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GrpcCategoryOverview) GetCurrentAmount() *GrpcMoney {
+	if x != nil {
+		return x.CurrentAmount
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetCategoriesOverviewReturns.ProtoReflect.Descriptor instead.
-func (*GetCategoriesOverviewReturns) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{32}
+func (x *GrpcCategoryOverview) GetCanBeDeleted() bool {
+	if x != nil {
+		return x.CanBeDeleted
+	}
+	return false
 }
 
-func (x *GetCategoriesOverviewReturns) GetCategories() []*GrpcCategoryOverview {
+func (x *GrpcCategoryOverview) GetTableOrder() int64 {
 	if x != nil {
-		return x.Categories
+		return x.TableOrder
+	}
+	return 0
+}
+
+func (x *GrpcCategoryOverview) GetRolloverPolicy() string {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return ""
+}
+
+func (x *GrpcCategoryOverview) GetRolloverCap() int64 {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return 0
+}
+
+func (x *GrpcCategoryOverview) GetAutoReset() bool {
+	if x != nil {
+		return x.AutoReset
+	}
+	return false
+}
+
+func (x *GrpcCategoryOverview) GetResetDue() bool {
+	if x != nil {
+		return x.ResetDue
+	}
+	return false
+}
+
+func (x *GrpcCategoryOverview) GetGoalAmount() *GrpcMoney {
+	if x != nil {
+		return x.GoalAmount
 	}
 	return nil
 }
 
-type GetTimePeriodsReturns struct {
+func (x *GrpcCategoryOverview) GetGoalDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GoalDate
+	}
+	return nil
+}
+
+func (x *GrpcCategoryOverview) GetGoalContribution() *GrpcMoney {
+	if x != nil {
+		return x.GoalContribution
+	}
+	return nil
+}
+
+type GrpcResetCategoryData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TimePeriods []*GrpcTimePeriod `protobuf:"bytes,1,rep,name=TimePeriods,proto3" json:"TimePeriods,omitempty"`
+	Amount         *GrpcMoney `protobuf:"bytes,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	CategoryId     int64      `protobuf:"varint,2,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	BudgetInput    *GrpcMoney `protobuf:"bytes,3,opt,name=BudgetInput,proto3" json:"BudgetInput,omitempty"`
+	InputInterval  int64      `protobuf:"varint,4,opt,name=InputInterval,proto3" json:"InputInterval,omitempty"`
+	InputPeriod    int64      `protobuf:"varint,5,opt,name=InputPeriod,proto3" json:"InputPeriod,omitempty"`
+	SpendingLimit  *GrpcMoney `protobuf:"bytes,6,opt,name=SpendingLimit,proto3" json:"SpendingLimit,omitempty"`
+	RolloverPolicy string     `protobuf:"bytes,7,opt,name=RolloverPolicy,proto3" json:"RolloverPolicy,omitempty"`
+	RolloverCap    int64      `protobuf:"varint,8,opt,name=RolloverCap,proto3" json:"RolloverCap,omitempty"`
 }
 
-func (x *GetTimePeriodsReturns) Reset() {
-	*x = GetTimePeriodsReturns{}
+func (x *GrpcResetCategoryData) Reset() {
+	*x = GrpcResetCategoryData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[33]
+		mi := &file_models_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTimePeriodsReturns) String() string {
+func (x *GrpcResetCategoryData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimePeriodsReturns) ProtoMessage() {}
+func (*GrpcResetCategoryData) ProtoMessage() {}
 
-func (x *GetTimePeriodsReturns) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[33]
+func (x *GrpcResetCategoryData) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2192,49 +2871,97 @@ func (x *GetTimePeriodsReturns) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimePeriodsReturns.ProtoReflect.Descriptor instead.
-func (*GetTimePeriodsReturns) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GrpcResetCategoryData.ProtoReflect.Descriptor instead.
+func (*GrpcResetCategoryData) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetTimePeriodsReturns) GetTimePeriods() []*GrpcTimePeriod {
+func (x *GrpcResetCategoryData) GetAmount() *GrpcMoney {
 	if x != nil {
-		return x.TimePeriods
+		return x.Amount
 	}
 	return nil
 }
 
-type DBNodeData struct {
+func (x *GrpcResetCategoryData) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GrpcResetCategoryData) GetBudgetInput() *GrpcMoney {
+	if x != nil {
+		return x.BudgetInput
+	}
+	return nil
+}
+
+func (x *GrpcResetCategoryData) GetInputInterval() int64 {
+	if x != nil {
+		return x.InputInterval
+	}
+	return 0
+}
+
+func (x *GrpcResetCategoryData) GetInputPeriod() int64 {
+	if x != nil {
+		return x.InputPeriod
+	}
+	return 0
+}
+
+func (x *GrpcResetCategoryData) GetSpendingLimit() *GrpcMoney {
+	if x != nil {
+		return x.SpendingLimit
+	}
+	return nil
+}
+
+func (x *GrpcResetCategoryData) GetRolloverPolicy() string {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return ""
+}
+
+func (x *GrpcResetCategoryData) GetRolloverCap() int64 {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return 0
+}
+
+// Time periods
+type GrpcTimePeriod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID             int64   `protobuf:"varint,7,opt,name=ID,proto3" json:"ID,omitempty"`
-	Address        string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	TotalMemoryMB  float64 `protobuf:"fixed64,2,opt,name=totalMemoryMB,proto3" json:"totalMemoryMB,omitempty"`
-	FreeMemoryMB   float64 `protobuf:"fixed64,3,opt,name=freeMemoryMB,proto3" json:"freeMemoryMB,omitempty"`
-	TotalStorageMB float64 `protobuf:"fixed64,4,opt,name=totalStorageMB,proto3" json:"totalStorageMB,omitempty"`
-	FreeStorageMB  float64 `protobuf:"fixed64,5,opt,name=freeStorageMB,proto3" json:"freeStorageMB,omitempty"`
-	CpuLoadPercent float64 `protobuf:"fixed64,6,opt,name=cpuLoadPercent,proto3" json:"cpuLoadPercent,omitempty"`
+	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Period    string                 `protobuf:"bytes,2,opt,name=Period,proto3" json:"Period,omitempty"`
+	Caption   string                 `protobuf:"bytes,3,opt,name=Caption,proto3" json:"Caption,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3,oneof" json:"UpdatedAt,omitempty"`
 }
 
-func (x *DBNodeData) Reset() {
-	*x = DBNodeData{}
+func (x *GrpcTimePeriod) Reset() {
+	*x = GrpcTimePeriod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[34]
+		mi := &file_models_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DBNodeData) String() string {
+func (x *GrpcTimePeriod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DBNodeData) ProtoMessage() {}
+func (*GrpcTimePeriod) ProtoMessage() {}
 
-func (x *DBNodeData) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[34]
+func (x *GrpcTimePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2245,599 +2972,5716 @@ func (x *DBNodeData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DBNodeData.ProtoReflect.Descriptor instead.
-func (*DBNodeData) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GrpcTimePeriod.ProtoReflect.Descriptor instead.
+func (*GrpcTimePeriod) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DBNodeData) GetID() int64 {
+func (x *GrpcTimePeriod) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *DBNodeData) GetAddress() string {
+func (x *GrpcTimePeriod) GetPeriod() string {
 	if x != nil {
-		return x.Address
+		return x.Period
 	}
 	return ""
 }
 
-func (x *DBNodeData) GetTotalMemoryMB() float64 {
+func (x *GrpcTimePeriod) GetCaption() string {
 	if x != nil {
-		return x.TotalMemoryMB
+		return x.Caption
 	}
-	return 0
+	return ""
 }
 
-func (x *DBNodeData) GetFreeMemoryMB() float64 {
+func (x *GrpcTimePeriod) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FreeMemoryMB
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *DBNodeData) GetTotalStorageMB() float64 {
+func (x *GrpcTimePeriod) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TotalStorageMB
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *DBNodeData) GetFreeStorageMB() float64 {
+// Functions params
+type ModifyFreeFundsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount      *GrpcMoney `protobuf:"bytes,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	ToAccountId int64      `protobuf:"varint,2,opt,name=ToAccountId,proto3" json:"ToAccountId,omitempty"`
+	TagName     string     `protobuf:"bytes,3,opt,name=TagName,proto3" json:"TagName,omitempty"`
+}
+
+func (x *ModifyFreeFundsParams) Reset() {
+	*x = ModifyFreeFundsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyFreeFundsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyFreeFundsParams) ProtoMessage() {}
+
+func (x *ModifyFreeFundsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyFreeFundsParams.ProtoReflect.Descriptor instead.
+func (*ModifyFreeFundsParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ModifyFreeFundsParams) GetAmount() *GrpcMoney {
 	if x != nil {
-		return x.FreeStorageMB
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *DBNodeData) GetCpuLoadPercent() float64 {
+func (x *ModifyFreeFundsParams) GetToAccountId() int64 {
 	if x != nil {
-		return x.CpuLoadPercent
+		return x.ToAccountId
 	}
 	return 0
 }
 
-var File_models_proto protoreflect.FileDescriptor
+func (x *ModifyFreeFundsParams) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
 
-var file_models_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x21, 0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
-	0x73, 0x67, 0x22, 0x0b, 0x0a, 0x09, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x44, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x22, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0e, 0x0a, 0x0c, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x08, 0x47, 0x72,
-	0x70, 0x63, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x42, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x44, 0x42, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x65, 0x65, 0x46, 0x75,
-	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x46, 0x72, 0x65, 0x65, 0x46,
-	0x75, 0x6e, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d,
-	0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
-	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbb, 0x03, 0x0a, 0x0b,
-	0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x61, 0x67, 0x52, 0x04, 0x54, 0x61, 0x67,
-	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0b, 0x46, 0x72, 0x6f, 0x6d, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47,
-	0x72, 0x70, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x46, 0x72, 0x6f, 0x6d,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x72, 0x6f, 0x6d, 0x43,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12,
-	0x31, 0x0a, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x07, 0x47, 0x72,
-	0x70, 0x63, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x61,
-	0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x55,
-	0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x22, 0xe5, 0x01, 0x0a, 0x18, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65,
-	0x54, 0x6f, 0x54, 0x61, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a,
-	0x09, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54,
-	0x61, 0x67, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x61, 0x67, 0x49,
-	0x64, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x0b, 0x47, 0x72, 0x70,
-	0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd3, 0x03, 0x0a, 0x0c, 0x47, 0x72,
-	0x70, 0x63, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x40, 0x0a, 0x0d, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
-	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x24, 0x0a, 0x0d,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0xaa, 0x04, 0x0a, 0x14, 0x47, 0x72, 0x70, 0x63, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x24,
-	0x0a, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x70,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x22, 0x0a, 0x0c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x66, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x4c, 0x65, 0x66, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x42,
-	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x43, 0x61, 0x6e, 0x42, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xdf, 0x01, 0x0a,
-	0x15, 0x47, 0x72, 0x70, 0x63, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd9,
-	0x01, 0x0a, 0x0e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a,
-	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6b, 0x0a, 0x15, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x79, 0x46, 0x72, 0x65, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54,
-	0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61,
-	0x67, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x61, 0x67,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x61,
-	0x67, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x78,
-	0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x28, 0x0a,
-	0x08, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x45,
-	0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x65, 0x6e,
-	0x73, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x45, 0x78, 0x70,
-	0x65, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70,
-	0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x54, 0x61, 0x67, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
-	0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6f, 0x70, 0x75,
-	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22,
-	0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
-	0x26, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x15, 0x45, 0x64, 0x69, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x89, 0x01, 0x0a, 0x13,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x14, 0x52, 0x65, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x26, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a,
-	0x0b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22,
-	0x53, 0x0a, 0x15, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4e, 0x65, 0x77, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x4d, 0x0a, 0x15,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x63, 0x61, 0x74, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x52,
-	0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x09, 0x63, 0x61, 0x74, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x45,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x72, 0x70,
-	0x63, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x72, 0x70, 0x63,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x72, 0x70,
-	0x63, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0b, 0x54, 0x69, 0x6d,
-	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x0a, 0x44, 0x42, 0x4e,
-	0x6f, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x42, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x66,
-	0x72, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x12, 0x26, 0x0a, 0x0e, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x42, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x4d, 0x42, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4d, 0x42, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x66, 0x72, 0x65, 0x65,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x42, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x70, 0x75,
-	0x4c, 0x6f, 0x61, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0e, 0x63, 0x70, 0x75, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x32, 0xb8, 0x09, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x27,
-	0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0b,
-	0x2e, 0x44, 0x42, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0a, 0x2e, 0x47, 0x72,
-	0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x09,
-	0x2e, 0x47, 0x72, 0x70, 0x63, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0c, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x0b, 0x2e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x06, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x12, 0x0d, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35,
-	0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x72, 0x65, 0x65, 0x46, 0x75, 0x6e, 0x64,
-	0x73, 0x12, 0x16, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x72, 0x65, 0x65, 0x46, 0x75,
-	0x6e, 0x64, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73,
-	0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x2e, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x0a, 0x2e, 0x47,
-	0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78,
-	0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x29, 0x0a,
-	0x0a, 0x41, 0x64, 0x64, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x0f, 0x2e, 0x45, 0x78,
-	0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47,
-	0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74,
-	0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x0f, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73,
-	0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78,
-	0x70, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78,
-	0x70, 0x65, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72,
-	0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12,
-	0x2b, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x11, 0x2e,
-	0x41, 0x64, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0f,
-	0x45, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70,
-	0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e,
-	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0e, 0x52, 0x65, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x52, 0x65,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0a, 0x2e,
-	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
-	0x12, 0x42, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x12, 0x12, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a,
-	0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x35, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x12, 0x16, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70,
-	0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x42, 0x39, 0x5a, 0x37,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6d, 0x69, 0x74,
-	0x61, 0x72, 0x67, 0x72, 0x6f, 0x7a, 0x65, 0x76, 0x35, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x6e, 0x73,
-	0x65, 0x73, 0x2d, 0x67, 0x6f, 0x2d, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type GetTagsReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*GrpcTag `protobuf:"bytes,1,rep,name=Tags,proto3" json:"Tags,omitempty"`
 }
 
-var (
-	file_models_proto_rawDescOnce sync.Once
-	file_models_proto_rawDescData = file_models_proto_rawDesc
-)
+func (x *GetTagsReturns) Reset() {
+	*x = GetTagsReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_models_proto_rawDescGZIP() []byte {
-	file_models_proto_rawDescOnce.Do(func() {
-		file_models_proto_rawDescData = protoimpl.X.CompressGZIP(file_models_proto_rawDescData)
-	})
-	return file_models_proto_rawDescData
+func (x *GetTagsReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_models_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
-var file_models_proto_goTypes = []interface{}{
-	(*SimpleMessage)(nil),                // 0: SimpleMessage
-	(*GrpcEmpty)(nil),                    // 1: GrpcEmpty
-	(*LoginCredentials)(nil),             // 2: LoginCredentials
-	(*LoginToken)(nil),                   // 3: LoginToken
-	(*LogoutParams)(nil),                 // 4: LogoutParams
-	(*GrpcUser)(nil),                     // 5: GrpcUser
-	(*GrpcExpense)(nil),                  // 6: GrpcExpense
-	(*GrpcTag)(nil),                      // 7: GrpcTag
-	(*GrpcExpenseToTagRealtion)(nil),     // 8: GrpcExpenseToTagRealtion
-	(*GrpcAccount)(nil),                  // 9: GrpcAccount
-	(*GrpcCategory)(nil),                 // 10: GrpcCategory
-	(*GrpcCategoryOverview)(nil),         // 11: GrpcCategoryOverview
-	(*GrpcResetCategoryData)(nil),        // 12: GrpcResetCategoryData
-	(*GrpcTimePeriod)(nil),               // 13: GrpcTimePeriod
-	(*ModifyFreeFundsParams)(nil),        // 14: ModifyFreeFundsParams
-	(*GetTagsReturns)(nil),               // 15: GetTagsReturns
-	(*GetExpensesReturns)(nil),           // 16: GetExpensesReturns
-	(*ExpensesParams)(nil),               // 17: ExpensesParams
-	(*DeleteExpenseParams)(nil),          // 18: DeleteExpenseParams
-	(*GetAccountsParams)(nil),            // 19: GetAccountsParams
-	(*GetAccountsReturns)(nil),           // 20: GetAccountsReturns
-	(*AddAccountParams)(nil),             // 21: AddAccountParams
-	(*EditAccountNameParams)(nil),        // 22: EditAccountNameParams
-	(*DeleteAccountParams)(nil),          // 23: DeleteAccountParams
-	(*TransferFundsParams)(nil),          // 24: TransferFundsParams
-	(*ReorderAccountParams)(nil),         // 25: ReorderAccountParams
-	(*AddCategoryParams)(nil),            // 26: AddCategoryParams
-	(*ReorderCategoryParams)(nil),        // 27: ReorderCategoryParams
-	(*DeleteCategoryParams)(nil),         // 28: DeleteCategoryParams
-	(*ResetCategoriesParams)(nil),        // 29: ResetCategoriesParams
-	(*GetCategoriesCountReturns)(nil),    // 30: GetCategoriesCountReturns
-	(*GetCategoriesReturns)(nil),         // 31: GetCategoriesReturns
-	(*GetCategoriesOverviewReturns)(nil), // 32: GetCategoriesOverviewReturns
-	(*GetTimePeriodsReturns)(nil),        // 33: GetTimePeriodsReturns
-	(*DBNodeData)(nil),                   // 34: DBNodeData
-	(*timestamppb.Timestamp)(nil),        // 35: google.protobuf.Timestamp
+func (*GetTagsReturns) ProtoMessage() {}
+
+func (x *GetTagsReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_models_proto_depIdxs = []int32{
-	35, // 0: GrpcUser.CreatedAt:type_name -> google.protobuf.Timestamp
-	35, // 1: GrpcUser.UpdatedAt:type_name -> google.protobuf.Timestamp
-	35, // 2: GrpcExpense.Date:type_name -> google.protobuf.Timestamp
-	7,  // 3: GrpcExpense.Tags:type_name -> GrpcTag
-	9,  // 4: GrpcExpense.FromAccount:type_name -> GrpcAccount
-	10, // 5: GrpcExpense.FromCategory:type_name -> GrpcCategory
-	35, // 6: GrpcExpense.CreatedAt:type_name -> google.protobuf.Timestamp
-	35, // 7: GrpcExpense.UpdatedAt:type_name -> google.protobuf.Timestamp
-	35, // 8: GrpcTag.CreatedAt:type_name -> google.protobuf.Timestamp
-	35, // 9: GrpcTag.UpdatedAt:type_name -> google.protobuf.Timestamp
-	35, // 10: GrpcExpenseToTagRealtion.CreatedAt:type_name -> google.protobuf.Timestamp
-	35, // 11: GrpcExpenseToTagRealtion.UpdatedAt:type_name -> google.protobuf.Timestamp
-	35, // 12: GrpcAccount.CreatedAt:type_name -> google.protobuf.Timestamp
-	35, // 13: GrpcAccount.UpdatedAt:type_name -> google.protobuf.Timestamp
-	35, // 14: GrpcCategory.LastInputDate:type_name -> google.protobuf.Timestamp
-	35, // 15: GrpcCategory.CreatedAt:type_name -> google.protobuf.Timestamp
-	35, // 16: GrpcCategory.UpdatedAt:type_name -> google.protobuf.Timestamp
-	35, // 17: GrpcCategoryOverview.PeriodStart:type_name -> google.protobuf.Timestamp
-	35, // 18: GrpcCategoryOverview.PeriodEnd:type_name -> google.protobuf.Timestamp
-	35, // 19: GrpcTimePeriod.CreatedAt:type_name -> google.protobuf.Timestamp
-	35, // 20: GrpcTimePeriod.UpdatedAt:type_name -> google.protobuf.Timestamp
-	7,  // 21: GetTagsReturns.Tags:type_name -> GrpcTag
-	6,  // 22: GetExpensesReturns.Expenses:type_name -> GrpcExpense
-	6,  // 23: ExpensesParams.Expense:type_name -> GrpcExpense
-	9,  // 24: GetAccountsReturns.Accounts:type_name -> GrpcAccount
-	9,  // 25: TransferFundsParams.FromAccount:type_name -> GrpcAccount
-	9,  // 26: TransferFundsParams.ToAccount:type_name -> GrpcAccount
-	9,  // 27: ReorderAccountParams.Account:type_name -> GrpcAccount
-	12, // 28: ResetCategoriesParams.catgories:type_name -> GrpcResetCategoryData
-	10, // 29: GetCategoriesReturns.Categories:type_name -> GrpcCategory
-	11, // 30: GetCategoriesOverviewReturns.Categories:type_name -> GrpcCategoryOverview
-	13, // 31: GetTimePeriodsReturns.TimePeriods:type_name -> GrpcTimePeriod
-	34, // 32: Database.RegisterNode:input_type -> DBNodeData
-	1,  // 33: Database.GetUser:input_type -> GrpcEmpty
-	2,  // 34: Database.Authenticate:input_type -> LoginCredentials
-	4,  // 35: Database.Logout:input_type -> LogoutParams
-	14, // 36: Database.ModifyFreeFunds:input_type -> ModifyFreeFundsParams
-	1,  // 37: Database.GetTags:input_type -> GrpcEmpty
-	1,  // 38: Database.GetExpenses:input_type -> GrpcEmpty
-	17, // 39: Database.AddExpense:input_type -> ExpensesParams
-	17, // 40: Database.EditExpense:input_type -> ExpensesParams
-	18, // 41: Database.DeleteExpense:input_type -> DeleteExpenseParams
-	19, // 42: Database.GetAccounts:input_type -> GetAccountsParams
-	21, // 43: Database.AddAccount:input_type -> AddAccountParams
-	22, // 44: Database.EditAccountName:input_type -> EditAccountNameParams
-	23, // 45: Database.DeleteAccount:input_type -> DeleteAccountParams
-	24, // 46: Database.TransferFunds:input_type -> TransferFundsParams
-	25, // 47: Database.ReorderAccount:input_type -> ReorderAccountParams
-	1,  // 48: Database.GetCategoriesCount:input_type -> GrpcEmpty
-	1,  // 49: Database.GetCategories:input_type -> GrpcEmpty
-	1,  // 50: Database.GetCategoriesOverview:input_type -> GrpcEmpty
-	26, // 51: Database.AddCategory:input_type -> AddCategoryParams
-	27, // 52: Database.ReorderCategory:input_type -> ReorderCategoryParams
-	28, // 53: Database.DeleteCategory:input_type -> DeleteCategoryParams
-	29, // 54: Database.ResetCategories:input_type -> ResetCategoriesParams
-	1,  // 55: Database.GetTimePeriods:input_type -> GrpcEmpty
-	1,  // 56: Database.RegisterNode:output_type -> GrpcEmpty
-	5,  // 57: Database.GetUser:output_type -> GrpcUser
-	3,  // 58: Database.Authenticate:output_type -> LoginToken
-	1,  // 59: Database.Logout:output_type -> GrpcEmpty
-	1,  // 60: Database.ModifyFreeFunds:output_type -> GrpcEmpty
-	15, // 61: Database.GetTags:output_type -> GetTagsReturns
-	16, // 62: Database.GetExpenses:output_type -> GetExpensesReturns
-	1,  // 63: Database.AddExpense:output_type -> GrpcEmpty
-	1,  // 64: Database.EditExpense:output_type -> GrpcEmpty
-	1,  // 65: Database.DeleteExpense:output_type -> GrpcEmpty
-	20, // 66: Database.GetAccounts:output_type -> GetAccountsReturns
-	1,  // 67: Database.AddAccount:output_type -> GrpcEmpty
-	1,  // 68: Database.EditAccountName:output_type -> GrpcEmpty
-	1,  // 69: Database.DeleteAccount:output_type -> GrpcEmpty
-	1,  // 70: Database.TransferFunds:output_type -> GrpcEmpty
-	1,  // 71: Database.ReorderAccount:output_type -> GrpcEmpty
-	30, // 72: Database.GetCategoriesCount:output_type -> GetCategoriesCountReturns
-	31, // 73: Database.GetCategories:output_type -> GetCategoriesReturns
-	32, // 74: Database.GetCategoriesOverview:output_type -> GetCategoriesOverviewReturns
-	1,  // 75: Database.AddCategory:output_type -> GrpcEmpty
-	1,  // 76: Database.ReorderCategory:output_type -> GrpcEmpty
-	1,  // 77: Database.DeleteCategory:output_type -> GrpcEmpty
-	1,  // 78: Database.ResetCategories:output_type -> GrpcEmpty
-	33, // 79: Database.GetTimePeriods:output_type -> GetTimePeriodsReturns
-	56, // [56:80] is the sub-list for method output_type
-	32, // [32:56] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+
+// Deprecated: Use GetTagsReturns.ProtoReflect.Descriptor instead.
+func (*GetTagsReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{32}
 }
 
-func init() { file_models_proto_init() }
-func file_models_proto_init() {
-	if File_models_proto != nil {
-		return
+func (x *GetTagsReturns) GetTags() []*GrpcTag {
+	if x != nil {
+		return x.Tags
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_models_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimpleMessage); i {
+	return nil
+}
+
+type GetExpensesReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expenses    []*GrpcExpense                  `protobuf:"bytes,1,rep,name=Expenses,proto3" json:"Expenses,omitempty"`
+	Outstanding []*GrpcOutstandingReimbursement `protobuf:"bytes,2,rep,name=Outstanding,proto3" json:"Outstanding,omitempty"`
+	// Attachment store usage and quota in bytes
+	AttachmentsSize  int64 `protobuf:"varint,3,opt,name=AttachmentsSize,proto3" json:"AttachmentsSize,omitempty"`
+	AttachmentsQuota int64 `protobuf:"varint,4,opt,name=AttachmentsQuota,proto3" json:"AttachmentsQuota,omitempty"`
+}
+
+func (x *GetExpensesReturns) Reset() {
+	*x = GetExpensesReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExpensesReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpensesReturns) ProtoMessage() {}
+
+func (x *GetExpensesReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpensesReturns.ProtoReflect.Descriptor instead.
+func (*GetExpensesReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetExpensesReturns) GetExpenses() []*GrpcExpense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *GetExpensesReturns) GetOutstanding() []*GrpcOutstandingReimbursement {
+	if x != nil {
+		return x.Outstanding
+	}
+	return nil
+}
+
+func (x *GetExpensesReturns) GetAttachmentsSize() int64 {
+	if x != nil {
+		return x.AttachmentsSize
+	}
+	return 0
+}
+
+func (x *GetExpensesReturns) GetAttachmentsQuota() int64 {
+	if x != nil {
+		return x.AttachmentsQuota
+	}
+	return 0
+}
+
+type ExpensesParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expense *GrpcExpense `protobuf:"bytes,1,opt,name=Expense,proto3" json:"Expense,omitempty"`
+	Tags    []string     `protobuf:"bytes,2,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// Additional lines. They share the date of the expense
+	Splits []*ExpensesParams `protobuf:"bytes,3,rep,name=Splits,proto3" json:"Splits,omitempty"`
+	// Payee name. New payees are created. Empty for expenses without a payee
+	Payee string `protobuf:"bytes,4,opt,name=Payee,proto3" json:"Payee,omitempty"`
+}
+
+func (x *ExpensesParams) Reset() {
+	*x = ExpensesParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpensesParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpensesParams) ProtoMessage() {}
+
+func (x *ExpensesParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpensesParams.ProtoReflect.Descriptor instead.
+func (*ExpensesParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExpensesParams) GetExpense() *GrpcExpense {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *ExpensesParams) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExpensesParams) GetSplits() []*ExpensesParams {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *ExpensesParams) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+type DeleteExpenseParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteExpenseParams) Reset() {
+	*x = DeleteExpenseParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteExpenseParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseParams) ProtoMessage() {}
+
+func (x *DeleteExpenseParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseParams.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteExpenseParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type ExpenseRefundParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Refund *GrpcExpenseRefund `protobuf:"bytes,1,opt,name=Refund,proto3" json:"Refund,omitempty"`
+}
+
+func (x *ExpenseRefundParams) Reset() {
+	*x = ExpenseRefundParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpenseRefundParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseRefundParams) ProtoMessage() {}
+
+func (x *ExpenseRefundParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseRefundParams.ProtoReflect.Descriptor instead.
+func (*ExpenseRefundParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExpenseRefundParams) GetRefund() *GrpcExpenseRefund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type DeleteExpenseRefundParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteExpenseRefundParams) Reset() {
+	*x = DeleteExpenseRefundParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteExpenseRefundParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseRefundParams) ProtoMessage() {}
+
+func (x *DeleteExpenseRefundParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseRefundParams.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseRefundParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteExpenseRefundParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type GetPayeesReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payees []*GrpcPayee `protobuf:"bytes,1,rep,name=Payees,proto3" json:"Payees,omitempty"`
+}
+
+func (x *GetPayeesReturns) Reset() {
+	*x = GetPayeesReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayeesReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayeesReturns) ProtoMessage() {}
+
+func (x *GetPayeesReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayeesReturns.ProtoReflect.Descriptor instead.
+func (*GetPayeesReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPayeesReturns) GetPayees() []*GrpcPayee {
+	if x != nil {
+		return x.Payees
+	}
+	return nil
+}
+
+type PayeeParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payee *GrpcPayee `protobuf:"bytes,1,opt,name=Payee,proto3" json:"Payee,omitempty"`
+}
+
+func (x *PayeeParams) Reset() {
+	*x = PayeeParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayeeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayeeParams) ProtoMessage() {}
+
+func (x *PayeeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayeeParams.ProtoReflect.Descriptor instead.
+func (*PayeeParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PayeeParams) GetPayee() *GrpcPayee {
+	if x != nil {
+		return x.Payee
+	}
+	return nil
+}
+
+type DeletePayeeParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeletePayeeParams) Reset() {
+	*x = DeletePayeeParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePayeeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePayeeParams) ProtoMessage() {}
+
+func (x *DeletePayeeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePayeeParams.ProtoReflect.Descriptor instead.
+func (*DeletePayeeParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeletePayeeParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type GetRulesReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*GrpcRule `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+}
+
+func (x *GetRulesReturns) Reset() {
+	*x = GetRulesReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRulesReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRulesReturns) ProtoMessage() {}
+
+func (x *GetRulesReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRulesReturns.ProtoReflect.Descriptor instead.
+func (*GetRulesReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetRulesReturns) GetRules() []*GrpcRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type RuleParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *GrpcRule `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+}
+
+func (x *RuleParams) Reset() {
+	*x = RuleParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleParams) ProtoMessage() {}
+
+func (x *RuleParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleParams.ProtoReflect.Descriptor instead.
+func (*RuleParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RuleParams) GetRule() *GrpcRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteRuleParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteRuleParams) Reset() {
+	*x = DeleteRuleParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRuleParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleParams) ProtoMessage() {}
+
+func (x *DeleteRuleParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleParams.ProtoReflect.Descriptor instead.
+func (*DeleteRuleParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteRuleParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+// Past expenses matched by a rule, most recent first
+type TestRuleReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expenses []*GrpcExpense `protobuf:"bytes,1,rep,name=Expenses,proto3" json:"Expenses,omitempty"`
+	Matched  int64          `protobuf:"varint,2,opt,name=Matched,proto3" json:"Matched,omitempty"`
+}
+
+func (x *TestRuleReturns) Reset() {
+	*x = TestRuleReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRuleReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRuleReturns) ProtoMessage() {}
+
+func (x *TestRuleReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRuleReturns.ProtoReflect.Descriptor instead.
+func (*TestRuleReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TestRuleReturns) GetExpenses() []*GrpcExpense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *TestRuleReturns) GetMatched() int64 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+// Attachments are streamed in chunks. The first message has the details
+type AttachmentChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info *GrpcAttachment `protobuf:"bytes,1,opt,name=Info,proto3" json:"Info,omitempty"`
+	Data []byte          `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *AttachmentChunk) Reset() {
+	*x = AttachmentChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachmentChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentChunk) ProtoMessage() {}
+
+func (x *AttachmentChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentChunk.ProtoReflect.Descriptor instead.
+func (*AttachmentChunk) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AttachmentChunk) GetInfo() *GrpcAttachment {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *AttachmentChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetAttachmentParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *GetAttachmentParams) Reset() {
+	*x = GetAttachmentParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttachmentParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentParams) ProtoMessage() {}
+
+func (x *GetAttachmentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentParams.ProtoReflect.Descriptor instead.
+func (*GetAttachmentParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetAttachmentParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteAttachmentParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteAttachmentParams) Reset() {
+	*x = DeleteAttachmentParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAttachmentParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentParams) ProtoMessage() {}
+
+func (x *DeleteAttachmentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentParams.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteAttachmentParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+// Most recent entries first. Undo ID is the entry the next undo reverts
+type GetAuditLogReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*GrpcAuditEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	UndoId  int64             `protobuf:"varint,2,opt,name=UndoId,proto3" json:"UndoId,omitempty"`
+}
+
+func (x *GetAuditLogReturns) Reset() {
+	*x = GetAuditLogReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuditLogReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogReturns) ProtoMessage() {}
+
+func (x *GetAuditLogReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogReturns.ProtoReflect.Descriptor instead.
+func (*GetAuditLogReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetAuditLogReturns) GetEntries() []*GrpcAuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetAuditLogReturns) GetUndoId() int64 {
+	if x != nil {
+		return x.UndoId
+	}
+	return 0
+}
+
+// Fix overwrites drifted values with the recomputed ones
+type VerifyIntegrityParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fix bool `protobuf:"varint,1,opt,name=Fix,proto3" json:"Fix,omitempty"`
+}
+
+func (x *VerifyIntegrityParams) Reset() {
+	*x = VerifyIntegrityParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyIntegrityParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyIntegrityParams) ProtoMessage() {}
+
+func (x *VerifyIntegrityParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyIntegrityParams.ProtoReflect.Descriptor instead.
+func (*VerifyIntegrityParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VerifyIntegrityParams) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+type VerifyIntegrityReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drifts []*GrpcDrift `protobuf:"bytes,1,rep,name=Drifts,proto3" json:"Drifts,omitempty"`
+	Fixed  bool         `protobuf:"varint,2,opt,name=Fixed,proto3" json:"Fixed,omitempty"`
+}
+
+func (x *VerifyIntegrityReturns) Reset() {
+	*x = VerifyIntegrityReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyIntegrityReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyIntegrityReturns) ProtoMessage() {}
+
+func (x *VerifyIntegrityReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyIntegrityReturns.ProtoReflect.Descriptor instead.
+func (*VerifyIntegrityReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VerifyIntegrityReturns) GetDrifts() []*GrpcDrift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+func (x *VerifyIntegrityReturns) GetFixed() bool {
+	if x != nil {
+		return x.Fixed
+	}
+	return false
+}
+
+type SetExpenseReimbursementParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpenseId int64 `protobuf:"varint,1,opt,name=ExpenseId,proto3" json:"ExpenseId,omitempty"`
+	// Empty counterparty clears the awaited reimbursement
+	Counterparty string `protobuf:"bytes,2,opt,name=Counterparty,proto3" json:"Counterparty,omitempty"`
+	// Defaults to the base amount of the expense less its refunds
+	Amount *GrpcMoney `protobuf:"bytes,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+}
+
+func (x *SetExpenseReimbursementParams) Reset() {
+	*x = SetExpenseReimbursementParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExpenseReimbursementParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExpenseReimbursementParams) ProtoMessage() {}
+
+func (x *SetExpenseReimbursementParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExpenseReimbursementParams.ProtoReflect.Descriptor instead.
+func (*SetExpenseReimbursementParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetExpenseReimbursementParams) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *SetExpenseReimbursementParams) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *SetExpenseReimbursementParams) GetAmount() *GrpcMoney {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type GetIncomesReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Incomes []*GrpcIncome         `protobuf:"bytes,1,rep,name=Incomes,proto3" json:"Incomes,omitempty"`
+	Months  []*GrpcMonthlySummary `protobuf:"bytes,2,rep,name=Months,proto3" json:"Months,omitempty"`
+}
+
+func (x *GetIncomesReturns) Reset() {
+	*x = GetIncomesReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncomesReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncomesReturns) ProtoMessage() {}
+
+func (x *GetIncomesReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncomesReturns.ProtoReflect.Descriptor instead.
+func (*GetIncomesReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetIncomesReturns) GetIncomes() []*GrpcIncome {
+	if x != nil {
+		return x.Incomes
+	}
+	return nil
+}
+
+func (x *GetIncomesReturns) GetMonths() []*GrpcMonthlySummary {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+type IncomeParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Income *GrpcIncome `protobuf:"bytes,1,opt,name=Income,proto3" json:"Income,omitempty"`
+	Tags   []string    `protobuf:"bytes,2,rep,name=Tags,proto3" json:"Tags,omitempty"`
+}
+
+func (x *IncomeParams) Reset() {
+	*x = IncomeParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncomeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeParams) ProtoMessage() {}
+
+func (x *IncomeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeParams.ProtoReflect.Descriptor instead.
+func (*IncomeParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *IncomeParams) GetIncome() *GrpcIncome {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+func (x *IncomeParams) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type DeleteIncomeParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteIncomeParams) Reset() {
+	*x = DeleteIncomeParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIncomeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIncomeParams) ProtoMessage() {}
+
+func (x *DeleteIncomeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIncomeParams.ProtoReflect.Descriptor instead.
+func (*DeleteIncomeParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteIncomeParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type GetLiabilitiesReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Liabilities []*GrpcLiability `protobuf:"bytes,1,rep,name=Liabilities,proto3" json:"Liabilities,omitempty"`
+	// Net worth is the accounts total plus lent minus borrowed balances
+	Accounts *GrpcMoney `protobuf:"bytes,2,opt,name=Accounts,proto3" json:"Accounts,omitempty"`
+	Lent     *GrpcMoney `protobuf:"bytes,3,opt,name=Lent,proto3" json:"Lent,omitempty"`
+	Borrowed *GrpcMoney `protobuf:"bytes,4,opt,name=Borrowed,proto3" json:"Borrowed,omitempty"`
+	NetWorth *GrpcMoney `protobuf:"bytes,5,opt,name=NetWorth,proto3" json:"NetWorth,omitempty"`
+	// Currencies of accounts left out of the accounts total
+	MissingRates []string `protobuf:"bytes,6,rep,name=MissingRates,proto3" json:"MissingRates,omitempty"`
+}
+
+func (x *GetLiabilitiesReturns) Reset() {
+	*x = GetLiabilitiesReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLiabilitiesReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiabilitiesReturns) ProtoMessage() {}
+
+func (x *GetLiabilitiesReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiabilitiesReturns.ProtoReflect.Descriptor instead.
+func (*GetLiabilitiesReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetLiabilitiesReturns) GetLiabilities() []*GrpcLiability {
+	if x != nil {
+		return x.Liabilities
+	}
+	return nil
+}
+
+func (x *GetLiabilitiesReturns) GetAccounts() *GrpcMoney {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetLiabilitiesReturns) GetLent() *GrpcMoney {
+	if x != nil {
+		return x.Lent
+	}
+	return nil
+}
+
+func (x *GetLiabilitiesReturns) GetBorrowed() *GrpcMoney {
+	if x != nil {
+		return x.Borrowed
+	}
+	return nil
+}
+
+func (x *GetLiabilitiesReturns) GetNetWorth() *GrpcMoney {
+	if x != nil {
+		return x.NetWorth
+	}
+	return nil
+}
+
+func (x *GetLiabilitiesReturns) GetMissingRates() []string {
+	if x != nil {
+		return x.MissingRates
+	}
+	return nil
+}
+
+type AddLiabilityParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Liability *GrpcLiability `protobuf:"bytes,1,opt,name=Liability,proto3" json:"Liability,omitempty"`
+}
+
+func (x *AddLiabilityParams) Reset() {
+	*x = AddLiabilityParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddLiabilityParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLiabilityParams) ProtoMessage() {}
+
+func (x *AddLiabilityParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLiabilityParams.ProtoReflect.Descriptor instead.
+func (*AddLiabilityParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddLiabilityParams) GetLiability() *GrpcLiability {
+	if x != nil {
+		return x.Liability
+	}
+	return nil
+}
+
+type DeleteLiabilityParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteLiabilityParams) Reset() {
+	*x = DeleteLiabilityParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLiabilityParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLiabilityParams) ProtoMessage() {}
+
+func (x *DeleteLiabilityParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLiabilityParams.ProtoReflect.Descriptor instead.
+func (*DeleteLiabilityParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteLiabilityParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type LiabilityPaymentParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiabilityId int64 `protobuf:"varint,1,opt,name=LiabilityId,proto3" json:"LiabilityId,omitempty"`
+	// Amount and interest part in the account currency
+	Amount    *GrpcMoney             `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Interest  *GrpcMoney             `protobuf:"bytes,3,opt,name=Interest,proto3" json:"Interest,omitempty"`
+	Date      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=Date,proto3" json:"Date,omitempty"`
+	AccountId int64                  `protobuf:"varint,5,opt,name=AccountId,proto3" json:"AccountId,omitempty"`
+	// Category of the expense for borrowed liabilities
+	CategoryId int64    `protobuf:"varint,6,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	Tags       []string `protobuf:"bytes,7,rep,name=Tags,proto3" json:"Tags,omitempty"`
+}
+
+func (x *LiabilityPaymentParams) Reset() {
+	*x = LiabilityPaymentParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiabilityPaymentParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiabilityPaymentParams) ProtoMessage() {}
+
+func (x *LiabilityPaymentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiabilityPaymentParams.ProtoReflect.Descriptor instead.
+func (*LiabilityPaymentParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LiabilityPaymentParams) GetLiabilityId() int64 {
+	if x != nil {
+		return x.LiabilityId
+	}
+	return 0
+}
+
+func (x *LiabilityPaymentParams) GetAmount() *GrpcMoney {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LiabilityPaymentParams) GetInterest() *GrpcMoney {
+	if x != nil {
+		return x.Interest
+	}
+	return nil
+}
+
+func (x *LiabilityPaymentParams) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *LiabilityPaymentParams) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *LiabilityPaymentParams) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *LiabilityPaymentParams) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetAccountsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderByPopularity bool `protobuf:"varint,1,opt,name=OrderByPopularity,proto3" json:"OrderByPopularity,omitempty"`
+}
+
+func (x *GetAccountsParams) Reset() {
+	*x = GetAccountsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsParams) ProtoMessage() {}
+
+func (x *GetAccountsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsParams.ProtoReflect.Descriptor instead.
+func (*GetAccountsParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetAccountsParams) GetOrderByPopularity() bool {
+	if x != nil {
+		return x.OrderByPopularity
+	}
+	return false
+}
+
+type GetAccountsReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts []*GrpcAccount `protobuf:"bytes,1,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+	// Sum of accounts in the base currency
+	Total *GrpcMoney `protobuf:"bytes,2,opt,name=Total,proto3" json:"Total,omitempty"`
+	// Currencies left out of the total because they have no exchange rate
+	MissingRates []string `protobuf:"bytes,3,rep,name=MissingRates,proto3" json:"MissingRates,omitempty"`
+}
+
+func (x *GetAccountsReturns) Reset() {
+	*x = GetAccountsReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountsReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsReturns) ProtoMessage() {}
+
+func (x *GetAccountsReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsReturns.ProtoReflect.Descriptor instead.
+func (*GetAccountsReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetAccountsReturns) GetAccounts() []*GrpcAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetAccountsReturns) GetTotal() *GrpcMoney {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetAccountsReturns) GetMissingRates() []string {
+	if x != nil {
+		return x.MissingRates
+	}
+	return nil
+}
+
+type AddAccountParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Defaults to the base currency
+	Currency string `protobuf:"bytes,2,opt,name=Currency,proto3" json:"Currency,omitempty"`
+}
+
+func (x *AddAccountParams) Reset() {
+	*x = AddAccountParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAccountParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAccountParams) ProtoMessage() {}
+
+func (x *AddAccountParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAccountParams.ProtoReflect.Descriptor instead.
+func (*AddAccountParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AddAccountParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAccountParams) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type EditAccountNameParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID   int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *EditAccountNameParams) Reset() {
+	*x = EditAccountNameParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditAccountNameParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditAccountNameParams) ProtoMessage() {}
+
+func (x *EditAccountNameParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditAccountNameParams.ProtoReflect.Descriptor instead.
+func (*EditAccountNameParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EditAccountNameParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *EditAccountNameParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteAccountParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteAccountParams) Reset() {
+	*x = DeleteAccountParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountParams) ProtoMessage() {}
+
+func (x *DeleteAccountParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountParams.ProtoReflect.Descriptor instead.
+func (*DeleteAccountParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteAccountParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type TransferFundsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromAccount *GrpcAccount `protobuf:"bytes,1,opt,name=FromAccount,proto3" json:"FromAccount,omitempty"`
+	ToAccount   *GrpcAccount `protobuf:"bytes,2,opt,name=ToAccount,proto3" json:"ToAccount,omitempty"`
+	Amount      *GrpcMoney   `protobuf:"bytes,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// Rate used when the accounts have different currencies. The stored rate is used when empty
+	Rate string `protobuf:"bytes,4,opt,name=Rate,proto3" json:"Rate,omitempty"`
+}
+
+func (x *TransferFundsParams) Reset() {
+	*x = TransferFundsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferFundsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFundsParams) ProtoMessage() {}
+
+func (x *TransferFundsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferFundsParams.ProtoReflect.Descriptor instead.
+func (*TransferFundsParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TransferFundsParams) GetFromAccount() *GrpcAccount {
+	if x != nil {
+		return x.FromAccount
+	}
+	return nil
+}
+
+func (x *TransferFundsParams) GetToAccount() *GrpcAccount {
+	if x != nil {
+		return x.ToAccount
+	}
+	return nil
+}
+
+func (x *TransferFundsParams) GetAmount() *GrpcMoney {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *TransferFundsParams) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+type ReorderAccountParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account   *GrpcAccount `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
+	Direction int64        `protobuf:"varint,2,opt,name=Direction,proto3" json:"Direction,omitempty"`
+}
+
+func (x *ReorderAccountParams) Reset() {
+	*x = ReorderAccountParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderAccountParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderAccountParams) ProtoMessage() {}
+
+func (x *ReorderAccountParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderAccountParams.ProtoReflect.Descriptor instead.
+func (*ReorderAccountParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReorderAccountParams) GetAccount() *GrpcAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ReorderAccountParams) GetDirection() int64 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+type AddCategoryParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string     `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	BudgetInput   *GrpcMoney `protobuf:"bytes,2,opt,name=BudgetInput,proto3" json:"BudgetInput,omitempty"`
+	SpendingLimit *GrpcMoney `protobuf:"bytes,3,opt,name=SpendingLimit,proto3" json:"SpendingLimit,omitempty"`
+	InputInterval int64      `protobuf:"varint,4,opt,name=InputInterval,proto3" json:"InputInterval,omitempty"`
+	InputPeriod   int64      `protobuf:"varint,5,opt,name=InputPeriod,proto3" json:"InputPeriod,omitempty"`
+	// Defaults to carry
+	RolloverPolicy string `protobuf:"bytes,6,opt,name=RolloverPolicy,proto3" json:"RolloverPolicy,omitempty"`
+	RolloverCap    int64  `protobuf:"varint,7,opt,name=RolloverCap,proto3" json:"RolloverCap,omitempty"`
+	AutoReset      bool   `protobuf:"varint,8,opt,name=AutoReset,proto3" json:"AutoReset,omitempty"`
+	// Zero for categories without a goal
+	GoalAmount *GrpcMoney             `protobuf:"bytes,9,opt,name=GoalAmount,proto3" json:"GoalAmount,omitempty"`
+	GoalDate   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=GoalDate,proto3" json:"GoalDate,omitempty"`
+}
+
+func (x *AddCategoryParams) Reset() {
+	*x = AddCategoryParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCategoryParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryParams) ProtoMessage() {}
+
+func (x *AddCategoryParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryParams.ProtoReflect.Descriptor instead.
+func (*AddCategoryParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AddCategoryParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddCategoryParams) GetBudgetInput() *GrpcMoney {
+	if x != nil {
+		return x.BudgetInput
+	}
+	return nil
+}
+
+func (x *AddCategoryParams) GetSpendingLimit() *GrpcMoney {
+	if x != nil {
+		return x.SpendingLimit
+	}
+	return nil
+}
+
+func (x *AddCategoryParams) GetInputInterval() int64 {
+	if x != nil {
+		return x.InputInterval
+	}
+	return 0
+}
+
+func (x *AddCategoryParams) GetInputPeriod() int64 {
+	if x != nil {
+		return x.InputPeriod
+	}
+	return 0
+}
+
+func (x *AddCategoryParams) GetRolloverPolicy() string {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return ""
+}
+
+func (x *AddCategoryParams) GetRolloverCap() int64 {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return 0
+}
+
+func (x *AddCategoryParams) GetAutoReset() bool {
+	if x != nil {
+		return x.AutoReset
+	}
+	return false
+}
+
+func (x *AddCategoryParams) GetGoalAmount() *GrpcMoney {
+	if x != nil {
+		return x.GoalAmount
+	}
+	return nil
+}
+
+func (x *AddCategoryParams) GetGoalDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GoalDate
+	}
+	return nil
+}
+
+type ReorderCategoryParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryId int64 `protobuf:"varint,1,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	NewOrder   int64 `protobuf:"varint,2,opt,name=NewOrder,proto3" json:"NewOrder,omitempty"`
+}
+
+func (x *ReorderCategoryParams) Reset() {
+	*x = ReorderCategoryParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderCategoryParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCategoryParams) ProtoMessage() {}
+
+func (x *ReorderCategoryParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCategoryParams.ProtoReflect.Descriptor instead.
+func (*ReorderCategoryParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReorderCategoryParams) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ReorderCategoryParams) GetNewOrder() int64 {
+	if x != nil {
+		return x.NewOrder
+	}
+	return 0
+}
+
+type DeleteCategoryParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteCategoryParams) Reset() {
+	*x = DeleteCategoryParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCategoryParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryParams) ProtoMessage() {}
+
+func (x *DeleteCategoryParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryParams.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteCategoryParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type SetCategoryGoalParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryId int64 `protobuf:"varint,1,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	// Zero clears the goal
+	GoalAmount *GrpcMoney             `protobuf:"bytes,2,opt,name=GoalAmount,proto3" json:"GoalAmount,omitempty"`
+	GoalDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=GoalDate,proto3" json:"GoalDate,omitempty"`
+}
+
+func (x *SetCategoryGoalParams) Reset() {
+	*x = SetCategoryGoalParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCategoryGoalParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryGoalParams) ProtoMessage() {}
+
+func (x *SetCategoryGoalParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryGoalParams.ProtoReflect.Descriptor instead.
+func (*SetCategoryGoalParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetCategoryGoalParams) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SetCategoryGoalParams) GetGoalAmount() *GrpcMoney {
+	if x != nil {
+		return x.GoalAmount
+	}
+	return nil
+}
+
+func (x *SetCategoryGoalParams) GetGoalDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GoalDate
+	}
+	return nil
+}
+
+type SetCategoryAutoResetParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryId int64 `protobuf:"varint,1,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	AutoReset  bool  `protobuf:"varint,2,opt,name=AutoReset,proto3" json:"AutoReset,omitempty"`
+}
+
+func (x *SetCategoryAutoResetParams) Reset() {
+	*x = SetCategoryAutoResetParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCategoryAutoResetParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryAutoResetParams) ProtoMessage() {}
+
+func (x *SetCategoryAutoResetParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryAutoResetParams.ProtoReflect.Descriptor instead.
+func (*SetCategoryAutoResetParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetCategoryAutoResetParams) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SetCategoryAutoResetParams) GetAutoReset() bool {
+	if x != nil {
+		return x.AutoReset
+	}
+	return false
+}
+
+type ResetCategoriesParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Catgories []*GrpcResetCategoryData `protobuf:"bytes,1,rep,name=catgories,proto3" json:"catgories,omitempty"`
+}
+
+func (x *ResetCategoriesParams) Reset() {
+	*x = ResetCategoriesParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetCategoriesParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCategoriesParams) ProtoMessage() {}
+
+func (x *ResetCategoriesParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCategoriesParams.ProtoReflect.Descriptor instead.
+func (*ResetCategoriesParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ResetCategoriesParams) GetCatgories() []*GrpcResetCategoryData {
+	if x != nil {
+		return x.Catgories
+	}
+	return nil
+}
+
+type GetExchangeRatesReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rates []*GrpcExchangeRate `protobuf:"bytes,1,rep,name=Rates,proto3" json:"Rates,omitempty"`
+}
+
+func (x *GetExchangeRatesReturns) Reset() {
+	*x = GetExchangeRatesReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExchangeRatesReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeRatesReturns) ProtoMessage() {}
+
+func (x *GetExchangeRatesReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExchangeRatesReturns.ProtoReflect.Descriptor instead.
+func (*GetExchangeRatesReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetExchangeRatesReturns) GetRates() []*GrpcExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SetExchangeRatesParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rates []*GrpcExchangeRate `protobuf:"bytes,1,rep,name=Rates,proto3" json:"Rates,omitempty"`
+}
+
+func (x *SetExchangeRatesParams) Reset() {
+	*x = SetExchangeRatesParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExchangeRatesParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRatesParams) ProtoMessage() {}
+
+func (x *SetExchangeRatesParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRatesParams.ProtoReflect.Descriptor instead.
+func (*SetExchangeRatesParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetExchangeRatesParams) GetRates() []*GrpcExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type DeleteExchangeRateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteExchangeRateParams) Reset() {
+	*x = DeleteExchangeRateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteExchangeRateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExchangeRateParams) ProtoMessage() {}
+
+func (x *DeleteExchangeRateParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExchangeRateParams.ProtoReflect.Descriptor instead.
+func (*DeleteExchangeRateParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteExchangeRateParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type SetBaseCurrencyParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string `protobuf:"bytes,1,opt,name=Currency,proto3" json:"Currency,omitempty"`
+	// Rate from the current base currency. The stored rate is used when empty
+	Rate string `protobuf:"bytes,2,opt,name=Rate,proto3" json:"Rate,omitempty"`
+}
+
+func (x *SetBaseCurrencyParams) Reset() {
+	*x = SetBaseCurrencyParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBaseCurrencyParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBaseCurrencyParams) ProtoMessage() {}
+
+func (x *SetBaseCurrencyParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBaseCurrencyParams.ProtoReflect.Descriptor instead.
+func (*SetBaseCurrencyParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetBaseCurrencyParams) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetBaseCurrencyParams) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+type GetCategoriesCountReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *GetCategoriesCountReturns) Reset() {
+	*x = GetCategoriesCountReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCategoriesCountReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesCountReturns) ProtoMessage() {}
+
+func (x *GetCategoriesCountReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesCountReturns.ProtoReflect.Descriptor instead.
+func (*GetCategoriesCountReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetCategoriesCountReturns) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetCategoriesReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories []*GrpcCategory `protobuf:"bytes,1,rep,name=Categories,proto3" json:"Categories,omitempty"`
+}
+
+func (x *GetCategoriesReturns) Reset() {
+	*x = GetCategoriesReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCategoriesReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesReturns) ProtoMessage() {}
+
+func (x *GetCategoriesReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesReturns.ProtoReflect.Descriptor instead.
+func (*GetCategoriesReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetCategoriesReturns) GetCategories() []*GrpcCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type GetCategoriesOverviewReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories []*GrpcCategoryOverview `protobuf:"bytes,1,rep,name=Categories,proto3" json:"Categories,omitempty"`
+}
+
+func (x *GetCategoriesOverviewReturns) Reset() {
+	*x = GetCategoriesOverviewReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCategoriesOverviewReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesOverviewReturns) ProtoMessage() {}
+
+func (x *GetCategoriesOverviewReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesOverviewReturns.ProtoReflect.Descriptor instead.
+func (*GetCategoriesOverviewReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetCategoriesOverviewReturns) GetCategories() []*GrpcCategoryOverview {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type GetTimePeriodsReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimePeriods []*GrpcTimePeriod `protobuf:"bytes,1,rep,name=TimePeriods,proto3" json:"TimePeriods,omitempty"`
+}
+
+func (x *GetTimePeriodsReturns) Reset() {
+	*x = GetTimePeriodsReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimePeriodsReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimePeriodsReturns) ProtoMessage() {}
+
+func (x *GetTimePeriodsReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimePeriodsReturns.ProtoReflect.Descriptor instead.
+func (*GetTimePeriodsReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetTimePeriodsReturns) GetTimePeriods() []*GrpcTimePeriod {
+	if x != nil {
+		return x.TimePeriods
+	}
+	return nil
+}
+
+type GetSessionsReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*GrpcSession `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
+}
+
+func (x *GetSessionsReturns) Reset() {
+	*x = GetSessionsReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionsReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionsReturns) ProtoMessage() {}
+
+func (x *GetSessionsReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionsReturns.ProtoReflect.Descriptor instead.
+func (*GetSessionsReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetSessionsReturns) GetSessions() []*GrpcSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *RevokeSessionParams) Reset() {
+	*x = RevokeSessionParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSessionParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionParams) ProtoMessage() {}
+
+func (x *RevokeSessionParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionParams.ProtoReflect.Descriptor instead.
+func (*RevokeSessionParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RevokeSessionParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type CreateSessionParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserKey       string `protobuf:"bytes,1,opt,name=UserKey,proto3" json:"UserKey,omitempty"`
+	Device        string `protobuf:"bytes,2,opt,name=Device,proto3" json:"Device,omitempty"`
+	RemoteAddress string `protobuf:"bytes,3,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+}
+
+func (x *CreateSessionParams) Reset() {
+	*x = CreateSessionParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSessionParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionParams) ProtoMessage() {}
+
+func (x *CreateSessionParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionParams.ProtoReflect.Descriptor instead.
+func (*CreateSessionParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateSessionParams) GetUserKey() string {
+	if x != nil {
+		return x.UserKey
+	}
+	return ""
+}
+
+func (x *CreateSessionParams) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CreateSessionParams) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+type ValidateSessionParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jti string `protobuf:"bytes,1,opt,name=Jti,proto3" json:"Jti,omitempty"`
+}
+
+func (x *ValidateSessionParams) Reset() {
+	*x = ValidateSessionParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateSessionParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSessionParams) ProtoMessage() {}
+
+func (x *ValidateSessionParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSessionParams.ProtoReflect.Descriptor instead.
+func (*ValidateSessionParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ValidateSessionParams) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+type RotateSessionParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=RefreshToken,proto3" json:"RefreshToken,omitempty"`
+}
+
+func (x *RotateSessionParams) Reset() {
+	*x = RotateSessionParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateSessionParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSessionParams) ProtoMessage() {}
+
+func (x *RotateSessionParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSessionParams.ProtoReflect.Descriptor instead.
+func (*RotateSessionParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RotateSessionParams) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type UserSessionsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserKey    string `protobuf:"bytes,1,opt,name=UserKey,proto3" json:"UserKey,omitempty"`
+	CurrentJti string `protobuf:"bytes,2,opt,name=CurrentJti,proto3" json:"CurrentJti,omitempty"`
+}
+
+func (x *UserSessionsParams) Reset() {
+	*x = UserSessionsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSessionsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSessionsParams) ProtoMessage() {}
+
+func (x *UserSessionsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSessionsParams.ProtoReflect.Descriptor instead.
+func (*UserSessionsParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UserSessionsParams) GetUserKey() string {
+	if x != nil {
+		return x.UserKey
+	}
+	return ""
+}
+
+func (x *UserSessionsParams) GetCurrentJti() string {
+	if x != nil {
+		return x.CurrentJti
+	}
+	return ""
+}
+
+type RevokeUserSessionParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserKey string `protobuf:"bytes,1,opt,name=UserKey,proto3" json:"UserKey,omitempty"`
+	ID      int64  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Jti     string `protobuf:"bytes,3,opt,name=Jti,proto3" json:"Jti,omitempty"`
+}
+
+func (x *RevokeUserSessionParams) Reset() {
+	*x = RevokeUserSessionParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeUserSessionParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionParams) ProtoMessage() {}
+
+func (x *RevokeUserSessionParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionParams.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RevokeUserSessionParams) GetUserKey() string {
+	if x != nil {
+		return x.UserKey
+	}
+	return ""
+}
+
+func (x *RevokeUserSessionParams) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RevokeUserSessionParams) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+// Login attempt checked against the throttles kept by the DB Controller
+type LoginAttemptParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserKey       string `protobuf:"bytes,1,opt,name=UserKey,proto3" json:"UserKey,omitempty"`
+	RemoteAddress string `protobuf:"bytes,2,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	Success       bool   `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
+}
+
+func (x *LoginAttemptParams) Reset() {
+	*x = LoginAttemptParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginAttemptParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginAttemptParams) ProtoMessage() {}
+
+func (x *LoginAttemptParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginAttemptParams.ProtoReflect.Descriptor instead.
+func (*LoginAttemptParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *LoginAttemptParams) GetUserKey() string {
+	if x != nil {
+		return x.UserKey
+	}
+	return ""
+}
+
+func (x *LoginAttemptParams) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *LoginAttemptParams) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Two-factor authentication
+type GrpcTwoFactor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled           bool  `protobuf:"varint,1,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	RecoveryCodesLeft int64 `protobuf:"varint,2,opt,name=RecoveryCodesLeft,proto3" json:"RecoveryCodesLeft,omitempty"`
+}
+
+func (x *GrpcTwoFactor) Reset() {
+	*x = GrpcTwoFactor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrpcTwoFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcTwoFactor) ProtoMessage() {}
+
+func (x *GrpcTwoFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcTwoFactor.ProtoReflect.Descriptor instead.
+func (*GrpcTwoFactor) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GrpcTwoFactor) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GrpcTwoFactor) GetRecoveryCodesLeft() int64 {
+	if x != nil {
+		return x.RecoveryCodesLeft
+	}
+	return 0
+}
+
+type BeginTwoFactorSetupReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base32 secret for manual entry
+	Secret string `protobuf:"bytes,1,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	// otpauth URI for the QR code
+	URI string `protobuf:"bytes,2,opt,name=URI,proto3" json:"URI,omitempty"`
+}
+
+func (x *BeginTwoFactorSetupReturns) Reset() {
+	*x = BeginTwoFactorSetupReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeginTwoFactorSetupReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTwoFactorSetupReturns) ProtoMessage() {}
+
+func (x *BeginTwoFactorSetupReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTwoFactorSetupReturns.ProtoReflect.Descriptor instead.
+func (*BeginTwoFactorSetupReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *BeginTwoFactorSetupReturns) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *BeginTwoFactorSetupReturns) GetURI() string {
+	if x != nil {
+		return x.URI
+	}
+	return ""
+}
+
+type TwoFactorCodeParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One-time password, or recovery code where accepted
+	Code string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+}
+
+func (x *TwoFactorCodeParams) Reset() {
+	*x = TwoFactorCodeParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwoFactorCodeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorCodeParams) ProtoMessage() {}
+
+func (x *TwoFactorCodeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorCodeParams.ProtoReflect.Descriptor instead.
+func (*TwoFactorCodeParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *TwoFactorCodeParams) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RecoveryCodesReturns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codes []string `protobuf:"bytes,1,rep,name=Codes,proto3" json:"Codes,omitempty"`
+}
+
+func (x *RecoveryCodesReturns) Reset() {
+	*x = RecoveryCodesReturns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryCodesReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryCodesReturns) ProtoMessage() {}
+
+func (x *RecoveryCodesReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryCodesReturns.ProtoReflect.Descriptor instead.
+func (*RecoveryCodesReturns) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RecoveryCodesReturns) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+// Settings
+type GrpcSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseCurrency string `protobuf:"bytes,1,opt,name=BaseCurrency,proto3" json:"BaseCurrency,omitempty"`
+	// Empty uses the default formats
+	Locale string `protobuf:"bytes,2,opt,name=Locale,proto3" json:"Locale,omitempty"`
+	// Sample of a grouped amount like 1,234.56. Empty uses the locale
+	NumberFormat string `protobuf:"bytes,3,opt,name=NumberFormat,proto3" json:"NumberFormat,omitempty"`
+	// Go time layout like 02.01.2006. Empty uses the locale
+	DateFormat string `protobuf:"bytes,4,opt,name=DateFormat,proto3" json:"DateFormat,omitempty"`
+	// Counts from Sunday as 0
+	FirstDayOfWeek int64 `protobuf:"varint,5,opt,name=FirstDayOfWeek,proto3" json:"FirstDayOfWeek,omitempty"`
+	// Zero when not set
+	DefaultAccountID  int64 `protobuf:"varint,6,opt,name=DefaultAccountID,proto3" json:"DefaultAccountID,omitempty"`
+	DefaultCategoryID int64 `protobuf:"varint,7,opt,name=DefaultCategoryID,proto3" json:"DefaultCategoryID,omitempty"`
+	// One of all, week, month, last_30_days and year
+	ExpenseRange string `protobuf:"bytes,8,opt,name=ExpenseRange,proto3" json:"ExpenseRange,omitempty"`
+}
+
+func (x *GrpcSettings) Reset() {
+	*x = GrpcSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrpcSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcSettings) ProtoMessage() {}
+
+func (x *GrpcSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcSettings.ProtoReflect.Descriptor instead.
+func (*GrpcSettings) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GrpcSettings) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *GrpcSettings) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GrpcSettings) GetNumberFormat() string {
+	if x != nil {
+		return x.NumberFormat
+	}
+	return ""
+}
+
+func (x *GrpcSettings) GetDateFormat() string {
+	if x != nil {
+		return x.DateFormat
+	}
+	return ""
+}
+
+func (x *GrpcSettings) GetFirstDayOfWeek() int64 {
+	if x != nil {
+		return x.FirstDayOfWeek
+	}
+	return 0
+}
+
+func (x *GrpcSettings) GetDefaultAccountID() int64 {
+	if x != nil {
+		return x.DefaultAccountID
+	}
+	return 0
+}
+
+func (x *GrpcSettings) GetDefaultCategoryID() int64 {
+	if x != nil {
+		return x.DefaultCategoryID
+	}
+	return 0
+}
+
+func (x *GrpcSettings) GetExpenseRange() string {
+	if x != nil {
+		return x.ExpenseRange
+	}
+	return ""
+}
+
+type UpdateSettingsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *GrpcSettings `protobuf:"bytes,1,opt,name=Settings,proto3" json:"Settings,omitempty"`
+	// Rate from the current base currency when it changes. The stored rate is used when empty
+	Rate string `protobuf:"bytes,2,opt,name=Rate,proto3" json:"Rate,omitempty"`
+}
+
+func (x *UpdateSettingsParams) Reset() {
+	*x = UpdateSettingsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSettingsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsParams) ProtoMessage() {}
+
+func (x *UpdateSettingsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsParams.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsParams) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdateSettingsParams) GetSettings() *GrpcSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateSettingsParams) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+type DBNodeData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             int64   `protobuf:"varint,7,opt,name=ID,proto3" json:"ID,omitempty"`
+	Address        string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TotalMemoryMB  float64 `protobuf:"fixed64,2,opt,name=totalMemoryMB,proto3" json:"totalMemoryMB,omitempty"`
+	FreeMemoryMB   float64 `protobuf:"fixed64,3,opt,name=freeMemoryMB,proto3" json:"freeMemoryMB,omitempty"`
+	TotalStorageMB float64 `protobuf:"fixed64,4,opt,name=totalStorageMB,proto3" json:"totalStorageMB,omitempty"`
+	FreeStorageMB  float64 `protobuf:"fixed64,5,opt,name=freeStorageMB,proto3" json:"freeStorageMB,omitempty"`
+	CpuLoadPercent float64 `protobuf:"fixed64,6,opt,name=cpuLoadPercent,proto3" json:"cpuLoadPercent,omitempty"`
+}
+
+func (x *DBNodeData) Reset() {
+	*x = DBNodeData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBNodeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBNodeData) ProtoMessage() {}
+
+func (x *DBNodeData) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBNodeData.ProtoReflect.Descriptor instead.
+func (*DBNodeData) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DBNodeData) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DBNodeData) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DBNodeData) GetTotalMemoryMB() float64 {
+	if x != nil {
+		return x.TotalMemoryMB
+	}
+	return 0
+}
+
+func (x *DBNodeData) GetFreeMemoryMB() float64 {
+	if x != nil {
+		return x.FreeMemoryMB
+	}
+	return 0
+}
+
+func (x *DBNodeData) GetTotalStorageMB() float64 {
+	if x != nil {
+		return x.TotalStorageMB
+	}
+	return 0
+}
+
+func (x *DBNodeData) GetFreeStorageMB() float64 {
+	if x != nil {
+		return x.FreeStorageMB
+	}
+	return 0
+}
+
+func (x *DBNodeData) GetCpuLoadPercent() float64 {
+	if x != nil {
+		return x.CpuLoadPercent
+	}
+	return 0
+}
+
+var File_models_proto protoreflect.FileDescriptor
+
+var file_models_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x21, 0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x22, 0x0b, 0x0a, 0x09, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x82, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x90, 0x01,
+	0x0a, 0x14, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x0e, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0x38, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa5, 0x02, 0x0a, 0x0b, 0x47,
+	0x72, 0x70, 0x63, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x4a, 0x74, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x4a, 0x74, 0x69, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x22, 0x3f, 0x0a, 0x09, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x22, 0xb7, 0x02, 0x0a, 0x08, 0x47, 0x72, 0x70, 0x63, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x42, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x44, 0x42, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x09, 0x46, 0x72, 0x65, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09,
+	0x46, 0x72, 0x65, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe6, 0x06, 0x0a,
+	0x0b, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x61, 0x67, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0b, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x72,
+	0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0c,
+	0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x52, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x06, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x06,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78,
+	0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x07, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x69,
+	0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x0f, 0x52, 0x65,
+	0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x0f, 0x52, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0b, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x50, 0x61, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x50, 0x61, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x79, 0x65,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x61, 0x79, 0x65, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70,
+	0x65, 0x6e, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9d,
+	0x02, 0x0a, 0x09, 0x47, 0x72, 0x70, 0x63, 0x50, 0x61, 0x79, 0x65, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x22, 0xc7,
+	0x02, 0x0a, 0x11, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a,
+	0x42, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x09,
+	0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x54,
+	0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x1c, 0x47, 0x72, 0x70,
+	0x63, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x69, 0x6d,
+	0x62, 0x75, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x22, 0x0a,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xa7, 0x03,
+	0x0a, 0x0a, 0x47, 0x72, 0x70, 0x63, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2a, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x61, 0x67, 0x52, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x47, 0x72, 0x70, 0x63,
+	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x06, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x03, 0x4e, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x4e, 0x65, 0x74, 0x22, 0xc5,
+	0x04, 0x0a, 0x0d, 0x47, 0x72, 0x70, 0x63, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x12, 0x24, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0d,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43,
+	0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x41, 0x0a, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x00, 0x52, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4c, 0x69, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x4e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x70, 0x63, 0x4c,
+	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x45,
+	0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x84, 0x02, 0x0a, 0x0e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x6e, 0x64, 0x6f, 0x4f, 0x66, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x55, 0x6e, 0x64, 0x6f, 0x4f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x6e,
+	0x64, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x55, 0x6e, 0x64, 0x6f,
+	0x6e, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb9, 0x01, 0x0a,
+	0x09, 0x47, 0x72, 0x70, 0x63, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xce, 0x02, 0x0a, 0x08, 0x47, 0x72, 0x70,
+	0x63, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x09, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a,
+	0x09, 0x4d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x4d, 0x61,
+	0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x57, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x53, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x53, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x07, 0x47, 0x72,
+	0x70, 0x63, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xe5, 0x01, 0x0a, 0x18, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65,
+	0x54, 0x6f, 0x54, 0x61, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54,
+	0x61, 0x67, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x61, 0x67, 0x49,
+	0x64, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd6, 0x02, 0x0a, 0x0b, 0x47, 0x72, 0x70,
+	0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0d,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x38,
+	0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xb1, 0x02, 0x0a, 0x10, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x46, 0x72,
+	0x6f, 0x6d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x54, 0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x38,
+	0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8f, 0x04, 0x0a, 0x0c, 0x47, 0x72, 0x70, 0x63, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x4c, 0x61, 0x73, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x4c, 0x61, 0x73,
+	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0d, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x0c,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x30, 0x0a, 0x0d,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x0d, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30,
+	0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x86, 0x07, 0x0a, 0x14, 0x47, 0x72, 0x70, 0x63,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x12, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x61, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x0a, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x2e, 0x0a, 0x0c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x66, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x0c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x66, 0x74,
+	0x12, 0x3c, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0d, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x43, 0x61, 0x6e, 0x42, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x43, 0x61, 0x6e, 0x42, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x26, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x41,
+	0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x44, 0x75, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x44, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x47, 0x6f, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x47, 0x6f, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x36, 0x0a, 0x08, 0x47, 0x6f, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x47, 0x6f, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x10, 0x47, 0x6f, 0x61, 0x6c,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x10,
+	0x47, 0x6f, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xcd, 0x02, 0x0a, 0x15, 0x47, 0x72, 0x70, 0x63, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70,
+	0x22, 0xd9, 0x01, 0x0a, 0x0e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43,
+	0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x3d, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x77, 0x0a, 0x15,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x72, 0x65, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x54,
+	0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x61,
+	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x61, 0x67, 0x52,
+	0x04, 0x54, 0x61, 0x67, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70,
+	0x65, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x08,
+	0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x45, 0x78,
+	0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x69,
+	0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x4f, 0x75, 0x74, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x8b, 0x01,
+	0x0a, 0x0e, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x26, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52,
+	0x07, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x06,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x45,
+	0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x79, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x61, 0x79, 0x65, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x22, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x22, 0x36, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x65, 0x65, 0x73, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x50, 0x61, 0x79, 0x65, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x50, 0x61, 0x79,
+	0x65, 0x65, 0x52, 0x06, 0x50, 0x61, 0x79, 0x65, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x0b, 0x50, 0x61,
+	0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x50, 0x61, 0x79,
+	0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x50,
+	0x61, 0x79, 0x65, 0x65, 0x52, 0x05, 0x50, 0x61, 0x79, 0x65, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0x32, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x52, 0x75, 0x6c,
+	0x65, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x55, 0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x0f,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x23, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22,
+	0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12,
+	0x29, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x6e,
+	0x64, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x6e, 0x64, 0x6f,
+	0x49, 0x64, 0x22, 0x29, 0x0a, 0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x46,
+	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x46, 0x69, 0x78, 0x22, 0x52, 0x0a,
+	0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x52, 0x06, 0x44, 0x72, 0x69, 0x66, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65,
+	0x52, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x25,
+	0x0a, 0x07, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x73, 0x22, 0x47, 0x0a, 0x0c, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52,
+	0x06, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x22, 0x85, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x4c,
+	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x0b, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x08, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x4c, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x04, 0x4c, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x08, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x26, 0x0a,
+	0x08, 0x4e, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x4e, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x41, 0x64, 0x64,
+	0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x2c, 0x0a, 0x09, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x09, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x27, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x88, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67,
+	0x73, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x41,
+	0x64, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22,
+	0x3b, 0x0a, 0x15, 0x45, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x25, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x49, 0x44, 0x22, 0xa9, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x46, 0x75, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x46,
+	0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b,
+	0x46, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x54,
+	0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x54, 0x6f,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x52,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x61, 0x74, 0x65, 0x22,
+	0x5c, 0x0a, 0x14, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x03,
+	0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a,
+	0x0b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x41, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x0a, 0x47, 0x6f, 0x61, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x47, 0x6f, 0x61, 0x6c, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x47, 0x6f, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x47, 0x6f, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x52,
+	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0a, 0x47, 0x6f, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x0a, 0x47, 0x6f, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36,
+	0x0a, 0x08, 0x47, 0x6f, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x47, 0x6f,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x22, 0x4d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x63,
+	0x61, 0x74, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x47, 0x72, 0x70, 0x63, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x63, 0x61, 0x74, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x42, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x05,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x27, 0x0a, 0x05, 0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x22, 0x47, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x61, 0x74, 0x65, 0x22, 0x31, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x45, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x4a,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0b, 0x54,
+	0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x12, 0x28, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x22, 0x6d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x29, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x4a, 0x74, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4a, 0x74, 0x69, 0x22, 0x39, 0x0a, 0x13, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4e, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x55,
+	0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4a, 0x74, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x4a, 0x74, 0x69, 0x22, 0x55, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x4a,
+	0x74, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4a, 0x74, 0x69, 0x22, 0x6e, 0x0a,
+	0x12, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a,
+	0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x57, 0x0a,
+	0x0d, 0x47, 0x72, 0x70, 0x63, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x46, 0x0a, 0x1a, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x55, 0x52, 0x49, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x49, 0x22, 0x29,
+	0x0a, 0x13, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x14, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xb4, 0x02, 0x0a, 0x0c, 0x47, 0x72, 0x70, 0x63,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x65,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x42, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x61,
+	0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x73,
+	0x74, 0x44, 0x61, 0x79, 0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x61, 0x79, 0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b,
+	0x12, 0x2a, 0x0a, 0x10, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x78,
+	0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x55,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x52, 0x61, 0x74, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x0a, 0x44, 0x42, 0x4e, 0x6f, 0x64, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x42, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x42,
+	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d,
+	0x42, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4d, 0x42, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x70, 0x75, 0x4c, 0x6f, 0x61,
+	0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x63, 0x70, 0x75, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x32, 0xb0,
+	0x1e, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x2e, 0x44, 0x42,
+	0x4e, 0x6f, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x0e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x2e, 0x44, 0x42, 0x4e, 0x6f, 0x64, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x38, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x11, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x0f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x38, 0x0a, 0x0d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x11, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x34, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x13, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x13,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x20, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x09, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x11, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x1a, 0x0b, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x23, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x0d, 0x2e, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0b, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x15, 0x2e, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x1a, 0x0b, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0a,
+	0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12,
+	0x31, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x35, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x72, 0x65, 0x65,
+	0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x46, 0x72,
+	0x65, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x13, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x0a, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x2e, 0x54, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x15,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x2e, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x17, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x15, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d,
+	0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a,
+	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x15, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x26, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x0a, 0x41, 0x64,
+	0x64, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x0f, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x45, 0x78, 0x70,
+	0x65, 0x6e, 0x73, 0x65, 0x12, 0x0f, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x31, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x45, 0x78, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a,
+	0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x12, 0x1a, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73,
+	0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x17, 0x53, 0x65, 0x74,
+	0x45, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x73,
+	0x65, 0x52, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x2a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x65, 0x65, 0x73, 0x12, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x79, 0x65, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x08,
+	0x41, 0x64, 0x64, 0x50, 0x61, 0x79, 0x65, 0x65, 0x12, 0x0c, 0x2e, 0x50, 0x61, 0x79, 0x65, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x25, 0x0a, 0x09, 0x45, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x65, 0x65, 0x12,
+	0x0c, 0x2e, 0x50, 0x61, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x61, 0x79, 0x65, 0x65, 0x12, 0x12, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x61, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x12, 0x22, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0b, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x0b, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x0b, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x1a, 0x10, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x0f, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x28, 0x01, 0x12, 0x3e, 0x0a, 0x12,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x10, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x17, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0e, 0x55, 0x6e, 0x64, 0x6f, 0x4c, 0x61, 0x73,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x42, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x16, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x17, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65,
+	0x73, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x12, 0x26, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0d,
+	0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0a, 0x45, 0x64, 0x69,
+	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0d, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x2f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x13, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x0c, 0x41, 0x64, 0x64,
+	0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x13, 0x2e, 0x41, 0x64, 0x64, 0x4c,
+	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a,
+	0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0f, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x3a, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x35, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x33, 0x0a, 0x0e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x37,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x17, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x73,
+	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x42, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4f, 0x76,
+	0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x12, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x16, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x53, 0x65,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x16, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x0a, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x0a, 0x2e,
+	0x47, 0x72, 0x70, 0x63, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x64, 0x69, 0x6d, 0x69, 0x74, 0x61, 0x72, 0x67, 0x72, 0x6f, 0x7a, 0x65, 0x76, 0x35, 0x2f, 0x65,
+	0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x2d, 0x67, 0x6f, 0x2d, 0x31, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_models_proto_rawDescOnce sync.Once
+	file_models_proto_rawDescData = file_models_proto_rawDesc
+)
+
+func file_models_proto_rawDescGZIP() []byte {
+	file_models_proto_rawDescOnce.Do(func() {
+		file_models_proto_rawDescData = protoimpl.X.CompressGZIP(file_models_proto_rawDescData)
+	})
+	return file_models_proto_rawDescData
+}
+
+var file_models_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
+var file_models_proto_goTypes = []interface{}{
+	(*SimpleMessage)(nil),                 // 0: SimpleMessage
+	(*GrpcEmpty)(nil),                     // 1: GrpcEmpty
+	(*LoginCredentials)(nil),              // 2: LoginCredentials
+	(*LoginToken)(nil),                    // 3: LoginToken
+	(*TwoFactorLoginParams)(nil),          // 4: TwoFactorLoginParams
+	(*LogoutParams)(nil),                  // 5: LogoutParams
+	(*RefreshTokenParams)(nil),            // 6: RefreshTokenParams
+	(*GrpcSession)(nil),                   // 7: GrpcSession
+	(*GrpcSessionToken)(nil),              // 8: GrpcSessionToken
+	(*GrpcMoney)(nil),                     // 9: GrpcMoney
+	(*GrpcUser)(nil),                      // 10: GrpcUser
+	(*GrpcExpense)(nil),                   // 11: GrpcExpense
+	(*GrpcAttachment)(nil),                // 12: GrpcAttachment
+	(*GrpcPayee)(nil),                     // 13: GrpcPayee
+	(*GrpcExpenseRefund)(nil),             // 14: GrpcExpenseRefund
+	(*GrpcOutstandingReimbursement)(nil),  // 15: GrpcOutstandingReimbursement
+	(*GrpcIncome)(nil),                    // 16: GrpcIncome
+	(*GrpcMonthlySummary)(nil),            // 17: GrpcMonthlySummary
+	(*GrpcLiability)(nil),                 // 18: GrpcLiability
+	(*GrpcLiabilityPayment)(nil),          // 19: GrpcLiabilityPayment
+	(*GrpcAuditEntry)(nil),                // 20: GrpcAuditEntry
+	(*GrpcDrift)(nil),                     // 21: GrpcDrift
+	(*GrpcRule)(nil),                      // 22: GrpcRule
+	(*GrpcTag)(nil),                       // 23: GrpcTag
+	(*GrpcExpenseToTagRealtion)(nil),      // 24: GrpcExpenseToTagRealtion
+	(*GrpcAccount)(nil),                   // 25: GrpcAccount
+	(*GrpcExchangeRate)(nil),              // 26: GrpcExchangeRate
+	(*GrpcCategory)(nil),                  // 27: GrpcCategory
+	(*GrpcCategoryOverview)(nil),          // 28: GrpcCategoryOverview
+	(*GrpcResetCategoryData)(nil),         // 29: GrpcResetCategoryData
+	(*GrpcTimePeriod)(nil),                // 30: GrpcTimePeriod
+	(*ModifyFreeFundsParams)(nil),         // 31: ModifyFreeFundsParams
+	(*GetTagsReturns)(nil),                // 32: GetTagsReturns
+	(*GetExpensesReturns)(nil),            // 33: GetExpensesReturns
+	(*ExpensesParams)(nil),                // 34: ExpensesParams
+	(*DeleteExpenseParams)(nil),           // 35: DeleteExpenseParams
+	(*ExpenseRefundParams)(nil),           // 36: ExpenseRefundParams
+	(*DeleteExpenseRefundParams)(nil),     // 37: DeleteExpenseRefundParams
+	(*GetPayeesReturns)(nil),              // 38: GetPayeesReturns
+	(*PayeeParams)(nil),                   // 39: PayeeParams
+	(*DeletePayeeParams)(nil),             // 40: DeletePayeeParams
+	(*GetRulesReturns)(nil),               // 41: GetRulesReturns
+	(*RuleParams)(nil),                    // 42: RuleParams
+	(*DeleteRuleParams)(nil),              // 43: DeleteRuleParams
+	(*TestRuleReturns)(nil),               // 44: TestRuleReturns
+	(*AttachmentChunk)(nil),               // 45: AttachmentChunk
+	(*GetAttachmentParams)(nil),           // 46: GetAttachmentParams
+	(*DeleteAttachmentParams)(nil),        // 47: DeleteAttachmentParams
+	(*GetAuditLogReturns)(nil),            // 48: GetAuditLogReturns
+	(*VerifyIntegrityParams)(nil),         // 49: VerifyIntegrityParams
+	(*VerifyIntegrityReturns)(nil),        // 50: VerifyIntegrityReturns
+	(*SetExpenseReimbursementParams)(nil), // 51: SetExpenseReimbursementParams
+	(*GetIncomesReturns)(nil),             // 52: GetIncomesReturns
+	(*IncomeParams)(nil),                  // 53: IncomeParams
+	(*DeleteIncomeParams)(nil),            // 54: DeleteIncomeParams
+	(*GetLiabilitiesReturns)(nil),         // 55: GetLiabilitiesReturns
+	(*AddLiabilityParams)(nil),            // 56: AddLiabilityParams
+	(*DeleteLiabilityParams)(nil),         // 57: DeleteLiabilityParams
+	(*LiabilityPaymentParams)(nil),        // 58: LiabilityPaymentParams
+	(*GetAccountsParams)(nil),             // 59: GetAccountsParams
+	(*GetAccountsReturns)(nil),            // 60: GetAccountsReturns
+	(*AddAccountParams)(nil),              // 61: AddAccountParams
+	(*EditAccountNameParams)(nil),         // 62: EditAccountNameParams
+	(*DeleteAccountParams)(nil),           // 63: DeleteAccountParams
+	(*TransferFundsParams)(nil),           // 64: TransferFundsParams
+	(*ReorderAccountParams)(nil),          // 65: ReorderAccountParams
+	(*AddCategoryParams)(nil),             // 66: AddCategoryParams
+	(*ReorderCategoryParams)(nil),         // 67: ReorderCategoryParams
+	(*DeleteCategoryParams)(nil),          // 68: DeleteCategoryParams
+	(*SetCategoryGoalParams)(nil),         // 69: SetCategoryGoalParams
+	(*SetCategoryAutoResetParams)(nil),    // 70: SetCategoryAutoResetParams
+	(*ResetCategoriesParams)(nil),         // 71: ResetCategoriesParams
+	(*GetExchangeRatesReturns)(nil),       // 72: GetExchangeRatesReturns
+	(*SetExchangeRatesParams)(nil),        // 73: SetExchangeRatesParams
+	(*DeleteExchangeRateParams)(nil),      // 74: DeleteExchangeRateParams
+	(*SetBaseCurrencyParams)(nil),         // 75: SetBaseCurrencyParams
+	(*GetCategoriesCountReturns)(nil),     // 76: GetCategoriesCountReturns
+	(*GetCategoriesReturns)(nil),          // 77: GetCategoriesReturns
+	(*GetCategoriesOverviewReturns)(nil),  // 78: GetCategoriesOverviewReturns
+	(*GetTimePeriodsReturns)(nil),         // 79: GetTimePeriodsReturns
+	(*GetSessionsReturns)(nil),            // 80: GetSessionsReturns
+	(*RevokeSessionParams)(nil),           // 81: RevokeSessionParams
+	(*CreateSessionParams)(nil),           // 82: CreateSessionParams
+	(*ValidateSessionParams)(nil),         // 83: ValidateSessionParams
+	(*RotateSessionParams)(nil),           // 84: RotateSessionParams
+	(*UserSessionsParams)(nil),            // 85: UserSessionsParams
+	(*RevokeUserSessionParams)(nil),       // 86: RevokeUserSessionParams
+	(*LoginAttemptParams)(nil),            // 87: LoginAttemptParams
+	(*GrpcTwoFactor)(nil),                 // 88: GrpcTwoFactor
+	(*BeginTwoFactorSetupReturns)(nil),    // 89: BeginTwoFactorSetupReturns
+	(*TwoFactorCodeParams)(nil),           // 90: TwoFactorCodeParams
+	(*RecoveryCodesReturns)(nil),          // 91: RecoveryCodesReturns
+	(*GrpcSettings)(nil),                  // 92: GrpcSettings
+	(*UpdateSettingsParams)(nil),          // 93: UpdateSettingsParams
+	(*DBNodeData)(nil),                    // 94: DBNodeData
+	(*timestamppb.Timestamp)(nil),         // 95: google.protobuf.Timestamp
+}
+var file_models_proto_depIdxs = []int32{
+	95,  // 0: LoginToken.expiresAt:type_name -> google.protobuf.Timestamp
+	95,  // 1: GrpcSession.LastSeenAt:type_name -> google.protobuf.Timestamp
+	95,  // 2: GrpcSession.ExpiresAt:type_name -> google.protobuf.Timestamp
+	95,  // 3: GrpcSession.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 4: GrpcSessionToken.ExpiresAt:type_name -> google.protobuf.Timestamp
+	9,   // 5: GrpcUser.FreeFunds:type_name -> GrpcMoney
+	95,  // 6: GrpcUser.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 7: GrpcUser.UpdatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 8: GrpcExpense.Amount:type_name -> GrpcMoney
+	95,  // 9: GrpcExpense.Date:type_name -> google.protobuf.Timestamp
+	23,  // 10: GrpcExpense.Tags:type_name -> GrpcTag
+	25,  // 11: GrpcExpense.FromAccount:type_name -> GrpcAccount
+	27,  // 12: GrpcExpense.FromCategory:type_name -> GrpcCategory
+	95,  // 13: GrpcExpense.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 14: GrpcExpense.UpdatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 15: GrpcExpense.BaseAmount:type_name -> GrpcMoney
+	11,  // 16: GrpcExpense.Splits:type_name -> GrpcExpense
+	14,  // 17: GrpcExpense.Refunds:type_name -> GrpcExpenseRefund
+	9,   // 18: GrpcExpense.ReimburseAmount:type_name -> GrpcMoney
+	9,   // 19: GrpcExpense.Outstanding:type_name -> GrpcMoney
+	12,  // 20: GrpcExpense.Attachments:type_name -> GrpcAttachment
+	95,  // 21: GrpcAttachment.CreatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 22: GrpcExpenseRefund.Amount:type_name -> GrpcMoney
+	9,   // 23: GrpcExpenseRefund.BaseAmount:type_name -> GrpcMoney
+	25,  // 24: GrpcExpenseRefund.ToAccount:type_name -> GrpcAccount
+	95,  // 25: GrpcExpenseRefund.Date:type_name -> google.protobuf.Timestamp
+	9,   // 26: GrpcOutstandingReimbursement.Amount:type_name -> GrpcMoney
+	9,   // 27: GrpcIncome.Amount:type_name -> GrpcMoney
+	9,   // 28: GrpcIncome.BaseAmount:type_name -> GrpcMoney
+	95,  // 29: GrpcIncome.Date:type_name -> google.protobuf.Timestamp
+	23,  // 30: GrpcIncome.Tags:type_name -> GrpcTag
+	25,  // 31: GrpcIncome.ToAccount:type_name -> GrpcAccount
+	95,  // 32: GrpcIncome.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 33: GrpcIncome.UpdatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 34: GrpcMonthlySummary.Income:type_name -> GrpcMoney
+	9,   // 35: GrpcMonthlySummary.Expenses:type_name -> GrpcMoney
+	9,   // 36: GrpcMonthlySummary.Net:type_name -> GrpcMoney
+	9,   // 37: GrpcLiability.Principal:type_name -> GrpcMoney
+	9,   // 38: GrpcLiability.Balance:type_name -> GrpcMoney
+	9,   // 39: GrpcLiability.PaymentAmount:type_name -> GrpcMoney
+	95,  // 40: GrpcLiability.StartDate:type_name -> google.protobuf.Timestamp
+	95,  // 41: GrpcLiability.NextPayment:type_name -> google.protobuf.Timestamp
+	19,  // 42: GrpcLiability.Payments:type_name -> GrpcLiabilityPayment
+	9,   // 43: GrpcLiabilityPayment.Amount:type_name -> GrpcMoney
+	9,   // 44: GrpcLiabilityPayment.Interest:type_name -> GrpcMoney
+	95,  // 45: GrpcLiabilityPayment.Date:type_name -> google.protobuf.Timestamp
+	95,  // 46: GrpcAuditEntry.CreatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 47: GrpcRule.MinAmount:type_name -> GrpcMoney
+	9,   // 48: GrpcRule.MaxAmount:type_name -> GrpcMoney
+	95,  // 49: GrpcTag.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 50: GrpcTag.UpdatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 51: GrpcExpenseToTagRealtion.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 52: GrpcExpenseToTagRealtion.UpdatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 53: GrpcAccount.CurrentAmount:type_name -> GrpcMoney
+	95,  // 54: GrpcAccount.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 55: GrpcAccount.UpdatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 56: GrpcAccount.BaseAmount:type_name -> GrpcMoney
+	95,  // 57: GrpcExchangeRate.Date:type_name -> google.protobuf.Timestamp
+	95,  // 58: GrpcExchangeRate.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 59: GrpcExchangeRate.UpdatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 60: GrpcCategory.BudgetInput:type_name -> GrpcMoney
+	95,  // 61: GrpcCategory.LastInputDate:type_name -> google.protobuf.Timestamp
+	9,   // 62: GrpcCategory.SpendingLimit:type_name -> GrpcMoney
+	9,   // 63: GrpcCategory.SpendingLeft:type_name -> GrpcMoney
+	9,   // 64: GrpcCategory.InitialAmount:type_name -> GrpcMoney
+	9,   // 65: GrpcCategory.CurrentAmount:type_name -> GrpcMoney
+	95,  // 66: GrpcCategory.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 67: GrpcCategory.UpdatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 68: GrpcCategoryOverview.BudgetInput:type_name -> GrpcMoney
+	9,   // 69: GrpcCategoryOverview.SpendingLimit:type_name -> GrpcMoney
+	9,   // 70: GrpcCategoryOverview.SpendingLeft:type_name -> GrpcMoney
+	95,  // 71: GrpcCategoryOverview.PeriodStart:type_name -> google.protobuf.Timestamp
+	95,  // 72: GrpcCategoryOverview.PeriodEnd:type_name -> google.protobuf.Timestamp
+	9,   // 73: GrpcCategoryOverview.InitialAmount:type_name -> GrpcMoney
+	9,   // 74: GrpcCategoryOverview.CurrentAmount:type_name -> GrpcMoney
+	9,   // 75: GrpcCategoryOverview.GoalAmount:type_name -> GrpcMoney
+	95,  // 76: GrpcCategoryOverview.GoalDate:type_name -> google.protobuf.Timestamp
+	9,   // 77: GrpcCategoryOverview.GoalContribution:type_name -> GrpcMoney
+	9,   // 78: GrpcResetCategoryData.Amount:type_name -> GrpcMoney
+	9,   // 79: GrpcResetCategoryData.BudgetInput:type_name -> GrpcMoney
+	9,   // 80: GrpcResetCategoryData.SpendingLimit:type_name -> GrpcMoney
+	95,  // 81: GrpcTimePeriod.CreatedAt:type_name -> google.protobuf.Timestamp
+	95,  // 82: GrpcTimePeriod.UpdatedAt:type_name -> google.protobuf.Timestamp
+	9,   // 83: ModifyFreeFundsParams.Amount:type_name -> GrpcMoney
+	23,  // 84: GetTagsReturns.Tags:type_name -> GrpcTag
+	11,  // 85: GetExpensesReturns.Expenses:type_name -> GrpcExpense
+	15,  // 86: GetExpensesReturns.Outstanding:type_name -> GrpcOutstandingReimbursement
+	11,  // 87: ExpensesParams.Expense:type_name -> GrpcExpense
+	34,  // 88: ExpensesParams.Splits:type_name -> ExpensesParams
+	14,  // 89: ExpenseRefundParams.Refund:type_name -> GrpcExpenseRefund
+	13,  // 90: GetPayeesReturns.Payees:type_name -> GrpcPayee
+	13,  // 91: PayeeParams.Payee:type_name -> GrpcPayee
+	22,  // 92: GetRulesReturns.Rules:type_name -> GrpcRule
+	22,  // 93: RuleParams.Rule:type_name -> GrpcRule
+	11,  // 94: TestRuleReturns.Expenses:type_name -> GrpcExpense
+	12,  // 95: AttachmentChunk.Info:type_name -> GrpcAttachment
+	20,  // 96: GetAuditLogReturns.Entries:type_name -> GrpcAuditEntry
+	21,  // 97: VerifyIntegrityReturns.Drifts:type_name -> GrpcDrift
+	9,   // 98: SetExpenseReimbursementParams.Amount:type_name -> GrpcMoney
+	16,  // 99: GetIncomesReturns.Incomes:type_name -> GrpcIncome
+	17,  // 100: GetIncomesReturns.Months:type_name -> GrpcMonthlySummary
+	16,  // 101: IncomeParams.Income:type_name -> GrpcIncome
+	18,  // 102: GetLiabilitiesReturns.Liabilities:type_name -> GrpcLiability
+	9,   // 103: GetLiabilitiesReturns.Accounts:type_name -> GrpcMoney
+	9,   // 104: GetLiabilitiesReturns.Lent:type_name -> GrpcMoney
+	9,   // 105: GetLiabilitiesReturns.Borrowed:type_name -> GrpcMoney
+	9,   // 106: GetLiabilitiesReturns.NetWorth:type_name -> GrpcMoney
+	18,  // 107: AddLiabilityParams.Liability:type_name -> GrpcLiability
+	9,   // 108: LiabilityPaymentParams.Amount:type_name -> GrpcMoney
+	9,   // 109: LiabilityPaymentParams.Interest:type_name -> GrpcMoney
+	95,  // 110: LiabilityPaymentParams.Date:type_name -> google.protobuf.Timestamp
+	25,  // 111: GetAccountsReturns.Accounts:type_name -> GrpcAccount
+	9,   // 112: GetAccountsReturns.Total:type_name -> GrpcMoney
+	25,  // 113: TransferFundsParams.FromAccount:type_name -> GrpcAccount
+	25,  // 114: TransferFundsParams.ToAccount:type_name -> GrpcAccount
+	9,   // 115: TransferFundsParams.Amount:type_name -> GrpcMoney
+	25,  // 116: ReorderAccountParams.Account:type_name -> GrpcAccount
+	9,   // 117: AddCategoryParams.BudgetInput:type_name -> GrpcMoney
+	9,   // 118: AddCategoryParams.SpendingLimit:type_name -> GrpcMoney
+	9,   // 119: AddCategoryParams.GoalAmount:type_name -> GrpcMoney
+	95,  // 120: AddCategoryParams.GoalDate:type_name -> google.protobuf.Timestamp
+	9,   // 121: SetCategoryGoalParams.GoalAmount:type_name -> GrpcMoney
+	95,  // 122: SetCategoryGoalParams.GoalDate:type_name -> google.protobuf.Timestamp
+	29,  // 123: ResetCategoriesParams.catgories:type_name -> GrpcResetCategoryData
+	26,  // 124: GetExchangeRatesReturns.Rates:type_name -> GrpcExchangeRate
+	26,  // 125: SetExchangeRatesParams.Rates:type_name -> GrpcExchangeRate
+	27,  // 126: GetCategoriesReturns.Categories:type_name -> GrpcCategory
+	28,  // 127: GetCategoriesOverviewReturns.Categories:type_name -> GrpcCategoryOverview
+	30,  // 128: GetTimePeriodsReturns.TimePeriods:type_name -> GrpcTimePeriod
+	7,   // 129: GetSessionsReturns.Sessions:type_name -> GrpcSession
+	92,  // 130: UpdateSettingsParams.Settings:type_name -> GrpcSettings
+	94,  // 131: Database.RegisterNode:input_type -> DBNodeData
+	94,  // 132: Database.DeregisterNode:input_type -> DBNodeData
+	82,  // 133: Database.CreateSession:input_type -> CreateSessionParams
+	83,  // 134: Database.ValidateSession:input_type -> ValidateSessionParams
+	84,  // 135: Database.RotateSession:input_type -> RotateSessionParams
+	85,  // 136: Database.GetUserSessions:input_type -> UserSessionsParams
+	86,  // 137: Database.RevokeUserSession:input_type -> RevokeUserSessionParams
+	87,  // 138: Database.CheckLoginAttempt:input_type -> LoginAttemptParams
+	87,  // 139: Database.RecordLoginAttempt:input_type -> LoginAttemptParams
+	1,   // 140: Database.GetUser:input_type -> GrpcEmpty
+	2,   // 141: Database.Authenticate:input_type -> LoginCredentials
+	5,   // 142: Database.Logout:input_type -> LogoutParams
+	6,   // 143: Database.RefreshToken:input_type -> RefreshTokenParams
+	4,   // 144: Database.VerifyTwoFactor:input_type -> TwoFactorLoginParams
+	1,   // 145: Database.GetSessions:input_type -> GrpcEmpty
+	81,  // 146: Database.RevokeSession:input_type -> RevokeSessionParams
+	31,  // 147: Database.ModifyFreeFunds:input_type -> ModifyFreeFundsParams
+	1,   // 148: Database.GetTwoFactor:input_type -> GrpcEmpty
+	1,   // 149: Database.BeginTwoFactorSetup:input_type -> GrpcEmpty
+	90,  // 150: Database.EnableTwoFactor:input_type -> TwoFactorCodeParams
+	90,  // 151: Database.DisableTwoFactor:input_type -> TwoFactorCodeParams
+	90,  // 152: Database.RegenerateRecoveryCodes:input_type -> TwoFactorCodeParams
+	1,   // 153: Database.GetSettings:input_type -> GrpcEmpty
+	93,  // 154: Database.UpdateSettings:input_type -> UpdateSettingsParams
+	1,   // 155: Database.GetTags:input_type -> GrpcEmpty
+	1,   // 156: Database.GetExpenses:input_type -> GrpcEmpty
+	34,  // 157: Database.AddExpense:input_type -> ExpensesParams
+	34,  // 158: Database.EditExpense:input_type -> ExpensesParams
+	35,  // 159: Database.DeleteExpense:input_type -> DeleteExpenseParams
+	36,  // 160: Database.AddExpenseRefund:input_type -> ExpenseRefundParams
+	37,  // 161: Database.DeleteExpenseRefund:input_type -> DeleteExpenseRefundParams
+	51,  // 162: Database.SetExpenseReimbursement:input_type -> SetExpenseReimbursementParams
+	1,   // 163: Database.GetPayees:input_type -> GrpcEmpty
+	39,  // 164: Database.AddPayee:input_type -> PayeeParams
+	39,  // 165: Database.EditPayee:input_type -> PayeeParams
+	40,  // 166: Database.DeletePayee:input_type -> DeletePayeeParams
+	1,   // 167: Database.GetRules:input_type -> GrpcEmpty
+	42,  // 168: Database.AddRule:input_type -> RuleParams
+	42,  // 169: Database.EditRule:input_type -> RuleParams
+	43,  // 170: Database.DeleteRule:input_type -> DeleteRuleParams
+	42,  // 171: Database.TestRule:input_type -> RuleParams
+	45,  // 172: Database.UploadAttachment:input_type -> AttachmentChunk
+	46,  // 173: Database.DownloadAttachment:input_type -> GetAttachmentParams
+	47,  // 174: Database.DeleteAttachment:input_type -> DeleteAttachmentParams
+	1,   // 175: Database.GetAuditLog:input_type -> GrpcEmpty
+	1,   // 176: Database.UndoLastAction:input_type -> GrpcEmpty
+	49,  // 177: Database.VerifyIntegrity:input_type -> VerifyIntegrityParams
+	1,   // 178: Database.GetIncomes:input_type -> GrpcEmpty
+	53,  // 179: Database.AddIncome:input_type -> IncomeParams
+	53,  // 180: Database.EditIncome:input_type -> IncomeParams
+	54,  // 181: Database.DeleteIncome:input_type -> DeleteIncomeParams
+	1,   // 182: Database.GetLiabilities:input_type -> GrpcEmpty
+	56,  // 183: Database.AddLiability:input_type -> AddLiabilityParams
+	57,  // 184: Database.DeleteLiability:input_type -> DeleteLiabilityParams
+	58,  // 185: Database.AddLiabilityPayment:input_type -> LiabilityPaymentParams
+	59,  // 186: Database.GetAccounts:input_type -> GetAccountsParams
+	61,  // 187: Database.AddAccount:input_type -> AddAccountParams
+	62,  // 188: Database.EditAccountName:input_type -> EditAccountNameParams
+	63,  // 189: Database.DeleteAccount:input_type -> DeleteAccountParams
+	64,  // 190: Database.TransferFunds:input_type -> TransferFundsParams
+	65,  // 191: Database.ReorderAccount:input_type -> ReorderAccountParams
+	1,   // 192: Database.GetExchangeRates:input_type -> GrpcEmpty
+	73,  // 193: Database.SetExchangeRates:input_type -> SetExchangeRatesParams
+	74,  // 194: Database.DeleteExchangeRate:input_type -> DeleteExchangeRateParams
+	75,  // 195: Database.SetBaseCurrency:input_type -> SetBaseCurrencyParams
+	1,   // 196: Database.GetCategoriesCount:input_type -> GrpcEmpty
+	1,   // 197: Database.GetCategories:input_type -> GrpcEmpty
+	1,   // 198: Database.GetCategoriesOverview:input_type -> GrpcEmpty
+	66,  // 199: Database.AddCategory:input_type -> AddCategoryParams
+	67,  // 200: Database.ReorderCategory:input_type -> ReorderCategoryParams
+	68,  // 201: Database.DeleteCategory:input_type -> DeleteCategoryParams
+	71,  // 202: Database.ResetCategories:input_type -> ResetCategoriesParams
+	70,  // 203: Database.SetCategoryAutoReset:input_type -> SetCategoryAutoResetParams
+	69,  // 204: Database.SetCategoryGoal:input_type -> SetCategoryGoalParams
+	1,   // 205: Database.GetTimePeriods:input_type -> GrpcEmpty
+	1,   // 206: Database.RegisterNode:output_type -> GrpcEmpty
+	1,   // 207: Database.DeregisterNode:output_type -> GrpcEmpty
+	8,   // 208: Database.CreateSession:output_type -> GrpcSessionToken
+	1,   // 209: Database.ValidateSession:output_type -> GrpcEmpty
+	8,   // 210: Database.RotateSession:output_type -> GrpcSessionToken
+	80,  // 211: Database.GetUserSessions:output_type -> GetSessionsReturns
+	1,   // 212: Database.RevokeUserSession:output_type -> GrpcEmpty
+	1,   // 213: Database.CheckLoginAttempt:output_type -> GrpcEmpty
+	1,   // 214: Database.RecordLoginAttempt:output_type -> GrpcEmpty
+	10,  // 215: Database.GetUser:output_type -> GrpcUser
+	3,   // 216: Database.Authenticate:output_type -> LoginToken
+	1,   // 217: Database.Logout:output_type -> GrpcEmpty
+	3,   // 218: Database.RefreshToken:output_type -> LoginToken
+	3,   // 219: Database.VerifyTwoFactor:output_type -> LoginToken
+	80,  // 220: Database.GetSessions:output_type -> GetSessionsReturns
+	1,   // 221: Database.RevokeSession:output_type -> GrpcEmpty
+	1,   // 222: Database.ModifyFreeFunds:output_type -> GrpcEmpty
+	88,  // 223: Database.GetTwoFactor:output_type -> GrpcTwoFactor
+	89,  // 224: Database.BeginTwoFactorSetup:output_type -> BeginTwoFactorSetupReturns
+	91,  // 225: Database.EnableTwoFactor:output_type -> RecoveryCodesReturns
+	1,   // 226: Database.DisableTwoFactor:output_type -> GrpcEmpty
+	91,  // 227: Database.RegenerateRecoveryCodes:output_type -> RecoveryCodesReturns
+	92,  // 228: Database.GetSettings:output_type -> GrpcSettings
+	1,   // 229: Database.UpdateSettings:output_type -> GrpcEmpty
+	32,  // 230: Database.GetTags:output_type -> GetTagsReturns
+	33,  // 231: Database.GetExpenses:output_type -> GetExpensesReturns
+	1,   // 232: Database.AddExpense:output_type -> GrpcEmpty
+	1,   // 233: Database.EditExpense:output_type -> GrpcEmpty
+	1,   // 234: Database.DeleteExpense:output_type -> GrpcEmpty
+	1,   // 235: Database.AddExpenseRefund:output_type -> GrpcEmpty
+	1,   // 236: Database.DeleteExpenseRefund:output_type -> GrpcEmpty
+	1,   // 237: Database.SetExpenseReimbursement:output_type -> GrpcEmpty
+	38,  // 238: Database.GetPayees:output_type -> GetPayeesReturns
+	1,   // 239: Database.AddPayee:output_type -> GrpcEmpty
+	1,   // 240: Database.EditPayee:output_type -> GrpcEmpty
+	1,   // 241: Database.DeletePayee:output_type -> GrpcEmpty
+	41,  // 242: Database.GetRules:output_type -> GetRulesReturns
+	1,   // 243: Database.AddRule:output_type -> GrpcEmpty
+	1,   // 244: Database.EditRule:output_type -> GrpcEmpty
+	1,   // 245: Database.DeleteRule:output_type -> GrpcEmpty
+	44,  // 246: Database.TestRule:output_type -> TestRuleReturns
+	12,  // 247: Database.UploadAttachment:output_type -> GrpcAttachment
+	45,  // 248: Database.DownloadAttachment:output_type -> AttachmentChunk
+	1,   // 249: Database.DeleteAttachment:output_type -> GrpcEmpty
+	48,  // 250: Database.GetAuditLog:output_type -> GetAuditLogReturns
+	1,   // 251: Database.UndoLastAction:output_type -> GrpcEmpty
+	50,  // 252: Database.VerifyIntegrity:output_type -> VerifyIntegrityReturns
+	52,  // 253: Database.GetIncomes:output_type -> GetIncomesReturns
+	1,   // 254: Database.AddIncome:output_type -> GrpcEmpty
+	1,   // 255: Database.EditIncome:output_type -> GrpcEmpty
+	1,   // 256: Database.DeleteIncome:output_type -> GrpcEmpty
+	55,  // 257: Database.GetLiabilities:output_type -> GetLiabilitiesReturns
+	1,   // 258: Database.AddLiability:output_type -> GrpcEmpty
+	1,   // 259: Database.DeleteLiability:output_type -> GrpcEmpty
+	1,   // 260: Database.AddLiabilityPayment:output_type -> GrpcEmpty
+	60,  // 261: Database.GetAccounts:output_type -> GetAccountsReturns
+	1,   // 262: Database.AddAccount:output_type -> GrpcEmpty
+	1,   // 263: Database.EditAccountName:output_type -> GrpcEmpty
+	1,   // 264: Database.DeleteAccount:output_type -> GrpcEmpty
+	1,   // 265: Database.TransferFunds:output_type -> GrpcEmpty
+	1,   // 266: Database.ReorderAccount:output_type -> GrpcEmpty
+	72,  // 267: Database.GetExchangeRates:output_type -> GetExchangeRatesReturns
+	1,   // 268: Database.SetExchangeRates:output_type -> GrpcEmpty
+	1,   // 269: Database.DeleteExchangeRate:output_type -> GrpcEmpty
+	1,   // 270: Database.SetBaseCurrency:output_type -> GrpcEmpty
+	76,  // 271: Database.GetCategoriesCount:output_type -> GetCategoriesCountReturns
+	77,  // 272: Database.GetCategories:output_type -> GetCategoriesReturns
+	78,  // 273: Database.GetCategoriesOverview:output_type -> GetCategoriesOverviewReturns
+	1,   // 274: Database.AddCategory:output_type -> GrpcEmpty
+	1,   // 275: Database.ReorderCategory:output_type -> GrpcEmpty
+	1,   // 276: Database.DeleteCategory:output_type -> GrpcEmpty
+	1,   // 277: Database.ResetCategories:output_type -> GrpcEmpty
+	1,   // 278: Database.SetCategoryAutoReset:output_type -> GrpcEmpty
+	1,   // 279: Database.SetCategoryGoal:output_type -> GrpcEmpty
+	79,  // 280: Database.GetTimePeriods:output_type -> GetTimePeriodsReturns
+	206, // [206:281] is the sub-list for method output_type
+	131, // [131:206] is the sub-list for method input_type
+	131, // [131:131] is the sub-list for extension type_name
+	131, // [131:131] is the sub-list for extension extendee
+	0,   // [0:131] is the sub-list for field type_name
+}
+
+func init() { file_models_proto_init() }
+func file_models_proto_init() {
+	if File_models_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_models_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimpleMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcEmpty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginCredentials); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwoFactorLoginParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcSessionToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcMoney); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcExpense); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcAttachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcPayee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcExpenseRefund); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcOutstandingReimbursement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcIncome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcMonthlySummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcLiability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcLiabilityPayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcAuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcTag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcExpenseToTagRealtion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcExchangeRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcCategoryOverview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcResetCategoryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcTimePeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyFreeFundsParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagsReturns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExpensesReturns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpensesParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExpenseParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpenseRefundParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExpenseRefundParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayeesReturns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayeeParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePayeeParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2848,8 +8692,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcEmpty); i {
+		file_models_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRulesReturns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2860,8 +8704,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginCredentials); i {
+		file_models_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2872,8 +8716,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginToken); i {
+		file_models_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRuleParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2884,8 +8728,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogoutParams); i {
+		file_models_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRuleReturns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2896,8 +8740,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcUser); i {
+		file_models_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2908,8 +8752,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcExpense); i {
+		file_models_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttachmentParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2920,8 +8764,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcTag); i {
+		file_models_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAttachmentParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2932,8 +8776,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcExpenseToTagRealtion); i {
+		file_models_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogReturns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2944,8 +8788,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcAccount); i {
+		file_models_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyIntegrityParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2956,8 +8800,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcCategory); i {
+		file_models_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyIntegrityReturns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2968,8 +8812,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcCategoryOverview); i {
+		file_models_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExpenseReimbursementParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2980,8 +8824,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcResetCategoryData); i {
+		file_models_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIncomesReturns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2992,8 +8836,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcTimePeriod); i {
+		file_models_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncomeParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3004,8 +8848,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyFreeFundsParams); i {
+		file_models_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteIncomeParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3016,8 +8860,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTagsReturns); i {
+		file_models_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLiabilitiesReturns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3028,8 +8872,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExpensesReturns); i {
+		file_models_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLiabilityParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3040,8 +8884,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpensesParams); i {
+		file_models_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLiabilityParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3052,8 +8896,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteExpenseParams); i {
+		file_models_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiabilityPaymentParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3064,7 +8908,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAccountsParams); i {
 			case 0:
 				return &v.state
@@ -3076,7 +8920,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAccountsReturns); i {
 			case 0:
 				return &v.state
@@ -3088,7 +8932,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddAccountParams); i {
 			case 0:
 				return &v.state
@@ -3100,7 +8944,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EditAccountNameParams); i {
 			case 0:
 				return &v.state
@@ -3112,7 +8956,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteAccountParams); i {
 			case 0:
 				return &v.state
@@ -3124,7 +8968,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransferFundsParams); i {
 			case 0:
 				return &v.state
@@ -3136,7 +8980,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReorderAccountParams); i {
 			case 0:
 				return &v.state
@@ -3148,7 +8992,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddCategoryParams); i {
 			case 0:
 				return &v.state
@@ -3160,7 +9004,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReorderCategoryParams); i {
 			case 0:
 				return &v.state
@@ -3172,7 +9016,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteCategoryParams); i {
 			case 0:
 				return &v.state
@@ -3184,7 +9028,31 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCategoryGoalParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCategoryAutoResetParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetCategoriesParams); i {
 			case 0:
 				return &v.state
@@ -3196,7 +9064,55 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExchangeRatesReturns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExchangeRatesParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExchangeRateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBaseCurrencyParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCategoriesCountReturns); i {
 			case 0:
 				return &v.state
@@ -3208,7 +9124,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCategoriesReturns); i {
 			case 0:
 				return &v.state
@@ -3220,7 +9136,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCategoriesOverviewReturns); i {
 			case 0:
 				return &v.state
@@ -3232,7 +9148,7 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTimePeriodsReturns); i {
 			case 0:
 				return &v.state
@@ -3244,7 +9160,175 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionsReturns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeSessionParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSessionParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateSessionParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateSessionParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSessionsParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeUserSessionParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginAttemptParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcTwoFactor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginTwoFactorSetupReturns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwoFactorCodeParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryCodesReturns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSettingsParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DBNodeData); i {
 			case 0:
 				return &v.state
@@ -3257,20 +9341,23 @@ func file_models_proto_init() {
 			}
 		}
 	}
-	file_models_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_models_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_models_proto_msgTypes[7].OneofWrappers = []interface{}{}
-	file_models_proto_msgTypes[8].OneofWrappers = []interface{}{}
-	file_models_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	file_models_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_models_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_models_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_models_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_models_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_models_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_models_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_models_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_models_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_models_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_models_proto_msgTypes[30].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_models_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   35,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
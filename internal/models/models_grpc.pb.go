@@ -24,11 +24,34 @@ const _ = grpc.SupportPackageIsVersion7
 type DatabaseClient interface {
 	// Register DB Node
 	RegisterNode(ctx context.Context, in *DBNodeData, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	DeregisterNode(ctx context.Context, in *DBNodeData, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	// Session registry kept by the DB Controller
+	CreateSession(ctx context.Context, in *CreateSessionParams, opts ...grpc.CallOption) (*GrpcSessionToken, error)
+	ValidateSession(ctx context.Context, in *ValidateSessionParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	RotateSession(ctx context.Context, in *RotateSessionParams, opts ...grpc.CallOption) (*GrpcSessionToken, error)
+	GetUserSessions(ctx context.Context, in *UserSessionsParams, opts ...grpc.CallOption) (*GetSessionsReturns, error)
+	RevokeUserSession(ctx context.Context, in *RevokeUserSessionParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	// Login throttling kept by the DB Controller
+	CheckLoginAttempt(ctx context.Context, in *LoginAttemptParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	RecordLoginAttempt(ctx context.Context, in *LoginAttemptParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	// User
 	GetUser(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GrpcUser, error)
 	Authenticate(ctx context.Context, in *LoginCredentials, opts ...grpc.CallOption) (*LoginToken, error)
 	Logout(ctx context.Context, in *LogoutParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenParams, opts ...grpc.CallOption) (*LoginToken, error)
+	VerifyTwoFactor(ctx context.Context, in *TwoFactorLoginParams, opts ...grpc.CallOption) (*LoginToken, error)
+	GetSessions(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetSessionsReturns, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	ModifyFreeFunds(ctx context.Context, in *ModifyFreeFundsParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	// Two-factor methods
+	GetTwoFactor(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GrpcTwoFactor, error)
+	BeginTwoFactorSetup(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*BeginTwoFactorSetupReturns, error)
+	EnableTwoFactor(ctx context.Context, in *TwoFactorCodeParams, opts ...grpc.CallOption) (*RecoveryCodesReturns, error)
+	DisableTwoFactor(ctx context.Context, in *TwoFactorCodeParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *TwoFactorCodeParams, opts ...grpc.CallOption) (*RecoveryCodesReturns, error)
+	// Settings methods
+	GetSettings(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GrpcSettings, error)
+	UpdateSettings(ctx context.Context, in *UpdateSettingsParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	// Tags methods
 	GetTags(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetTagsReturns, error)
 	// Expenses methods
@@ -36,6 +59,39 @@ type DatabaseClient interface {
 	AddExpense(ctx context.Context, in *ExpensesParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	EditExpense(ctx context.Context, in *ExpensesParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	DeleteExpense(ctx context.Context, in *DeleteExpenseParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	AddExpenseRefund(ctx context.Context, in *ExpenseRefundParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	DeleteExpenseRefund(ctx context.Context, in *DeleteExpenseRefundParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	SetExpenseReimbursement(ctx context.Context, in *SetExpenseReimbursementParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	// Payees methods
+	GetPayees(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetPayeesReturns, error)
+	AddPayee(ctx context.Context, in *PayeeParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	EditPayee(ctx context.Context, in *PayeeParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	DeletePayee(ctx context.Context, in *DeletePayeeParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	// Rules methods
+	GetRules(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetRulesReturns, error)
+	AddRule(ctx context.Context, in *RuleParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	EditRule(ctx context.Context, in *RuleParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	TestRule(ctx context.Context, in *RuleParams, opts ...grpc.CallOption) (*TestRuleReturns, error)
+	// Attachments methods
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (Database_UploadAttachmentClient, error)
+	DownloadAttachment(ctx context.Context, in *GetAttachmentParams, opts ...grpc.CallOption) (Database_DownloadAttachmentClient, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	// Audit log methods
+	GetAuditLog(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetAuditLogReturns, error)
+	UndoLastAction(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	// Integrity methods
+	VerifyIntegrity(ctx context.Context, in *VerifyIntegrityParams, opts ...grpc.CallOption) (*VerifyIntegrityReturns, error)
+	// Incomes methods
+	GetIncomes(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetIncomesReturns, error)
+	AddIncome(ctx context.Context, in *IncomeParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	EditIncome(ctx context.Context, in *IncomeParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	DeleteIncome(ctx context.Context, in *DeleteIncomeParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	// Liabilities methods
+	GetLiabilities(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetLiabilitiesReturns, error)
+	AddLiability(ctx context.Context, in *AddLiabilityParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	DeleteLiability(ctx context.Context, in *DeleteLiabilityParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	AddLiabilityPayment(ctx context.Context, in *LiabilityPaymentParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	// Accounts methods
 	GetAccounts(ctx context.Context, in *GetAccountsParams, opts ...grpc.CallOption) (*GetAccountsReturns, error)
 	AddAccount(ctx context.Context, in *AddAccountParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
@@ -43,6 +99,11 @@ type DatabaseClient interface {
 	DeleteAccount(ctx context.Context, in *DeleteAccountParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	TransferFunds(ctx context.Context, in *TransferFundsParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	ReorderAccount(ctx context.Context, in *ReorderAccountParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	// Exchange rates methods
+	GetExchangeRates(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetExchangeRatesReturns, error)
+	SetExchangeRates(ctx context.Context, in *SetExchangeRatesParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	SetBaseCurrency(ctx context.Context, in *SetBaseCurrencyParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	// Categories methods
 	GetCategoriesCount(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetCategoriesCountReturns, error)
 	GetCategories(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetCategoriesReturns, error)
@@ -51,6 +112,8 @@ type DatabaseClient interface {
 	ReorderCategory(ctx context.Context, in *ReorderCategoryParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	DeleteCategory(ctx context.Context, in *DeleteCategoryParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	ResetCategories(ctx context.Context, in *ResetCategoriesParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	SetCategoryAutoReset(ctx context.Context, in *SetCategoryAutoResetParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
+	SetCategoryGoal(ctx context.Context, in *SetCategoryGoalParams, opts ...grpc.CallOption) (*GrpcEmpty, error)
 	// Time periods
 	GetTimePeriods(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetTimePeriodsReturns, error)
 }
@@ -72,6 +135,78 @@ func (c *databaseClient) RegisterNode(ctx context.Context, in *DBNodeData, opts
 	return out, nil
 }
 
+func (c *databaseClient) DeregisterNode(ctx context.Context, in *DBNodeData, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/DeregisterNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) CreateSession(ctx context.Context, in *CreateSessionParams, opts ...grpc.CallOption) (*GrpcSessionToken, error) {
+	out := new(GrpcSessionToken)
+	err := c.cc.Invoke(ctx, "/Database/CreateSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) ValidateSession(ctx context.Context, in *ValidateSessionParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/ValidateSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RotateSession(ctx context.Context, in *RotateSessionParams, opts ...grpc.CallOption) (*GrpcSessionToken, error) {
+	out := new(GrpcSessionToken)
+	err := c.cc.Invoke(ctx, "/Database/RotateSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetUserSessions(ctx context.Context, in *UserSessionsParams, opts ...grpc.CallOption) (*GetSessionsReturns, error) {
+	out := new(GetSessionsReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetUserSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RevokeUserSession(ctx context.Context, in *RevokeUserSessionParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/RevokeUserSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) CheckLoginAttempt(ctx context.Context, in *LoginAttemptParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/CheckLoginAttempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RecordLoginAttempt(ctx context.Context, in *LoginAttemptParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/RecordLoginAttempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseClient) GetUser(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GrpcUser, error) {
 	out := new(GrpcUser)
 	err := c.cc.Invoke(ctx, "/Database/GetUser", in, out, opts...)
@@ -99,6 +234,42 @@ func (c *databaseClient) Logout(ctx context.Context, in *LogoutParams, opts ...g
 	return out, nil
 }
 
+func (c *databaseClient) RefreshToken(ctx context.Context, in *RefreshTokenParams, opts ...grpc.CallOption) (*LoginToken, error) {
+	out := new(LoginToken)
+	err := c.cc.Invoke(ctx, "/Database/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) VerifyTwoFactor(ctx context.Context, in *TwoFactorLoginParams, opts ...grpc.CallOption) (*LoginToken, error) {
+	out := new(LoginToken)
+	err := c.cc.Invoke(ctx, "/Database/VerifyTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetSessions(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetSessionsReturns, error) {
+	out := new(GetSessionsReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RevokeSession(ctx context.Context, in *RevokeSessionParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseClient) ModifyFreeFunds(ctx context.Context, in *ModifyFreeFundsParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
 	out := new(GrpcEmpty)
 	err := c.cc.Invoke(ctx, "/Database/ModifyFreeFunds", in, out, opts...)
@@ -108,6 +279,69 @@ func (c *databaseClient) ModifyFreeFunds(ctx context.Context, in *ModifyFreeFund
 	return out, nil
 }
 
+func (c *databaseClient) GetTwoFactor(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GrpcTwoFactor, error) {
+	out := new(GrpcTwoFactor)
+	err := c.cc.Invoke(ctx, "/Database/GetTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) BeginTwoFactorSetup(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*BeginTwoFactorSetupReturns, error) {
+	out := new(BeginTwoFactorSetupReturns)
+	err := c.cc.Invoke(ctx, "/Database/BeginTwoFactorSetup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) EnableTwoFactor(ctx context.Context, in *TwoFactorCodeParams, opts ...grpc.CallOption) (*RecoveryCodesReturns, error) {
+	out := new(RecoveryCodesReturns)
+	err := c.cc.Invoke(ctx, "/Database/EnableTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DisableTwoFactor(ctx context.Context, in *TwoFactorCodeParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/DisableTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RegenerateRecoveryCodes(ctx context.Context, in *TwoFactorCodeParams, opts ...grpc.CallOption) (*RecoveryCodesReturns, error) {
+	out := new(RecoveryCodesReturns)
+	err := c.cc.Invoke(ctx, "/Database/RegenerateRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetSettings(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GrpcSettings, error) {
+	out := new(GrpcSettings)
+	err := c.cc.Invoke(ctx, "/Database/GetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) UpdateSettings(ctx context.Context, in *UpdateSettingsParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/UpdateSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseClient) GetTags(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetTagsReturns, error) {
 	out := new(GetTagsReturns)
 	err := c.cc.Invoke(ctx, "/Database/GetTags", in, out, opts...)
@@ -153,435 +387,1808 @@ func (c *databaseClient) DeleteExpense(ctx context.Context, in *DeleteExpensePar
 	return out, nil
 }
 
-func (c *databaseClient) GetAccounts(ctx context.Context, in *GetAccountsParams, opts ...grpc.CallOption) (*GetAccountsReturns, error) {
-	out := new(GetAccountsReturns)
-	err := c.cc.Invoke(ctx, "/Database/GetAccounts", in, out, opts...)
+func (c *databaseClient) AddExpenseRefund(ctx context.Context, in *ExpenseRefundParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/AddExpenseRefund", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) AddAccount(ctx context.Context, in *AddAccountParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+func (c *databaseClient) DeleteExpenseRefund(ctx context.Context, in *DeleteExpenseRefundParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
 	out := new(GrpcEmpty)
-	err := c.cc.Invoke(ctx, "/Database/AddAccount", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/Database/DeleteExpenseRefund", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) EditAccountName(ctx context.Context, in *EditAccountNameParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+func (c *databaseClient) SetExpenseReimbursement(ctx context.Context, in *SetExpenseReimbursementParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
 	out := new(GrpcEmpty)
-	err := c.cc.Invoke(ctx, "/Database/EditAccountName", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/Database/SetExpenseReimbursement", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) DeleteAccount(ctx context.Context, in *DeleteAccountParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
-	out := new(GrpcEmpty)
-	err := c.cc.Invoke(ctx, "/Database/DeleteAccount", in, out, opts...)
+func (c *databaseClient) GetPayees(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetPayeesReturns, error) {
+	out := new(GetPayeesReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetPayees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) TransferFunds(ctx context.Context, in *TransferFundsParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+func (c *databaseClient) AddPayee(ctx context.Context, in *PayeeParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
 	out := new(GrpcEmpty)
-	err := c.cc.Invoke(ctx, "/Database/TransferFunds", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/Database/AddPayee", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) ReorderAccount(ctx context.Context, in *ReorderAccountParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+func (c *databaseClient) EditPayee(ctx context.Context, in *PayeeParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
 	out := new(GrpcEmpty)
-	err := c.cc.Invoke(ctx, "/Database/ReorderAccount", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/Database/EditPayee", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) GetCategoriesCount(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetCategoriesCountReturns, error) {
-	out := new(GetCategoriesCountReturns)
-	err := c.cc.Invoke(ctx, "/Database/GetCategoriesCount", in, out, opts...)
+func (c *databaseClient) DeletePayee(ctx context.Context, in *DeletePayeeParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/DeletePayee", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) GetCategories(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetCategoriesReturns, error) {
-	out := new(GetCategoriesReturns)
-	err := c.cc.Invoke(ctx, "/Database/GetCategories", in, out, opts...)
+func (c *databaseClient) GetRules(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetRulesReturns, error) {
+	out := new(GetRulesReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetRules", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) GetCategoriesOverview(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetCategoriesOverviewReturns, error) {
-	out := new(GetCategoriesOverviewReturns)
-	err := c.cc.Invoke(ctx, "/Database/GetCategoriesOverview", in, out, opts...)
+func (c *databaseClient) AddRule(ctx context.Context, in *RuleParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/AddRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) AddCategory(ctx context.Context, in *AddCategoryParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+func (c *databaseClient) EditRule(ctx context.Context, in *RuleParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
 	out := new(GrpcEmpty)
-	err := c.cc.Invoke(ctx, "/Database/AddCategory", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/Database/EditRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) ReorderCategory(ctx context.Context, in *ReorderCategoryParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+func (c *databaseClient) DeleteRule(ctx context.Context, in *DeleteRuleParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
 	out := new(GrpcEmpty)
-	err := c.cc.Invoke(ctx, "/Database/ReorderCategory", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/Database/DeleteRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) DeleteCategory(ctx context.Context, in *DeleteCategoryParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
-	out := new(GrpcEmpty)
-	err := c.cc.Invoke(ctx, "/Database/DeleteCategory", in, out, opts...)
+func (c *databaseClient) TestRule(ctx context.Context, in *RuleParams, opts ...grpc.CallOption) (*TestRuleReturns, error) {
+	out := new(TestRuleReturns)
+	err := c.cc.Invoke(ctx, "/Database/TestRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseClient) ResetCategories(ctx context.Context, in *ResetCategoriesParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
-	out := new(GrpcEmpty)
-	err := c.cc.Invoke(ctx, "/Database/ResetCategories", in, out, opts...)
+func (c *databaseClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (Database_UploadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Database_ServiceDesc.Streams[0], "/Database/UploadAttachment", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &databaseUploadAttachmentClient{stream}
+	return x, nil
 }
 
-func (c *databaseClient) GetTimePeriods(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetTimePeriodsReturns, error) {
-	out := new(GetTimePeriodsReturns)
-	err := c.cc.Invoke(ctx, "/Database/GetTimePeriods", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Database_UploadAttachmentClient interface {
+	Send(*AttachmentChunk) error
+	CloseAndRecv() (*GrpcAttachment, error)
+	grpc.ClientStream
 }
 
-// DatabaseServer is the server API for Database service.
-// All implementations must embed UnimplementedDatabaseServer
-// for forward compatibility
-type DatabaseServer interface {
-	// Register DB Node
-	RegisterNode(context.Context, *DBNodeData) (*GrpcEmpty, error)
-	// User
-	GetUser(context.Context, *GrpcEmpty) (*GrpcUser, error)
-	Authenticate(context.Context, *LoginCredentials) (*LoginToken, error)
-	Logout(context.Context, *LogoutParams) (*GrpcEmpty, error)
-	ModifyFreeFunds(context.Context, *ModifyFreeFundsParams) (*GrpcEmpty, error)
-	// Tags methods
-	GetTags(context.Context, *GrpcEmpty) (*GetTagsReturns, error)
-	// Expenses methods
-	GetExpenses(context.Context, *GrpcEmpty) (*GetExpensesReturns, error)
-	AddExpense(context.Context, *ExpensesParams) (*GrpcEmpty, error)
-	EditExpense(context.Context, *ExpensesParams) (*GrpcEmpty, error)
-	DeleteExpense(context.Context, *DeleteExpenseParams) (*GrpcEmpty, error)
-	// Accounts methods
-	GetAccounts(context.Context, *GetAccountsParams) (*GetAccountsReturns, error)
-	AddAccount(context.Context, *AddAccountParams) (*GrpcEmpty, error)
-	EditAccountName(context.Context, *EditAccountNameParams) (*GrpcEmpty, error)
-	DeleteAccount(context.Context, *DeleteAccountParams) (*GrpcEmpty, error)
-	TransferFunds(context.Context, *TransferFundsParams) (*GrpcEmpty, error)
-	ReorderAccount(context.Context, *ReorderAccountParams) (*GrpcEmpty, error)
-	// Categories methods
-	GetCategoriesCount(context.Context, *GrpcEmpty) (*GetCategoriesCountReturns, error)
-	GetCategories(context.Context, *GrpcEmpty) (*GetCategoriesReturns, error)
-	GetCategoriesOverview(context.Context, *GrpcEmpty) (*GetCategoriesOverviewReturns, error)
-	AddCategory(context.Context, *AddCategoryParams) (*GrpcEmpty, error)
-	ReorderCategory(context.Context, *ReorderCategoryParams) (*GrpcEmpty, error)
-	DeleteCategory(context.Context, *DeleteCategoryParams) (*GrpcEmpty, error)
-	ResetCategories(context.Context, *ResetCategoriesParams) (*GrpcEmpty, error)
-	// Time periods
-	GetTimePeriods(context.Context, *GrpcEmpty) (*GetTimePeriodsReturns, error)
-	mustEmbedUnimplementedDatabaseServer()
+type databaseUploadAttachmentClient struct {
+	grpc.ClientStream
 }
 
-// UnimplementedDatabaseServer must be embedded to have forward compatible implementations.
-type UnimplementedDatabaseServer struct {
+func (x *databaseUploadAttachmentClient) Send(m *AttachmentChunk) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func (UnimplementedDatabaseServer) RegisterNode(context.Context, *DBNodeData) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterNode not implemented")
+func (x *databaseUploadAttachmentClient) CloseAndRecv() (*GrpcAttachment, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(GrpcAttachment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedDatabaseServer) GetUser(context.Context, *GrpcEmpty) (*GrpcUser, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+
+func (c *databaseClient) DownloadAttachment(ctx context.Context, in *GetAttachmentParams, opts ...grpc.CallOption) (Database_DownloadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Database_ServiceDesc.Streams[1], "/Database/DownloadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &databaseDownloadAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedDatabaseServer) Authenticate(context.Context, *LoginCredentials) (*LoginToken, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+
+type Database_DownloadAttachmentClient interface {
+	Recv() (*AttachmentChunk, error)
+	grpc.ClientStream
 }
-func (UnimplementedDatabaseServer) Logout(context.Context, *LogoutParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+
+type databaseDownloadAttachmentClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedDatabaseServer) ModifyFreeFunds(context.Context, *ModifyFreeFundsParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyFreeFunds not implemented")
+
+func (x *databaseDownloadAttachmentClient) Recv() (*AttachmentChunk, error) {
+	m := new(AttachmentChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedDatabaseServer) GetTags(context.Context, *GrpcEmpty) (*GetTagsReturns, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+
+func (c *databaseClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/DeleteAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDatabaseServer) GetExpenses(context.Context, *GrpcEmpty) (*GetExpensesReturns, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExpenses not implemented")
+
+func (c *databaseClient) GetAuditLog(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetAuditLogReturns, error) {
+	out := new(GetAuditLogReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDatabaseServer) AddExpense(context.Context, *ExpensesParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddExpense not implemented")
+
+func (c *databaseClient) UndoLastAction(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/UndoLastAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) VerifyIntegrity(ctx context.Context, in *VerifyIntegrityParams, opts ...grpc.CallOption) (*VerifyIntegrityReturns, error) {
+	out := new(VerifyIntegrityReturns)
+	err := c.cc.Invoke(ctx, "/Database/VerifyIntegrity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetIncomes(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetIncomesReturns, error) {
+	out := new(GetIncomesReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetIncomes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddIncome(ctx context.Context, in *IncomeParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/AddIncome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) EditIncome(ctx context.Context, in *IncomeParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/EditIncome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteIncome(ctx context.Context, in *DeleteIncomeParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/DeleteIncome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetLiabilities(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetLiabilitiesReturns, error) {
+	out := new(GetLiabilitiesReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetLiabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddLiability(ctx context.Context, in *AddLiabilityParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/AddLiability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteLiability(ctx context.Context, in *DeleteLiabilityParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/DeleteLiability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddLiabilityPayment(ctx context.Context, in *LiabilityPaymentParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/AddLiabilityPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetAccounts(ctx context.Context, in *GetAccountsParams, opts ...grpc.CallOption) (*GetAccountsReturns, error) {
+	out := new(GetAccountsReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddAccount(ctx context.Context, in *AddAccountParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/AddAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) EditAccountName(ctx context.Context, in *EditAccountNameParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/EditAccountName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteAccount(ctx context.Context, in *DeleteAccountParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) TransferFunds(ctx context.Context, in *TransferFundsParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/TransferFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) ReorderAccount(ctx context.Context, in *ReorderAccountParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/ReorderAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetExchangeRates(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetExchangeRatesReturns, error) {
+	out := new(GetExchangeRatesReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) SetExchangeRates(ctx context.Context, in *SetExchangeRatesParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/SetExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/DeleteExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) SetBaseCurrency(ctx context.Context, in *SetBaseCurrencyParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/SetBaseCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetCategoriesCount(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetCategoriesCountReturns, error) {
+	out := new(GetCategoriesCountReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetCategoriesCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetCategories(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetCategoriesReturns, error) {
+	out := new(GetCategoriesReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetCategoriesOverview(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetCategoriesOverviewReturns, error) {
+	out := new(GetCategoriesOverviewReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetCategoriesOverview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddCategory(ctx context.Context, in *AddCategoryParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/AddCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) ReorderCategory(ctx context.Context, in *ReorderCategoryParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/ReorderCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteCategory(ctx context.Context, in *DeleteCategoryParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/DeleteCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) ResetCategories(ctx context.Context, in *ResetCategoriesParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/ResetCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) SetCategoryAutoReset(ctx context.Context, in *SetCategoryAutoResetParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/SetCategoryAutoReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) SetCategoryGoal(ctx context.Context, in *SetCategoryGoalParams, opts ...grpc.CallOption) (*GrpcEmpty, error) {
+	out := new(GrpcEmpty)
+	err := c.cc.Invoke(ctx, "/Database/SetCategoryGoal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) GetTimePeriods(ctx context.Context, in *GrpcEmpty, opts ...grpc.CallOption) (*GetTimePeriodsReturns, error) {
+	out := new(GetTimePeriodsReturns)
+	err := c.cc.Invoke(ctx, "/Database/GetTimePeriods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DatabaseServer is the server API for Database service.
+// All implementations must embed UnimplementedDatabaseServer
+// for forward compatibility
+type DatabaseServer interface {
+	// Register DB Node
+	RegisterNode(context.Context, *DBNodeData) (*GrpcEmpty, error)
+	DeregisterNode(context.Context, *DBNodeData) (*GrpcEmpty, error)
+	// Session registry kept by the DB Controller
+	CreateSession(context.Context, *CreateSessionParams) (*GrpcSessionToken, error)
+	ValidateSession(context.Context, *ValidateSessionParams) (*GrpcEmpty, error)
+	RotateSession(context.Context, *RotateSessionParams) (*GrpcSessionToken, error)
+	GetUserSessions(context.Context, *UserSessionsParams) (*GetSessionsReturns, error)
+	RevokeUserSession(context.Context, *RevokeUserSessionParams) (*GrpcEmpty, error)
+	// Login throttling kept by the DB Controller
+	CheckLoginAttempt(context.Context, *LoginAttemptParams) (*GrpcEmpty, error)
+	RecordLoginAttempt(context.Context, *LoginAttemptParams) (*GrpcEmpty, error)
+	// User
+	GetUser(context.Context, *GrpcEmpty) (*GrpcUser, error)
+	Authenticate(context.Context, *LoginCredentials) (*LoginToken, error)
+	Logout(context.Context, *LogoutParams) (*GrpcEmpty, error)
+	RefreshToken(context.Context, *RefreshTokenParams) (*LoginToken, error)
+	VerifyTwoFactor(context.Context, *TwoFactorLoginParams) (*LoginToken, error)
+	GetSessions(context.Context, *GrpcEmpty) (*GetSessionsReturns, error)
+	RevokeSession(context.Context, *RevokeSessionParams) (*GrpcEmpty, error)
+	ModifyFreeFunds(context.Context, *ModifyFreeFundsParams) (*GrpcEmpty, error)
+	// Two-factor methods
+	GetTwoFactor(context.Context, *GrpcEmpty) (*GrpcTwoFactor, error)
+	BeginTwoFactorSetup(context.Context, *GrpcEmpty) (*BeginTwoFactorSetupReturns, error)
+	EnableTwoFactor(context.Context, *TwoFactorCodeParams) (*RecoveryCodesReturns, error)
+	DisableTwoFactor(context.Context, *TwoFactorCodeParams) (*GrpcEmpty, error)
+	RegenerateRecoveryCodes(context.Context, *TwoFactorCodeParams) (*RecoveryCodesReturns, error)
+	// Settings methods
+	GetSettings(context.Context, *GrpcEmpty) (*GrpcSettings, error)
+	UpdateSettings(context.Context, *UpdateSettingsParams) (*GrpcEmpty, error)
+	// Tags methods
+	GetTags(context.Context, *GrpcEmpty) (*GetTagsReturns, error)
+	// Expenses methods
+	GetExpenses(context.Context, *GrpcEmpty) (*GetExpensesReturns, error)
+	AddExpense(context.Context, *ExpensesParams) (*GrpcEmpty, error)
+	EditExpense(context.Context, *ExpensesParams) (*GrpcEmpty, error)
+	DeleteExpense(context.Context, *DeleteExpenseParams) (*GrpcEmpty, error)
+	AddExpenseRefund(context.Context, *ExpenseRefundParams) (*GrpcEmpty, error)
+	DeleteExpenseRefund(context.Context, *DeleteExpenseRefundParams) (*GrpcEmpty, error)
+	SetExpenseReimbursement(context.Context, *SetExpenseReimbursementParams) (*GrpcEmpty, error)
+	// Payees methods
+	GetPayees(context.Context, *GrpcEmpty) (*GetPayeesReturns, error)
+	AddPayee(context.Context, *PayeeParams) (*GrpcEmpty, error)
+	EditPayee(context.Context, *PayeeParams) (*GrpcEmpty, error)
+	DeletePayee(context.Context, *DeletePayeeParams) (*GrpcEmpty, error)
+	// Rules methods
+	GetRules(context.Context, *GrpcEmpty) (*GetRulesReturns, error)
+	AddRule(context.Context, *RuleParams) (*GrpcEmpty, error)
+	EditRule(context.Context, *RuleParams) (*GrpcEmpty, error)
+	DeleteRule(context.Context, *DeleteRuleParams) (*GrpcEmpty, error)
+	TestRule(context.Context, *RuleParams) (*TestRuleReturns, error)
+	// Attachments methods
+	UploadAttachment(Database_UploadAttachmentServer) error
+	DownloadAttachment(*GetAttachmentParams, Database_DownloadAttachmentServer) error
+	DeleteAttachment(context.Context, *DeleteAttachmentParams) (*GrpcEmpty, error)
+	// Audit log methods
+	GetAuditLog(context.Context, *GrpcEmpty) (*GetAuditLogReturns, error)
+	UndoLastAction(context.Context, *GrpcEmpty) (*GrpcEmpty, error)
+	// Integrity methods
+	VerifyIntegrity(context.Context, *VerifyIntegrityParams) (*VerifyIntegrityReturns, error)
+	// Incomes methods
+	GetIncomes(context.Context, *GrpcEmpty) (*GetIncomesReturns, error)
+	AddIncome(context.Context, *IncomeParams) (*GrpcEmpty, error)
+	EditIncome(context.Context, *IncomeParams) (*GrpcEmpty, error)
+	DeleteIncome(context.Context, *DeleteIncomeParams) (*GrpcEmpty, error)
+	// Liabilities methods
+	GetLiabilities(context.Context, *GrpcEmpty) (*GetLiabilitiesReturns, error)
+	AddLiability(context.Context, *AddLiabilityParams) (*GrpcEmpty, error)
+	DeleteLiability(context.Context, *DeleteLiabilityParams) (*GrpcEmpty, error)
+	AddLiabilityPayment(context.Context, *LiabilityPaymentParams) (*GrpcEmpty, error)
+	// Accounts methods
+	GetAccounts(context.Context, *GetAccountsParams) (*GetAccountsReturns, error)
+	AddAccount(context.Context, *AddAccountParams) (*GrpcEmpty, error)
+	EditAccountName(context.Context, *EditAccountNameParams) (*GrpcEmpty, error)
+	DeleteAccount(context.Context, *DeleteAccountParams) (*GrpcEmpty, error)
+	TransferFunds(context.Context, *TransferFundsParams) (*GrpcEmpty, error)
+	ReorderAccount(context.Context, *ReorderAccountParams) (*GrpcEmpty, error)
+	// Exchange rates methods
+	GetExchangeRates(context.Context, *GrpcEmpty) (*GetExchangeRatesReturns, error)
+	SetExchangeRates(context.Context, *SetExchangeRatesParams) (*GrpcEmpty, error)
+	DeleteExchangeRate(context.Context, *DeleteExchangeRateParams) (*GrpcEmpty, error)
+	SetBaseCurrency(context.Context, *SetBaseCurrencyParams) (*GrpcEmpty, error)
+	// Categories methods
+	GetCategoriesCount(context.Context, *GrpcEmpty) (*GetCategoriesCountReturns, error)
+	GetCategories(context.Context, *GrpcEmpty) (*GetCategoriesReturns, error)
+	GetCategoriesOverview(context.Context, *GrpcEmpty) (*GetCategoriesOverviewReturns, error)
+	AddCategory(context.Context, *AddCategoryParams) (*GrpcEmpty, error)
+	ReorderCategory(context.Context, *ReorderCategoryParams) (*GrpcEmpty, error)
+	DeleteCategory(context.Context, *DeleteCategoryParams) (*GrpcEmpty, error)
+	ResetCategories(context.Context, *ResetCategoriesParams) (*GrpcEmpty, error)
+	SetCategoryAutoReset(context.Context, *SetCategoryAutoResetParams) (*GrpcEmpty, error)
+	SetCategoryGoal(context.Context, *SetCategoryGoalParams) (*GrpcEmpty, error)
+	// Time periods
+	GetTimePeriods(context.Context, *GrpcEmpty) (*GetTimePeriodsReturns, error)
+	mustEmbedUnimplementedDatabaseServer()
+}
+
+// UnimplementedDatabaseServer must be embedded to have forward compatible implementations.
+type UnimplementedDatabaseServer struct {
+}
+
+func (UnimplementedDatabaseServer) RegisterNode(context.Context, *DBNodeData) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterNode not implemented")
+}
+func (UnimplementedDatabaseServer) DeregisterNode(context.Context, *DBNodeData) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterNode not implemented")
+}
+func (UnimplementedDatabaseServer) CreateSession(context.Context, *CreateSessionParams) (*GrpcSessionToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedDatabaseServer) ValidateSession(context.Context, *ValidateSessionParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSession not implemented")
+}
+func (UnimplementedDatabaseServer) RotateSession(context.Context, *RotateSessionParams) (*GrpcSessionToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSession not implemented")
+}
+func (UnimplementedDatabaseServer) GetUserSessions(context.Context, *UserSessionsParams) (*GetSessionsReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSessions not implemented")
+}
+func (UnimplementedDatabaseServer) RevokeUserSession(context.Context, *RevokeUserSessionParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSession not implemented")
+}
+func (UnimplementedDatabaseServer) CheckLoginAttempt(context.Context, *LoginAttemptParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckLoginAttempt not implemented")
+}
+func (UnimplementedDatabaseServer) RecordLoginAttempt(context.Context, *LoginAttemptParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordLoginAttempt not implemented")
+}
+func (UnimplementedDatabaseServer) GetUser(context.Context, *GrpcEmpty) (*GrpcUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedDatabaseServer) Authenticate(context.Context, *LoginCredentials) (*LoginToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+}
+func (UnimplementedDatabaseServer) Logout(context.Context, *LogoutParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedDatabaseServer) RefreshToken(context.Context, *RefreshTokenParams) (*LoginToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedDatabaseServer) VerifyTwoFactor(context.Context, *TwoFactorLoginParams) (*LoginToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTwoFactor not implemented")
+}
+func (UnimplementedDatabaseServer) GetSessions(context.Context, *GrpcEmpty) (*GetSessionsReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedDatabaseServer) RevokeSession(context.Context, *RevokeSessionParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedDatabaseServer) ModifyFreeFunds(context.Context, *ModifyFreeFundsParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyFreeFunds not implemented")
+}
+func (UnimplementedDatabaseServer) GetTwoFactor(context.Context, *GrpcEmpty) (*GrpcTwoFactor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwoFactor not implemented")
+}
+func (UnimplementedDatabaseServer) BeginTwoFactorSetup(context.Context, *GrpcEmpty) (*BeginTwoFactorSetupReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTwoFactorSetup not implemented")
+}
+func (UnimplementedDatabaseServer) EnableTwoFactor(context.Context, *TwoFactorCodeParams) (*RecoveryCodesReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTwoFactor not implemented")
+}
+func (UnimplementedDatabaseServer) DisableTwoFactor(context.Context, *TwoFactorCodeParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedDatabaseServer) RegenerateRecoveryCodes(context.Context, *TwoFactorCodeParams) (*RecoveryCodesReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedDatabaseServer) GetSettings(context.Context, *GrpcEmpty) (*GrpcSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedDatabaseServer) UpdateSettings(context.Context, *UpdateSettingsParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+func (UnimplementedDatabaseServer) GetTags(context.Context, *GrpcEmpty) (*GetTagsReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedDatabaseServer) GetExpenses(context.Context, *GrpcEmpty) (*GetExpensesReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpenses not implemented")
+}
+func (UnimplementedDatabaseServer) AddExpense(context.Context, *ExpensesParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExpense not implemented")
 }
 func (UnimplementedDatabaseServer) EditExpense(context.Context, *ExpensesParams) (*GrpcEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditExpense not implemented")
 }
-func (UnimplementedDatabaseServer) DeleteExpense(context.Context, *DeleteExpenseParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpense not implemented")
+func (UnimplementedDatabaseServer) DeleteExpense(context.Context, *DeleteExpenseParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpense not implemented")
+}
+func (UnimplementedDatabaseServer) AddExpenseRefund(context.Context, *ExpenseRefundParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExpenseRefund not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteExpenseRefund(context.Context, *DeleteExpenseRefundParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpenseRefund not implemented")
+}
+func (UnimplementedDatabaseServer) SetExpenseReimbursement(context.Context, *SetExpenseReimbursementParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExpenseReimbursement not implemented")
+}
+func (UnimplementedDatabaseServer) GetPayees(context.Context, *GrpcEmpty) (*GetPayeesReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayees not implemented")
+}
+func (UnimplementedDatabaseServer) AddPayee(context.Context, *PayeeParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPayee not implemented")
+}
+func (UnimplementedDatabaseServer) EditPayee(context.Context, *PayeeParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPayee not implemented")
+}
+func (UnimplementedDatabaseServer) DeletePayee(context.Context, *DeletePayeeParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePayee not implemented")
+}
+func (UnimplementedDatabaseServer) GetRules(context.Context, *GrpcEmpty) (*GetRulesReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRules not implemented")
+}
+func (UnimplementedDatabaseServer) AddRule(context.Context, *RuleParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRule not implemented")
+}
+func (UnimplementedDatabaseServer) EditRule(context.Context, *RuleParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditRule not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteRule(context.Context, *DeleteRuleParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedDatabaseServer) TestRule(context.Context, *RuleParams) (*TestRuleReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestRule not implemented")
+}
+func (UnimplementedDatabaseServer) UploadAttachment(Database_UploadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedDatabaseServer) DownloadAttachment(*GetAttachmentParams, Database_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteAttachment(context.Context, *DeleteAttachmentParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedDatabaseServer) GetAuditLog(context.Context, *GrpcEmpty) (*GetAuditLogReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedDatabaseServer) UndoLastAction(context.Context, *GrpcEmpty) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoLastAction not implemented")
+}
+func (UnimplementedDatabaseServer) VerifyIntegrity(context.Context, *VerifyIntegrityParams) (*VerifyIntegrityReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyIntegrity not implemented")
+}
+func (UnimplementedDatabaseServer) GetIncomes(context.Context, *GrpcEmpty) (*GetIncomesReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncomes not implemented")
+}
+func (UnimplementedDatabaseServer) AddIncome(context.Context, *IncomeParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIncome not implemented")
+}
+func (UnimplementedDatabaseServer) EditIncome(context.Context, *IncomeParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditIncome not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteIncome(context.Context, *DeleteIncomeParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIncome not implemented")
+}
+func (UnimplementedDatabaseServer) GetLiabilities(context.Context, *GrpcEmpty) (*GetLiabilitiesReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiabilities not implemented")
+}
+func (UnimplementedDatabaseServer) AddLiability(context.Context, *AddLiabilityParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLiability not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteLiability(context.Context, *DeleteLiabilityParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLiability not implemented")
+}
+func (UnimplementedDatabaseServer) AddLiabilityPayment(context.Context, *LiabilityPaymentParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLiabilityPayment not implemented")
+}
+func (UnimplementedDatabaseServer) GetAccounts(context.Context, *GetAccountsParams) (*GetAccountsReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (UnimplementedDatabaseServer) AddAccount(context.Context, *AddAccountParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccount not implemented")
+}
+func (UnimplementedDatabaseServer) EditAccountName(context.Context, *EditAccountNameParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditAccountName not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteAccount(context.Context, *DeleteAccountParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedDatabaseServer) TransferFunds(context.Context, *TransferFundsParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFunds not implemented")
+}
+func (UnimplementedDatabaseServer) ReorderAccount(context.Context, *ReorderAccountParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderAccount not implemented")
+}
+func (UnimplementedDatabaseServer) GetExchangeRates(context.Context, *GrpcEmpty) (*GetExchangeRatesReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
+func (UnimplementedDatabaseServer) SetExchangeRates(context.Context, *SetExchangeRatesParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteExchangeRate(context.Context, *DeleteExchangeRateParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExchangeRate not implemented")
+}
+func (UnimplementedDatabaseServer) SetBaseCurrency(context.Context, *SetBaseCurrencyParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBaseCurrency not implemented")
+}
+func (UnimplementedDatabaseServer) GetCategoriesCount(context.Context, *GrpcEmpty) (*GetCategoriesCountReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoriesCount not implemented")
+}
+func (UnimplementedDatabaseServer) GetCategories(context.Context, *GrpcEmpty) (*GetCategoriesReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
+}
+func (UnimplementedDatabaseServer) GetCategoriesOverview(context.Context, *GrpcEmpty) (*GetCategoriesOverviewReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoriesOverview not implemented")
+}
+func (UnimplementedDatabaseServer) AddCategory(context.Context, *AddCategoryParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCategory not implemented")
+}
+func (UnimplementedDatabaseServer) ReorderCategory(context.Context, *ReorderCategoryParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCategory not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteCategory(context.Context, *DeleteCategoryParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedDatabaseServer) ResetCategories(context.Context, *ResetCategoriesParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCategories not implemented")
+}
+func (UnimplementedDatabaseServer) SetCategoryAutoReset(context.Context, *SetCategoryAutoResetParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategoryAutoReset not implemented")
+}
+func (UnimplementedDatabaseServer) SetCategoryGoal(context.Context, *SetCategoryGoalParams) (*GrpcEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategoryGoal not implemented")
+}
+func (UnimplementedDatabaseServer) GetTimePeriods(context.Context, *GrpcEmpty) (*GetTimePeriodsReturns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimePeriods not implemented")
+}
+func (UnimplementedDatabaseServer) mustEmbedUnimplementedDatabaseServer() {}
+
+// UnsafeDatabaseServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DatabaseServer will
+// result in compilation errors.
+type UnsafeDatabaseServer interface {
+	mustEmbedUnimplementedDatabaseServer()
+}
+
+func RegisterDatabaseServer(s grpc.ServiceRegistrar, srv DatabaseServer) {
+	s.RegisterService(&Database_ServiceDesc, srv)
+}
+
+func _Database_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBNodeData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RegisterNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/RegisterNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RegisterNode(ctx, req.(*DBNodeData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DeregisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBNodeData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeregisterNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/DeregisterNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeregisterNode(ctx, req.(*DBNodeData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/CreateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).CreateSession(ctx, req.(*CreateSessionParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_ValidateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSessionParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).ValidateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/ValidateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).ValidateSession(ctx, req.(*ValidateSessionParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RotateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSessionParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RotateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/RotateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RotateSession(ctx, req.(*RotateSessionParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_GetUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetUserSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetUserSessions(ctx, req.(*UserSessionsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RevokeUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/RevokeUserSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RevokeUserSession(ctx, req.(*RevokeUserSessionParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_CheckLoginAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginAttemptParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).CheckLoginAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/CheckLoginAttempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).CheckLoginAttempt(ctx, req.(*LoginAttemptParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RecordLoginAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginAttemptParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RecordLoginAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/RecordLoginAttempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RecordLoginAttempt(ctx, req.(*LoginAttemptParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetUser(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginCredentials)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).Authenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/Authenticate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).Authenticate(ctx, req.(*LoginCredentials))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).Logout(ctx, req.(*LogoutParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RefreshToken(ctx, req.(*RefreshTokenParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_VerifyTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorLoginParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).VerifyTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/VerifyTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).VerifyTwoFactor(ctx, req.(*TwoFactorLoginParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetSessions(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RevokeSession(ctx, req.(*RevokeSessionParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_ModifyFreeFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyFreeFundsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).ModifyFreeFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/ModifyFreeFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).ModifyFreeFunds(ctx, req.(*ModifyFreeFundsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_GetTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetTwoFactor(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_BeginTwoFactorSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).BeginTwoFactorSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/BeginTwoFactorSetup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).BeginTwoFactorSetup(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_EnableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorCodeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).EnableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/EnableTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).EnableTwoFactor(ctx, req.(*TwoFactorCodeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorCodeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/DisableTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DisableTwoFactor(ctx, req.(*TwoFactorCodeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorCodeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/RegenerateRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RegenerateRecoveryCodes(ctx, req.(*TwoFactorCodeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetSettings(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/UpdateSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).UpdateSettings(ctx, req.(*UpdateSettingsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetTags(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_GetExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetExpenses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetExpenses(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_AddExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpensesParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/AddExpense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddExpense(ctx, req.(*ExpensesParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) GetAccounts(context.Context, *GetAccountsParams) (*GetAccountsReturns, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+
+func _Database_EditExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpensesParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).EditExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/EditExpense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).EditExpense(ctx, req.(*ExpensesParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) AddAccount(context.Context, *AddAccountParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAccount not implemented")
+
+func _Database_DeleteExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExpenseParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/DeleteExpense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteExpense(ctx, req.(*DeleteExpenseParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) EditAccountName(context.Context, *EditAccountNameParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditAccountName not implemented")
+
+func _Database_AddExpenseRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseRefundParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddExpenseRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/AddExpenseRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddExpenseRefund(ctx, req.(*ExpenseRefundParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) DeleteAccount(context.Context, *DeleteAccountParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+
+func _Database_DeleteExpenseRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExpenseRefundParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteExpenseRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/DeleteExpenseRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteExpenseRefund(ctx, req.(*DeleteExpenseRefundParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) TransferFunds(context.Context, *TransferFundsParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferFunds not implemented")
+
+func _Database_SetExpenseReimbursement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExpenseReimbursementParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).SetExpenseReimbursement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/SetExpenseReimbursement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).SetExpenseReimbursement(ctx, req.(*SetExpenseReimbursementParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) ReorderAccount(context.Context, *ReorderAccountParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReorderAccount not implemented")
+
+func _Database_GetPayees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetPayees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetPayees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetPayees(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) GetCategoriesCount(context.Context, *GrpcEmpty) (*GetCategoriesCountReturns, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategoriesCount not implemented")
+
+func _Database_AddPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayeeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddPayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/AddPayee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddPayee(ctx, req.(*PayeeParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) GetCategories(context.Context, *GrpcEmpty) (*GetCategoriesReturns, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
+
+func _Database_EditPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayeeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).EditPayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/EditPayee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).EditPayee(ctx, req.(*PayeeParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) GetCategoriesOverview(context.Context, *GrpcEmpty) (*GetCategoriesOverviewReturns, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategoriesOverview not implemented")
+
+func _Database_DeletePayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePayeeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeletePayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/DeletePayee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeletePayee(ctx, req.(*DeletePayeeParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) AddCategory(context.Context, *AddCategoryParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCategory not implemented")
+
+func _Database_GetRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetRules(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) ReorderCategory(context.Context, *ReorderCategoryParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReorderCategory not implemented")
+
+func _Database_AddRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/AddRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddRule(ctx, req.(*RuleParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) DeleteCategory(context.Context, *DeleteCategoryParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+
+func _Database_EditRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).EditRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/EditRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).EditRule(ctx, req.(*RuleParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) ResetCategories(context.Context, *ResetCategoriesParams) (*GrpcEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetCategories not implemented")
+
+func _Database_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/DeleteRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteRule(ctx, req.(*DeleteRuleParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) GetTimePeriods(context.Context, *GrpcEmpty) (*GetTimePeriodsReturns, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTimePeriods not implemented")
+
+func _Database_TestRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).TestRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/TestRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).TestRule(ctx, req.(*RuleParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDatabaseServer) mustEmbedUnimplementedDatabaseServer() {}
 
-// UnsafeDatabaseServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DatabaseServer will
-// result in compilation errors.
-type UnsafeDatabaseServer interface {
-	mustEmbedUnimplementedDatabaseServer()
+func _Database_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DatabaseServer).UploadAttachment(&databaseUploadAttachmentServer{stream})
+}
+
+type Database_UploadAttachmentServer interface {
+	SendAndClose(*GrpcAttachment) error
+	Recv() (*AttachmentChunk, error)
+	grpc.ServerStream
+}
+
+type databaseUploadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *databaseUploadAttachmentServer) SendAndClose(m *GrpcAttachment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *databaseUploadAttachmentServer) Recv() (*AttachmentChunk, error) {
+	m := new(AttachmentChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Database_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAttachmentParams)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatabaseServer).DownloadAttachment(m, &databaseDownloadAttachmentServer{stream})
+}
+
+type Database_DownloadAttachmentServer interface {
+	Send(*AttachmentChunk) error
+	grpc.ServerStream
+}
+
+type databaseDownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *databaseDownloadAttachmentServer) Send(m *AttachmentChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Database_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/DeleteAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteAttachment(ctx, req.(*DeleteAttachmentParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDatabaseServer(s grpc.ServiceRegistrar, srv DatabaseServer) {
-	s.RegisterService(&Database_ServiceDesc, srv)
+func _Database_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetAuditLog(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DBNodeData)
+func _Database_UndoLastAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).RegisterNode(ctx, in)
+		return srv.(DatabaseServer).UndoLastAction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/RegisterNode",
+		FullMethod: "/Database/UndoLastAction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).RegisterNode(ctx, req.(*DBNodeData))
+		return srv.(DatabaseServer).UndoLastAction(ctx, req.(*GrpcEmpty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GrpcEmpty)
+func _Database_VerifyIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyIntegrityParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).GetUser(ctx, in)
+		return srv.(DatabaseServer).VerifyIntegrity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/GetUser",
+		FullMethod: "/Database/VerifyIntegrity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).GetUser(ctx, req.(*GrpcEmpty))
+		return srv.(DatabaseServer).VerifyIntegrity(ctx, req.(*VerifyIntegrityParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginCredentials)
+func _Database_GetIncomes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).Authenticate(ctx, in)
+		return srv.(DatabaseServer).GetIncomes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/Authenticate",
+		FullMethod: "/Database/GetIncomes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).Authenticate(ctx, req.(*LoginCredentials))
+		return srv.(DatabaseServer).GetIncomes(ctx, req.(*GrpcEmpty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogoutParams)
+func _Database_AddIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncomeParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).Logout(ctx, in)
+		return srv.(DatabaseServer).AddIncome(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/Logout",
+		FullMethod: "/Database/AddIncome",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).Logout(ctx, req.(*LogoutParams))
+		return srv.(DatabaseServer).AddIncome(ctx, req.(*IncomeParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_ModifyFreeFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyFreeFundsParams)
+func _Database_EditIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncomeParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).ModifyFreeFunds(ctx, in)
+		return srv.(DatabaseServer).EditIncome(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/ModifyFreeFunds",
+		FullMethod: "/Database/EditIncome",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).ModifyFreeFunds(ctx, req.(*ModifyFreeFundsParams))
+		return srv.(DatabaseServer).EditIncome(ctx, req.(*IncomeParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GrpcEmpty)
+func _Database_DeleteIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIncomeParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).GetTags(ctx, in)
+		return srv.(DatabaseServer).DeleteIncome(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/GetTags",
+		FullMethod: "/Database/DeleteIncome",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).GetTags(ctx, req.(*GrpcEmpty))
+		return srv.(DatabaseServer).DeleteIncome(ctx, req.(*DeleteIncomeParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_GetExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Database_GetLiabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GrpcEmpty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).GetExpenses(ctx, in)
+		return srv.(DatabaseServer).GetLiabilities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/GetExpenses",
+		FullMethod: "/Database/GetLiabilities",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).GetExpenses(ctx, req.(*GrpcEmpty))
+		return srv.(DatabaseServer).GetLiabilities(ctx, req.(*GrpcEmpty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_AddExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExpensesParams)
+func _Database_AddLiability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLiabilityParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).AddExpense(ctx, in)
+		return srv.(DatabaseServer).AddLiability(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/AddExpense",
+		FullMethod: "/Database/AddLiability",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).AddExpense(ctx, req.(*ExpensesParams))
+		return srv.(DatabaseServer).AddLiability(ctx, req.(*AddLiabilityParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_EditExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExpensesParams)
+func _Database_DeleteLiability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLiabilityParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).EditExpense(ctx, in)
+		return srv.(DatabaseServer).DeleteLiability(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/EditExpense",
+		FullMethod: "/Database/DeleteLiability",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).EditExpense(ctx, req.(*ExpensesParams))
+		return srv.(DatabaseServer).DeleteLiability(ctx, req.(*DeleteLiabilityParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Database_DeleteExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteExpenseParams)
+func _Database_AddLiabilityPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiabilityPaymentParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServer).DeleteExpense(ctx, in)
+		return srv.(DatabaseServer).AddLiabilityPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Database/DeleteExpense",
+		FullMethod: "/Database/AddLiabilityPayment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServer).DeleteExpense(ctx, req.(*DeleteExpenseParams))
+		return srv.(DatabaseServer).AddLiabilityPayment(ctx, req.(*LiabilityPaymentParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -694,6 +2301,78 @@ func _Database_ReorderAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Database_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/GetExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).GetExchangeRates(ctx, req.(*GrpcEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRatesParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).SetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/SetExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).SetExchangeRates(ctx, req.(*SetExchangeRatesParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExchangeRateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/DeleteExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_SetBaseCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBaseCurrencyParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).SetBaseCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/SetBaseCurrency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).SetBaseCurrency(ctx, req.(*SetBaseCurrencyParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Database_GetCategoriesCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GrpcEmpty)
 	if err := dec(in); err != nil {
@@ -820,6 +2499,42 @@ func _Database_ResetCategories_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Database_SetCategoryAutoReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryAutoResetParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).SetCategoryAutoReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/SetCategoryAutoReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).SetCategoryAutoReset(ctx, req.(*SetCategoryAutoResetParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_SetCategoryGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryGoalParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).SetCategoryGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Database/SetCategoryGoal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).SetCategoryGoal(ctx, req.(*SetCategoryGoalParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Database_GetTimePeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GrpcEmpty)
 	if err := dec(in); err != nil {
@@ -849,6 +2564,38 @@ var Database_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterNode",
 			Handler:    _Database_RegisterNode_Handler,
 		},
+		{
+			MethodName: "DeregisterNode",
+			Handler:    _Database_DeregisterNode_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _Database_CreateSession_Handler,
+		},
+		{
+			MethodName: "ValidateSession",
+			Handler:    _Database_ValidateSession_Handler,
+		},
+		{
+			MethodName: "RotateSession",
+			Handler:    _Database_RotateSession_Handler,
+		},
+		{
+			MethodName: "GetUserSessions",
+			Handler:    _Database_GetUserSessions_Handler,
+		},
+		{
+			MethodName: "RevokeUserSession",
+			Handler:    _Database_RevokeUserSession_Handler,
+		},
+		{
+			MethodName: "CheckLoginAttempt",
+			Handler:    _Database_CheckLoginAttempt_Handler,
+		},
+		{
+			MethodName: "RecordLoginAttempt",
+			Handler:    _Database_RecordLoginAttempt_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _Database_GetUser_Handler,
@@ -861,10 +2608,54 @@ var Database_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Logout",
 			Handler:    _Database_Logout_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Database_RefreshToken_Handler,
+		},
+		{
+			MethodName: "VerifyTwoFactor",
+			Handler:    _Database_VerifyTwoFactor_Handler,
+		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _Database_GetSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Database_RevokeSession_Handler,
+		},
 		{
 			MethodName: "ModifyFreeFunds",
 			Handler:    _Database_ModifyFreeFunds_Handler,
 		},
+		{
+			MethodName: "GetTwoFactor",
+			Handler:    _Database_GetTwoFactor_Handler,
+		},
+		{
+			MethodName: "BeginTwoFactorSetup",
+			Handler:    _Database_BeginTwoFactorSetup_Handler,
+		},
+		{
+			MethodName: "EnableTwoFactor",
+			Handler:    _Database_EnableTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _Database_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _Database_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _Database_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _Database_UpdateSettings_Handler,
+		},
 		{
 			MethodName: "GetTags",
 			Handler:    _Database_GetTags_Handler,
@@ -885,6 +2676,102 @@ var Database_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteExpense",
 			Handler:    _Database_DeleteExpense_Handler,
 		},
+		{
+			MethodName: "AddExpenseRefund",
+			Handler:    _Database_AddExpenseRefund_Handler,
+		},
+		{
+			MethodName: "DeleteExpenseRefund",
+			Handler:    _Database_DeleteExpenseRefund_Handler,
+		},
+		{
+			MethodName: "SetExpenseReimbursement",
+			Handler:    _Database_SetExpenseReimbursement_Handler,
+		},
+		{
+			MethodName: "GetPayees",
+			Handler:    _Database_GetPayees_Handler,
+		},
+		{
+			MethodName: "AddPayee",
+			Handler:    _Database_AddPayee_Handler,
+		},
+		{
+			MethodName: "EditPayee",
+			Handler:    _Database_EditPayee_Handler,
+		},
+		{
+			MethodName: "DeletePayee",
+			Handler:    _Database_DeletePayee_Handler,
+		},
+		{
+			MethodName: "GetRules",
+			Handler:    _Database_GetRules_Handler,
+		},
+		{
+			MethodName: "AddRule",
+			Handler:    _Database_AddRule_Handler,
+		},
+		{
+			MethodName: "EditRule",
+			Handler:    _Database_EditRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _Database_DeleteRule_Handler,
+		},
+		{
+			MethodName: "TestRule",
+			Handler:    _Database_TestRule_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _Database_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _Database_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "UndoLastAction",
+			Handler:    _Database_UndoLastAction_Handler,
+		},
+		{
+			MethodName: "VerifyIntegrity",
+			Handler:    _Database_VerifyIntegrity_Handler,
+		},
+		{
+			MethodName: "GetIncomes",
+			Handler:    _Database_GetIncomes_Handler,
+		},
+		{
+			MethodName: "AddIncome",
+			Handler:    _Database_AddIncome_Handler,
+		},
+		{
+			MethodName: "EditIncome",
+			Handler:    _Database_EditIncome_Handler,
+		},
+		{
+			MethodName: "DeleteIncome",
+			Handler:    _Database_DeleteIncome_Handler,
+		},
+		{
+			MethodName: "GetLiabilities",
+			Handler:    _Database_GetLiabilities_Handler,
+		},
+		{
+			MethodName: "AddLiability",
+			Handler:    _Database_AddLiability_Handler,
+		},
+		{
+			MethodName: "DeleteLiability",
+			Handler:    _Database_DeleteLiability_Handler,
+		},
+		{
+			MethodName: "AddLiabilityPayment",
+			Handler:    _Database_AddLiabilityPayment_Handler,
+		},
 		{
 			MethodName: "GetAccounts",
 			Handler:    _Database_GetAccounts_Handler,
@@ -909,6 +2796,22 @@ var Database_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReorderAccount",
 			Handler:    _Database_ReorderAccount_Handler,
 		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _Database_GetExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _Database_SetExchangeRates_Handler,
+		},
+		{
+			MethodName: "DeleteExchangeRate",
+			Handler:    _Database_DeleteExchangeRate_Handler,
+		},
+		{
+			MethodName: "SetBaseCurrency",
+			Handler:    _Database_SetBaseCurrency_Handler,
+		},
 		{
 			MethodName: "GetCategoriesCount",
 			Handler:    _Database_GetCategoriesCount_Handler,
@@ -937,11 +2840,30 @@ var Database_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCategories",
 			Handler:    _Database_ResetCategories_Handler,
 		},
+		{
+			MethodName: "SetCategoryAutoReset",
+			Handler:    _Database_SetCategoryAutoReset_Handler,
+		},
+		{
+			MethodName: "SetCategoryGoal",
+			Handler:    _Database_SetCategoryGoal_Handler,
+		},
 		{
 			MethodName: "GetTimePeriods",
 			Handler:    _Database_GetTimePeriods_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _Database_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _Database_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "models.proto",
 }
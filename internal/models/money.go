@@ -0,0 +1,23 @@
+package models
+
+import "github.com/dimitargrozev5/expenses-go-1/internal/money"
+
+// Create grpc money from minor units
+func NewGrpcMoney(amount int64, currency string) *GrpcMoney {
+	return &GrpcMoney{Amount: amount, Currency: money.Normalize(currency)}
+}
+
+// Create grpc money from money
+func MoneyToGrpc(m money.Money) *GrpcMoney {
+	return NewGrpcMoney(m.Amount, m.Currency)
+}
+
+// Convert to money. Nil is zero in the default currency
+func (x *GrpcMoney) Money() money.Money {
+	return money.New(x.GetAmount(), x.GetCurrency())
+}
+
+// Format as a plain decimal, e.g. 12.34
+func (x *GrpcMoney) Decimal() string {
+	return x.Money().Decimal()
+}
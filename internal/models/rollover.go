@@ -0,0 +1,33 @@
+package models
+
+// Rollover policies applied to the leftover funds of a category when its period is reset
+const (
+	RolloverCarry  = "carry"
+	RolloverReturn = "return"
+	RolloverCap    = "cap"
+	RolloverDeduct = "deduct"
+)
+
+// Rollover policy with a caption for forms
+type RolloverPolicy struct {
+	Policy  string
+	Caption string
+}
+
+// Rollover policies in the order they are offered
+var RolloverPolicies = []RolloverPolicy{
+	{RolloverCarry, "Carry surplus over"},
+	{RolloverReturn, "Return surplus to free funds"},
+	{RolloverCap, "Carry up to N times the budget input"},
+	{RolloverDeduct, "Carry surplus, deduct overspend"},
+}
+
+// Check if policy is a known rollover policy
+func ValidRolloverPolicy(policy string) bool {
+	for _, p := range RolloverPolicies {
+		if p.Policy == policy {
+			return true
+		}
+	}
+	return false
+}
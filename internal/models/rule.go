@@ -0,0 +1,69 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Expense values rules are matched against
+type RuleExpense struct {
+	// Amount in the base currency
+	BaseAmount int64
+	Payee      string
+	AccountId  int64
+	Date       time.Time
+}
+
+// Result of applying rules to an expense. Zero ids are left unchanged
+type RuleResult struct {
+	Tags       []string
+	CategoryId int64
+	AccountId  int64
+	// Ids of the matching rules
+	Matched []int64
+}
+
+// Get the weekdays bit of a day
+func WeekdayBit(day time.Weekday) int64 {
+	return 1 << int64(day)
+}
+
+// Check if an expense meets all conditions of a rule
+func RuleMatches(rule *GrpcRule, e RuleExpense) bool {
+	if rule.MinAmount != nil && e.BaseAmount < rule.MinAmount.GetAmount() {
+		return false
+	}
+	if rule.MaxAmount != nil && e.BaseAmount > rule.MaxAmount.GetAmount() {
+		return false
+	}
+	if text := strings.TrimSpace(rule.Text); text != "" && !strings.Contains(strings.ToLower(e.Payee), strings.ToLower(text)) {
+		return false
+	}
+	if rule.AccountId != 0 && rule.AccountId != e.AccountId {
+		return false
+	}
+	if rule.Weekdays != 0 && rule.Weekdays&WeekdayBit(e.Date.Weekday()) == 0 {
+		return false
+	}
+	return true
+}
+
+// Apply enabled rules in order. Every matching rule adds its tags, the first one to set the category or the account wins
+func ApplyRules(rules []*GrpcRule, e RuleExpense) RuleResult {
+	result := RuleResult{Tags: []string{}, Matched: []int64{}}
+	for _, rule := range rules {
+		if !rule.Enabled || !RuleMatches(rule, e) {
+			continue
+		}
+
+		result.Matched = append(result.Matched, rule.ID)
+		result.Tags = append(result.Tags, rule.AddTags...)
+		if result.CategoryId == 0 {
+			result.CategoryId = rule.SetCategoryId
+		}
+		if result.AccountId == 0 {
+			result.AccountId = rule.SetAccountId
+		}
+	}
+	return result
+}
@@ -0,0 +1,172 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Currency used when no currency code is known
+const DefaultCurrency = "EUR"
+
+var (
+	ErrInvalid   = errors.New("invalid amount")
+	ErrPrecision = errors.New("amount has too many decimal places")
+	ErrOverflow  = errors.New("amount is too large")
+
+	ErrInvalidCurrency = errors.New("currency must be a three letter code")
+)
+
+// Currencies whose minor unit isn't a hundredth (ISO 4217)
+var exponents = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"VND": 0,
+}
+
+// Money is an amount stored in the minor units of its currency (e.g. cents)
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// New returns money in the given currency
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: Normalize(currency)}
+}
+
+// Normalize upper-cases a currency code and falls back to the default currency
+func Normalize(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return DefaultCurrency
+	}
+	return currency
+}
+
+// ValidCurrency checks the currency is a three letter code like EUR
+func ValidCurrency(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Exponent returns the number of decimal places of the currency's minor unit
+func Exponent(currency string) int {
+	exp, ok := exponents[Normalize(currency)]
+	if !ok {
+		return 2
+	}
+	return exp
+}
+
+// Parse reads a decimal string like "12.34" or "-0,5" into minor units without going through floats
+func Parse(s string, currency string) (Money, error) {
+	amount, err := ParseAmount(s, currency)
+	if err != nil {
+		return Money{}, err
+	}
+	return New(amount, currency), nil
+}
+
+// ParseAmount reads a decimal string into minor units of the currency
+func ParseAmount(s string, currency string) (int64, error) {
+	return parseDecimal(s, Exponent(currency))
+}
+
+// ParsePercent reads a percent like "4.25" into basis points (hundredths of a percent)
+func ParsePercent(s string) (int64, error) {
+	return parseDecimal(s, 2)
+}
+
+// Read a decimal string into an integer of units of 10^-exp
+func parseDecimal(s string, exp int) (int64, error) {
+	// Strip sign
+	s = strings.TrimSpace(s)
+	negative := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	// Both dot and comma are accepted as decimal separator
+	s = strings.Replace(s, ",", ".", 1)
+	whole, frac, hasFrac := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return 0, ErrInvalid
+	}
+	if hasFrac && frac == "" {
+		return 0, ErrInvalid
+	}
+
+	// Only digits are left. A second separator (e.g. "1,234.56") is invalid, not too precise
+	for _, r := range whole + frac {
+		if r < '0' || r > '9' {
+			return 0, ErrInvalid
+		}
+	}
+
+	// Drop trailing zeros that don't change the value
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > exp {
+		return 0, ErrPrecision
+	}
+	frac = frac + strings.Repeat("0", exp-len(frac))
+
+	// Accumulate digits
+	var amount int64
+	for _, r := range whole + frac {
+		if amount > (math.MaxInt64-int64(r-'0'))/10 {
+			return 0, ErrOverflow
+		}
+		amount = amount*10 + int64(r-'0')
+	}
+
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// Format writes minor units as a decimal string with the currency's precision
+func Format(amount int64, currency string) string {
+	exp := Exponent(currency)
+
+	// Work with the absolute value as unsigned to handle math.MinInt64
+	sign := ""
+	abs := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		abs = uint64(-(amount + 1)) + 1
+	}
+
+	digits := fmt.Sprintf("%0*d", exp+1, abs)
+	if exp == 0 {
+		return sign + digits
+	}
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+// String formats money as a decimal followed by its currency code
+func (m Money) String() string {
+	return fmt.Sprintf("%s %s", Format(m.Amount, m.Currency), Normalize(m.Currency))
+}
+
+// Decimal formats money as a plain decimal, suitable for form inputs
+func (m Money) Decimal() string {
+	return Format(m.Amount, m.Currency)
+}
@@ -0,0 +1,240 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		currency string
+		expected int64
+		err      error
+	}{
+		// Signs
+		{"plain", "12.34", "EUR", 1234, nil},
+		{"negative", "-12.34", "EUR", -1234, nil},
+		{"plus sign", "+12.34", "EUR", 1234, nil},
+		{"negative zero", "-0", "EUR", 0, nil},
+		{"surrounding spaces", "  -5  ", "EUR", -500, nil},
+		{"double sign", "--5", "EUR", 0, ErrInvalid},
+		{"sign only", "-", "EUR", 0, ErrInvalid},
+		{"sign after digits", "5-", "EUR", 0, ErrInvalid},
+
+		// Decimal separators
+		{"comma separator", "12,34", "EUR", 1234, nil},
+		{"no whole part", ".5", "EUR", 50, nil},
+		{"comma without whole part", ",05", "EUR", 5, nil},
+		{"no fraction after separator", "5.", "EUR", 0, ErrInvalid},
+		{"separator only", ".", "EUR", 0, ErrInvalid},
+		{"empty", "", "EUR", 0, ErrInvalid},
+
+		// Thousands separators aren't accepted
+		{"comma thousands with dot decimal", "1,234.56", "EUR", 0, ErrInvalid},
+		{"dot thousands with comma decimal", "1.234,56", "EUR", 0, ErrInvalid},
+		{"two dot groups", "1.234.567", "EUR", 0, ErrInvalid},
+		{"space group", "1 234", "EUR", 0, ErrInvalid},
+		{"no-break space group", "1\u00a0234", "EUR", 0, ErrInvalid},
+		{"single comma group reads as fraction", "1,234", "EUR", 0, ErrPrecision},
+
+		// Fraction digits
+		{"one fraction digit", "1.5", "EUR", 150, nil},
+		{"trailing zeros", "1.500", "EUR", 150, nil},
+		{"too many fraction digits", "1.505", "EUR", 0, ErrPrecision},
+		{"zero exponent currency", "100", "JPY", 100, nil},
+		{"zero exponent with zero fraction", "100.0", "JPY", 100, nil},
+		{"zero exponent with fraction", "100.5", "JPY", 0, ErrPrecision},
+		{"three digit exponent", "1.234", "BHD", 1234, nil},
+		{"three digit exponent too precise", "1.2345", "BHD", 0, ErrPrecision},
+		{"lower case currency", "1.5", "bhd", 1500, nil},
+
+		// Range of int64
+		{"max", "92233720368547758.07", "EUR", math.MaxInt64, nil},
+		{"max negated", "-92233720368547758.07", "EUR", -math.MaxInt64, nil},
+		{"over max", "92233720368547758.08", "EUR", 0, ErrOverflow},
+		{"min isn't reachable", "-92233720368547758.08", "EUR", 0, ErrOverflow},
+		{"many digits", "100000000000000000000", "JPY", 0, ErrOverflow},
+
+		// Not numbers
+		{"letters", "abc", "EUR", 0, ErrInvalid},
+		{"exponent", "1e3", "EUR", 0, ErrInvalid},
+		{"currency symbol", "€5", "EUR", 0, ErrInvalid},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			received, err := ParseAmount(test.input, test.currency)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("wrong error for %q; expected %v; received %v", test.input, test.err, err)
+			}
+			if received != test.expected {
+				t.Errorf("wrong amount for %q; expected %d; received %d", test.input, test.expected, received)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		expected string
+	}{
+		{0, "EUR", "0.00"},
+		{1, "EUR", "0.01"},
+		{-1, "EUR", "-0.01"},
+		{99, "EUR", "0.99"},
+		{-99, "EUR", "-0.99"},
+		{100, "EUR", "1.00"},
+		{123456, "EUR", "1234.56"},
+		{-123456, "EUR", "-1234.56"},
+		{5, "", "0.05"},
+		{100, "JPY", "100"},
+		{-100, "JPY", "-100"},
+		{0, "JPY", "0"},
+		{1, "BHD", "0.001"},
+		{-1234, "BHD", "-1.234"},
+		{math.MaxInt64, "EUR", "92233720368547758.07"},
+		{math.MinInt64, "EUR", "-92233720368547758.08"},
+		{math.MinInt64, "JPY", "-9223372036854775808"},
+	}
+
+	for _, test := range tests {
+		received := Format(test.amount, test.currency)
+		if received != test.expected {
+			t.Errorf("wrong format of %d %s; expected %s; received %s", test.amount, test.currency, test.expected, received)
+		}
+	}
+}
+
+// Formatted amounts parse back to the same minor units
+func TestFormatParse(t *testing.T) {
+	amounts := []int64{0, 1, -1, 50, -50, 1234, -1234, 100000, math.MaxInt64, -math.MaxInt64}
+	for _, currency := range []string{"EUR", "JPY", "BHD"} {
+		for _, amount := range amounts {
+			received, err := ParseAmount(Format(amount, currency), currency)
+			if err != nil || received != amount {
+				t.Errorf("%d %s didn't round trip; received %d, %v", amount, currency, received, err)
+			}
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		from     string
+		rate     string
+		to       string
+		expected int64
+		err      error
+	}{
+		{"exact", 1000, "EUR", "1.95583", "BGN", 1956, nil},
+		{"identity", 1234, "EUR", "1", "EUR", 1234, nil},
+
+		// Halves round away from zero
+		{"half rounds up", 5, "EUR", "0.5", "USD", 3, nil},
+		{"negative half rounds down", -5, "EUR", "0.5", "USD", -3, nil},
+		{"just below half rounds down", 1, "EUR", "0.4999", "USD", 0, nil},
+		{"negative just below half rounds up", -1, "EUR", "0.4999", "USD", 0, nil},
+		{"just above half rounds up", 1, "EUR", "0.5001", "USD", 1, nil},
+		{"negative just above half rounds down", -1, "EUR", "0.5001", "USD", -1, nil},
+		{"one rounding for the whole product", 3, "EUR", "0.1666666667", "USD", 1, nil},
+
+		// Minor units of different sizes
+		{"to zero exponent", 100, "EUR", "160.5", "JPY", 161, nil},
+		{"to zero exponent negative", -100, "EUR", "160.5", "JPY", -161, nil},
+		{"from zero exponent", 161, "JPY", "0.00623", "EUR", 100, nil},
+		{"to three digit exponent", 100, "EUR", "0.4", "BHD", 400, nil},
+		{"from three digit exponent", 1005, "BHD", "2.5", "EUR", 251, nil},
+
+		// Range of int64
+		{"overflow", math.MaxInt64, "EUR", "2", "USD", 0, ErrOverflow},
+		{"overflow from scale", math.MaxInt64 / 10, "JPY", "1", "BHD", 0, ErrOverflow},
+		{"negative overflow", -math.MaxInt64, "EUR", "1.5", "USD", 0, ErrOverflow},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rate, err := ParseRate(test.rate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			received, err := Convert(New(test.amount, test.from), rate, test.to)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("wrong error; expected %v; received %v", test.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if received.Amount != test.expected || received.Currency != test.to {
+				t.Errorf("wrong conversion; expected %d %s; received %d %s", test.expected, test.to, received.Amount, received.Currency)
+			}
+		})
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	valid := map[string]string{
+		"1.95583":  "1.95583",
+		"1,95583":  "1.95583",
+		" 2 ":      "2",
+		"0.000001": "0.000001",
+		"1.50":     "1.5",
+	}
+	for input, expected := range valid {
+		rate, err := ParseRate(input)
+		if err != nil {
+			t.Errorf("rate %q failed: %v", input, err)
+			continue
+		}
+		if received := FormatRate(rate); received != expected {
+			t.Errorf("wrong rate for %q; expected %s; received %s", input, expected, received)
+		}
+	}
+
+	for _, input := range []string{"", "0", "0.0", "-1", "1/3", "1e3", "abc", "1.2.3"} {
+		_, err := ParseRate(input)
+		if !errors.Is(err, ErrInvalidRate) {
+			t.Errorf("rate %q should be invalid; received %v", input, err)
+		}
+	}
+}
+
+func TestParsePercent(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		err      error
+	}{
+		{"4.25", 425, nil},
+		{"4,25", 425, nil},
+		{"0", 0, nil},
+		{"12", 1200, nil},
+		{"0.5", 50, nil},
+		{"1.250", 125, nil},
+		{"1.255", 0, ErrPrecision},
+		{"NaN", 0, ErrInvalid},
+		{"Inf", 0, ErrInvalid},
+		{"+Inf", 0, ErrInvalid},
+		{"1e2", 0, ErrInvalid},
+		{"", 0, ErrInvalid},
+		{"99999999999999999999", 0, ErrOverflow},
+	}
+
+	for _, test := range tests {
+		received, err := ParsePercent(test.input)
+		if !errors.Is(err, test.err) {
+			t.Errorf("wrong error for %q; expected %v; received %v", test.input, test.err, err)
+			continue
+		}
+		if received != test.expected {
+			t.Errorf("wrong basis points for %q; expected %d; received %d", test.input, test.expected, received)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+var ErrInvalidRate = errors.New("exchange rate must be a positive decimal")
+
+// ParseRate reads an exchange rate like "1.95583". Rates are exact rationals so conversions only round once
+func ParseRate(s string) (*big.Rat, error) {
+	s = strings.Replace(strings.TrimSpace(s), ",", ".", 1)
+
+	// Only plain decimals, no fractions or exponents
+	for _, r := range s {
+		if (r < '0' || r > '9') && r != '.' {
+			return nil, ErrInvalidRate
+		}
+	}
+
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok || rate.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+
+	return rate, nil
+}
+
+// FormatRate writes a rate as a decimal with up to 10 places
+func FormatRate(rate *big.Rat) string {
+	s := rate.FloatString(10)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// ScaleFactor turns a rate between currencies into a rate between their minor units
+func ScaleFactor(rate *big.Rat, from, to string) *big.Rat {
+	scale := Exponent(to) - Exponent(from)
+	factor := new(big.Rat).Set(rate)
+	if scale > 0 {
+		factor.Mul(factor, new(big.Rat).SetInt(pow10(scale)))
+	} else if scale < 0 {
+		factor.Quo(factor, new(big.Rat).SetInt(pow10(-scale)))
+	}
+	return factor
+}
+
+// Convert money to another currency. Rate is the price of one unit of m.Currency in the target currency
+func Convert(m Money, rate *big.Rat, to string) (Money, error) {
+	factor := ScaleFactor(rate, m.Currency, to)
+
+	// Multiply and round half away from zero
+	value := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), factor)
+	num := new(big.Int).Abs(value.Num())
+	den := value.Denom()
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Mul(r, big.NewInt(2)).Cmp(den) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if value.Sign() < 0 {
+		q.Neg(q)
+	}
+
+	if !q.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+
+	return New(q.Int64(), to), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,281 @@
+package prefs
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+)
+
+// Formats used when the user has no settings. They match how amounts and dates were always shown
+const (
+	DefaultNumberFormat = "1234.56"
+	DefaultDateFormat   = "02.01.2006"
+)
+
+// Expense list ranges
+const (
+	RangeAll        = "all"
+	RangeWeek       = "week"
+	RangeMonth      = "month"
+	RangeLast30Days = "last_30_days"
+	RangeYear       = "year"
+)
+
+// Choice in a settings select
+type Option struct {
+	Value string
+	Label string
+}
+
+// Locale with its default formats
+type Locale struct {
+	Code         string
+	Name         string
+	NumberFormat string
+	DateFormat   string
+}
+
+// Supported locales. The empty one keeps the default formats
+var Locales = []Locale{
+	{Code: "", Name: "Default", NumberFormat: DefaultNumberFormat, DateFormat: DefaultDateFormat},
+	{Code: "en-US", Name: "English (US)", NumberFormat: "1,234.56", DateFormat: "01/02/2006"},
+	{Code: "en-GB", Name: "English (UK)", NumberFormat: "1,234.56", DateFormat: "02/01/2006"},
+	{Code: "de-DE", Name: "Deutsch", NumberFormat: "1.234,56", DateFormat: "02.01.2006"},
+	{Code: "fr-FR", Name: "Français", NumberFormat: "1 234,56", DateFormat: "02/01/2006"},
+	{Code: "bg-BG", Name: "Български", NumberFormat: "1 234,56", DateFormat: "02.01.2006"},
+}
+
+// Separators of a number format
+type separators struct {
+	group   string
+	decimal string
+}
+
+// Supported number formats by their sample. Space groups use a no-break space, so amounts don't wrap
+var numberFormats = map[string]separators{
+	"1234.56":  {group: "", decimal: "."},
+	"1234,56":  {group: "", decimal: ","},
+	"1,234.56": {group: ",", decimal: "."},
+	"1.234,56": {group: ".", decimal: ","},
+	"1 234,56": {group: "\u00a0", decimal: ","},
+	"1'234.56": {group: "'", decimal: "."},
+}
+
+// Number formats in the order they are offered
+var NumberFormats = []string{"1234.56", "1234,56", "1,234.56", "1.234,56", "1 234,56", "1'234.56"}
+
+// Date formats as Go time layouts
+var DateFormats = []Option{
+	{Value: "02.01.2006", Label: "31.12.2024"},
+	{Value: "2006-01-02", Label: "2024-12-31"},
+	{Value: "02/01/2006", Label: "31/12/2024"},
+	{Value: "01/02/2006", Label: "12/31/2024"},
+	{Value: "2 Jan 2006", Label: "31 Dec 2024"},
+	{Value: "Jan 2, 2006", Label: "Dec 31, 2024"},
+}
+
+// Ranges of the expense list
+var ExpenseRanges = []Option{
+	{Value: RangeAll, Label: "All"},
+	{Value: RangeWeek, Label: "This week"},
+	{Value: RangeMonth, Label: "This month"},
+	{Value: RangeLast30Days, Label: "Last 30 days"},
+	{Value: RangeYear, Label: "This year"},
+}
+
+// Display preferences of a user
+type Prefs struct {
+	Locale       string
+	NumberFormat string
+	DateFormat   string
+
+	FirstDayOfWeek time.Weekday
+}
+
+// Preferences of users without settings
+func Default() Prefs {
+	return Prefs{FirstDayOfWeek: time.Monday}
+}
+
+// Check locale is supported. Empty is the default locale
+func ValidLocale(code string) bool {
+	_, ok := findLocale(code)
+	return ok
+}
+
+// Check number format is supported. Empty uses the locale
+func ValidNumberFormat(format string) bool {
+	_, ok := numberFormats[format]
+	return ok || format == ""
+}
+
+// Check date format is supported. Empty uses the locale
+func ValidDateFormat(format string) bool {
+	for _, option := range DateFormats {
+		if option.Value == format {
+			return true
+		}
+	}
+	return format == ""
+}
+
+// Check expense range is supported
+func ValidExpenseRange(r string) bool {
+	for _, option := range ExpenseRanges {
+		if option.Value == r {
+			return true
+		}
+	}
+	return false
+}
+
+func findLocale(code string) (Locale, bool) {
+	for _, locale := range Locales {
+		if locale.Code == code {
+			return locale, true
+		}
+	}
+	return Locales[0], false
+}
+
+// Number format in use. A set format wins over the locale
+func (p Prefs) numberFormat() separators {
+	format := p.NumberFormat
+	if format == "" {
+		locale, _ := findLocale(p.Locale)
+		format = locale.NumberFormat
+	}
+
+	seps, ok := numberFormats[format]
+	if !ok {
+		return numberFormats[DefaultNumberFormat]
+	}
+	return seps
+}
+
+// Date format in use. A set format wins over the locale
+func (p Prefs) dateFormat() string {
+	if p.DateFormat != "" {
+		return p.DateFormat
+	}
+	locale, _ := findLocale(p.Locale)
+	return locale.DateFormat
+}
+
+// Format minor units as a decimal with the separators of the user
+func (p Prefs) Amount(m money.Money) string {
+	s := money.Format(m.Amount, m.Currency)
+	seps := p.numberFormat()
+
+	// Split sign and fraction
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	whole, frac, hasFrac := strings.Cut(s, ".")
+
+	// Group thousands
+	if seps.group != "" {
+		var grouped strings.Builder
+		for i, r := range whole {
+			if i > 0 && (len(whole)-i)%3 == 0 {
+				grouped.WriteString(seps.group)
+			}
+			grouped.WriteRune(r)
+		}
+		whole = grouped.String()
+	}
+
+	if !hasFrac {
+		return sign + whole
+	}
+	return sign + whole + seps.decimal + frac
+}
+
+// Format money as an amount followed by its currency code
+func (p Prefs) Money(m money.Money) string {
+	return p.Amount(m) + " " + money.Normalize(m.Currency)
+}
+
+// Format the date of a time
+func (p Prefs) Date(t time.Time) string {
+	return t.Format(p.dateFormat())
+}
+
+// Format a time of day
+func (p Prefs) Time(t time.Time) string {
+	return t.Format("15:04")
+}
+
+// Format date and time of day
+func (p Prefs) DateTime(t time.Time) string {
+	return p.Date(t) + " " + p.Time(t)
+}
+
+// Days of the week starting from the first day of the user
+func (p Prefs) Weekdays() []time.Weekday {
+	days := make([]time.Weekday, 7)
+	for i := range days {
+		days[i] = (p.FirstDayOfWeek + time.Weekday(i)) % 7
+	}
+	return days
+}
+
+// Get the start of a range that ends now. Returns false for ranges without a start
+func (p Prefs) RangeStart(r string, now time.Time) (time.Time, bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch r {
+	case RangeWeek:
+		offset := (int(today.Weekday()) - int(p.FirstDayOfWeek) + 7) % 7
+		return today.AddDate(0, 0, -offset), true
+	case RangeMonth:
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()), true
+	case RangeLast30Days:
+		return today.AddDate(0, 0, -29), true
+	case RangeYear:
+		return time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location()), true
+	}
+
+	return time.Time{}, false
+}
+
+type ctxKey int
+
+const prefsKey ctxKey = iota
+
+// Store preferences in context, so views can format with them
+func WithPrefs(ctx context.Context, p Prefs) context.Context {
+	return context.WithValue(ctx, prefsKey, p)
+}
+
+// Get preferences from context. Falls back to the defaults
+func Get(ctx context.Context) Prefs {
+	p, ok := ctx.Value(prefsKey).(Prefs)
+	if !ok {
+		return Default()
+	}
+	return p
+}
+
+// Format money with the preferences in context
+func Money(ctx context.Context, m money.Money) string {
+	return Get(ctx).Money(m)
+}
+
+// Format an amount without currency code with the preferences in context
+func Amount(ctx context.Context, m money.Money) string {
+	return Get(ctx).Amount(m)
+}
+
+// Format a date with the preferences in context
+func Date(ctx context.Context, t time.Time) string {
+	return Get(ctx).Date(t)
+}
+
+// Format date and time with the preferences in context
+func DateTime(ctx context.Context, t time.Time) string {
+	return Get(ctx).DateTime(t)
+}
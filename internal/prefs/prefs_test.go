@@ -0,0 +1,78 @@
+package prefs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+)
+
+func TestMoney(t *testing.T) {
+	tests := []struct {
+		prefs    Prefs
+		money    money.Money
+		expected string
+	}{
+		{Default(), money.New(-123456789, "EUR"), "-1234567.89 EUR"},
+		{Prefs{Locale: "de-DE"}, money.New(-123456789, "EUR"), "-1.234.567,89 EUR"},
+		{Prefs{Locale: "en-US"}, money.New(100, "EUR"), "1.00 EUR"},
+		{Prefs{Locale: "fr-FR"}, money.New(1234567, "JPY"), "1 234 567 JPY"},
+		{Prefs{Locale: "de-DE", NumberFormat: "1'234.56"}, money.New(123456, "USD"), "1'234.56 USD"},
+		{Prefs{NumberFormat: "1,234.56"}, money.New(99999, "BHD"), "99.999 BHD"},
+	}
+
+	for _, test := range tests {
+		received := test.prefs.Money(test.money)
+		if received != test.expected {
+			t.Errorf("wrong money for %+v; expected %q; received %q", test.prefs, test.expected, received)
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	date := time.Date(2024, time.December, 31, 18, 5, 0, 0, time.UTC)
+	tests := []struct {
+		prefs    Prefs
+		expected string
+	}{
+		{Default(), "31.12.2024 18:05"},
+		{Prefs{Locale: "en-US"}, "12/31/2024 18:05"},
+		{Prefs{Locale: "en-US", DateFormat: "2006-01-02"}, "2024-12-31 18:05"},
+	}
+
+	for _, test := range tests {
+		received := test.prefs.DateTime(date)
+		if received != test.expected {
+			t.Errorf("wrong date for %+v; expected %q; received %q", test.prefs, test.expected, received)
+		}
+	}
+}
+
+func TestRangeStart(t *testing.T) {
+	// Monday
+	now := time.Date(2026, time.October, 19, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		prefs    Prefs
+		r        string
+		expected time.Time
+	}{
+		{Default(), RangeWeek, time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)},
+		{Prefs{FirstDayOfWeek: time.Sunday}, RangeWeek, time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)},
+		{Default(), RangeMonth, time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)},
+		{Default(), RangeLast30Days, time.Date(2026, time.September, 20, 0, 0, 0, 0, time.UTC)},
+		{Default(), RangeYear, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		received, ok := test.prefs.RangeStart(test.r, now)
+		if !ok || !received.Equal(test.expected) {
+			t.Errorf("wrong start of %s; expected %v; received %v", test.r, test.expected, received)
+		}
+	}
+
+	_, ok := Default().RangeStart(RangeAll, now)
+	if ok {
+		t.Error("range all has a start")
+	}
+}
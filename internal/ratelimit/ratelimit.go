@@ -0,0 +1,110 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Exponential backoff after repeated failures
+type Backoff struct {
+	// Failures that don't cause a delay
+	Free int64
+
+	// Delay after the first failure over Free. It doubles with every next failure
+	Base time.Duration
+
+	// Longest delay. Reaching it locks the key out for that long
+	Max time.Duration
+
+	// Failures older than this are forgotten
+	Window time.Duration
+}
+
+// Get delay after a number of failures
+func (b Backoff) Delay(failures int64) time.Duration {
+	if failures <= b.Free {
+		return 0
+	}
+
+	delay := b.Base
+	for i := b.Free + 1; i < failures; i++ {
+		delay *= 2
+		if delay >= b.Max {
+			return b.Max
+		}
+	}
+
+	return min(delay, b.Max)
+}
+
+// Token bucket of a key
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Token bucket limiter with a bucket per key. Every bucket holds up to Burst tokens
+// and refills at Rate tokens per second. Every call takes one token
+type Limiter struct {
+	Rate  float64
+	Burst int
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+}
+
+// Create a limiter
+func New(rate float64, burst int) *Limiter {
+	return &Limiter{
+		Rate:    rate,
+		Burst:   burst,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Take a token for a key. Returns false and the time until the next token when the bucket is empty
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	return l.allowAt(key, time.Now())
+}
+
+func (l *Limiter) allowAt(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(now)
+
+	// New keys start with a full bucket
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.Burst), last: now}
+		l.buckets[key] = b
+	}
+
+	// Refill
+	b.tokens = min(float64(l.Burst), b.tokens+now.Sub(b.last).Seconds()*l.Rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	wait := time.Duration((1 - b.tokens) / l.Rate * float64(time.Second))
+	return false, wait
+}
+
+// Remove buckets that refilled, so idle keys don't pile up. Runs at most once a minute
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+
+	full := time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= full {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -3,10 +3,15 @@ package dbrepo
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"math/big"
+	"slices"
 	"time"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -16,7 +21,7 @@ func (m *sqliteDBRepo) GetAccounts(params *models.GetAccountsParams) (*models.Ge
 	defer cancel()
 
 	// Define query
-	query := `SELECT id, name, current_amount, usage_count, table_order, created_at, updated_at FROM accounts`
+	query := `SELECT id, name, current_amount, currency, usage_count, table_order, created_at, updated_at FROM accounts`
 
 	// Order by option
 	if params.OrderByPopularity {
@@ -25,6 +30,12 @@ func (m *sqliteDBRepo) GetAccounts(params *models.GetAccountsParams) (*models.Ge
 		query = fmt.Sprintf("%s ORDER BY table_order DESC", query)
 	}
 
+	// Get user currency
+	currency, err := getCurrency(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get rows
 	rows, err := m.DB.QueryContext(ctx, query)
 	if err != nil {
@@ -38,7 +49,7 @@ func (m *sqliteDBRepo) GetAccounts(params *models.GetAccountsParams) (*models.Ge
 	// Scan rows
 	for rows.Next() {
 		// Define base models
-		account := &models.GrpcAccount{}
+		account := &models.GrpcAccount{CurrentAmount: &models.GrpcMoney{}}
 
 		// Get time
 		var createdAt time.Time
@@ -47,7 +58,8 @@ func (m *sqliteDBRepo) GetAccounts(params *models.GetAccountsParams) (*models.Ge
 		err = rows.Scan(
 			&account.ID,
 			&account.Name,
-			&account.CurrentAmount,
+			&account.CurrentAmount.Amount,
+			&account.CurrentAmount.Currency,
 			&account.UsageCount,
 			&account.TableOrder,
 			&createdAt,
@@ -70,7 +82,26 @@ func (m *sqliteDBRepo) GetAccounts(params *models.GetAccountsParams) (*models.Ge
 		return nil, err
 	}
 
-	return &models.GetAccountsReturns{Accounts: accounts}, nil
+	// Convert to base currency with the latest rates
+	total := models.NewGrpcMoney(0, currency)
+	missingRates := make([]string, 0)
+	for _, account := range accounts {
+		base, err := convertMoney(ctx, m.DB, account.CurrentAmount.Money(), currency, time.Now())
+		if errors.Is(err, errNoRate) {
+			// Leave accounts without a rate out of the total
+			if !slices.Contains(missingRates, account.CurrentAmount.Currency) {
+				missingRates = append(missingRates, account.CurrentAmount.Currency)
+			}
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		account.BaseAmount = models.MoneyToGrpc(base)
+		total.Amount += base.Amount
+	}
+
+	return &models.GetAccountsReturns{Accounts: accounts, Total: total, MissingRates: missingRates}, nil
 }
 
 func (m *sqliteDBRepo) GetAccount(id int64, tx *sql.Tx) (*models.GrpcAccount, error) {
@@ -79,13 +110,13 @@ func (m *sqliteDBRepo) GetAccount(id int64, tx *sql.Tx) (*models.GrpcAccount, er
 	defer cancel()
 
 	// Define query
-	query := `SELECT id, name, current_amount, usage_count, table_order, created_at, updated_at FROM accounts WHERE id=$1`
+	query := `SELECT id, name, current_amount, currency, usage_count, table_order, created_at, updated_at FROM accounts WHERE id=$1`
 
 	// Get row
 	row := tx.QueryRowContext(ctx, query, id)
 
 	// Set account
-	account := &models.GrpcAccount{}
+	account := &models.GrpcAccount{CurrentAmount: &models.GrpcMoney{}}
 	var createdAt time.Time
 	var updatedAt sql.NullTime
 
@@ -93,7 +124,8 @@ func (m *sqliteDBRepo) GetAccount(id int64, tx *sql.Tx) (*models.GrpcAccount, er
 	err := row.Scan(
 		&account.ID,
 		&account.Name,
-		&account.CurrentAmount,
+		&account.CurrentAmount.Amount,
+		&account.CurrentAmount.Currency,
 		&account.UsageCount,
 		&account.TableOrder,
 		&createdAt,
@@ -125,19 +157,47 @@ func (m *sqliteDBRepo) AddAccount(params *models.AddAccountParams) (*models.Grpc
 	}
 	defer tx.Rollback()
 
+	// Default to base currency
+	currency := money.Normalize(params.Currency)
+	if params.Currency == "" {
+		currency, err = getCurrency(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Check currency
+	if !money.ValidCurrency(currency) {
+		return nil, domainerr.InvalidArgument(money.ErrInvalidCurrency.Error())
+	}
+
 	// Define query to insert account
-	stmt := `INSERT INTO procedure_insert_account (name) VALUES ($1)`
+	stmt := `INSERT INTO procedure_insert_account (name, currency) VALUES ($1, $2)`
 
 	// Execute query
 	_, err = tx.ExecContext(
 		ctx,
 		stmt,
 		params.Name,
+		currency,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// Take last inserted account
+	var id int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM accounts ORDER BY id DESC LIMIT 1;`).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionAddAccount, auditAccount, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return nil, nil
 }
@@ -154,6 +214,15 @@ func (m *sqliteDBRepo) EditAccountName(params *models.EditAccountNameParams) (*m
 	}
 	defer tx.Rollback()
 
+	// Get account before the changes
+	before, err := auditState(ctx, tx, auditAccount, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, domainerr.NotFound("account not found")
+	}
+
 	// Define query to insert account
 	stmt := `UPDATE procedure_account_update_name SET name = $1 WHERE id = $2`
 
@@ -168,6 +237,12 @@ func (m *sqliteDBRepo) EditAccountName(params *models.EditAccountNameParams) (*m
 		return nil, err
 	}
 
+	// Log action
+	err = audit(ctx, tx, actionRenameAccount, auditAccount, params.ID, before)
+	if err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return nil, nil
 }
@@ -195,6 +270,12 @@ func (m *sqliteDBRepo) DeleteAccount(params *models.DeleteAccountParams) (*model
 		return nil, nil
 	}
 
+	// Get account before it's removed
+	before, err := auditState(ctx, tx, auditAccount, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Setup query to delete account
 	stmt := `DELETE FROM procedure_remove_account WHERE id=$1`
 
@@ -204,11 +285,98 @@ func (m *sqliteDBRepo) DeleteAccount(params *models.DeleteAccountParams) (*model
 		return nil, err
 	}
 
+	// Log action
+	err = audit(ctx, tx, actionDeleteAccount, auditAccount, params.ID, before)
+	if err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return nil, nil
 }
 
 func (m *sqliteDBRepo) TransferFunds(params *models.TransferFundsParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get accounts currencies
+	fromCurrency, err := getAccountCurrency(ctx, tx, params.FromAccount.GetID())
+	if err != nil {
+		return nil, err
+	}
+	toCurrency, err := getAccountCurrency(ctx, tx, params.ToAccount.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Amount is in the currency of the account it is taken from
+	amount, err := inCurrency(params.Amount, fromCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return nil, domainerr.InvalidArgument("amount must be positive")
+	}
+
+	// Get rate, either given or stored
+	var rate *big.Rat
+	if params.Rate != "" && fromCurrency != toCurrency {
+		rate, err = money.ParseRate(params.Rate)
+		if err != nil {
+			return nil, domainerr.InvalidArgument(err.Error())
+		}
+	} else {
+		rate, err = getRate(ctx, tx, fromCurrency, toCurrency, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Convert amount
+	toAmount, err := money.Convert(money.New(amount, fromCurrency), rate, toCurrency)
+	if err != nil {
+		return nil, domainerr.InvalidArgument(err.Error())
+	}
+
+	// Define query
+	stmt := `INSERT INTO procedure_transfer_funds (from_account, to_account, from_amount, to_amount, rate) VALUES ($1, $2, $3, $4, $5)`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		params.FromAccount.GetID(),
+		params.ToAccount.GetID(),
+		amount,
+		toAmount.Amount,
+		money.FormatRate(rate),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Take last inserted transfer
+	var id int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM account_transfers ORDER BY id DESC LIMIT 1;`).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionTransferFunds, auditTransfer, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
 	return nil, nil
 }
 
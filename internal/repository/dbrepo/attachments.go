@@ -0,0 +1,350 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/attachments"
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Attachment store quota when none is configured
+const defaultAttachmentQuota = 50 * 1024 * 1024
+
+// Add attachments to their expenses
+func (m *sqliteDBRepo) getExpenseAttachments(ctx context.Context, expenses map[int64]*models.GrpcExpense) error {
+	// Define query
+	query := `SELECT
+					id,
+					expense_id,
+					name,
+					content_type,
+					size,
+					hash,
+					created_at
+				FROM attachments
+				ORDER BY id;`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	for rows.Next() {
+		attachment := &models.GrpcAttachment{}
+		var createdAt time.Time
+
+		err = rows.Scan(
+			&attachment.ID,
+			&attachment.ExpenseId,
+			&attachment.Name,
+			&attachment.ContentType,
+			&attachment.Size,
+			&attachment.Hash,
+			&createdAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		attachment.CreatedAt = timestamppb.New(createdAt)
+
+		if expense, ok := expenses[attachment.ExpenseId]; ok {
+			expense.Attachments = append(expense.Attachments, attachment)
+		}
+	}
+
+	return rows.Err()
+}
+
+// Get size of the attachment store. Shared content is counted once
+func attachmentsSize(ctx context.Context, q rowQuerier) (int64, error) {
+	// Define query
+	query := `SELECT COALESCE(SUM(size), 0) FROM (SELECT DISTINCT hash, size FROM attachments)`
+
+	// Get size
+	var size int64
+	err := q.QueryRowContext(ctx, query).Scan(&size)
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// Get attachment store quota
+func (m *sqliteDBRepo) attachmentQuota() int64 {
+	if m.App == nil || m.App.AttachmentQuota <= 0 {
+		return defaultAttachmentQuota
+	}
+	return m.App.AttachmentQuota
+}
+
+// Add attachment to an expense. Content is read until the end of the stream
+func (m *sqliteDBRepo) AddAttachment(params *models.GrpcAttachment, content io.Reader) (*models.GrpcAttachment, error) {
+	// Check attachment store
+	if m.Files == nil {
+		return nil, fmt.Errorf("attachment store is not available")
+	}
+
+	// Check name
+	name := strings.TrimSpace(params.GetName())
+	if name == "" {
+		return nil, domainerr.InvalidArgument("attachment name is required")
+	}
+	contentType := params.GetContentType()
+	if _, _, err := mime.ParseMediaType(contentType); err != nil {
+		contentType = "application/octet-stream"
+	}
+
+	// Get space left
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	used, err := attachmentsSize(ctx, m.DB)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	left := m.attachmentQuota() - used
+	if left <= 0 {
+		return nil, domainerr.New(codes.ResourceExhausted, domainerr.ReasonQuotaExceeded, "attachment quota exceeded")
+	}
+
+	// Write content to the store. This takes as long as the upload
+	hash, size, err := m.Files.Put(content, left)
+	if errors.Is(err, attachments.ErrTooLarge) {
+		return nil, domainerr.New(codes.ResourceExhausted, domainerr.ReasonQuotaExceeded, "attachment quota exceeded")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Define context with timeout
+	ctx, cancel = context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Add attachment and remove content nobody uses when that fails
+	attachment, err := m.insertAttachment(ctx, params.GetExpenseId(), name, contentType, size, hash)
+	if err != nil {
+		m.pruneAttachments(ctx, []string{hash})
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// Insert attachment details
+func (m *sqliteDBRepo) insertAttachment(ctx context.Context, expenseId int64, name, contentType string, size int64, hash string) (*models.GrpcAttachment, error) {
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Define query
+	stmt := `INSERT INTO procedure_new_attachment (
+		expense_id,
+		name,
+		content_type,
+		size,
+		hash
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5
+	)`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, expenseId, name, contentType, size, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Take last inserted attachment
+	// Can't use RETURNING because the insert happens through a trigger
+	query := `SELECT id, created_at FROM attachments ORDER BY id DESC LIMIT 1;`
+
+	// Get attachment
+	attachment := &models.GrpcAttachment{
+		ExpenseId:   expenseId,
+		Name:        name,
+		ContentType: contentType,
+		Size:        size,
+		Hash:        hash,
+	}
+	var createdAt time.Time
+	err = tx.QueryRowContext(ctx, query).Scan(&attachment.ID, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	attachment.CreatedAt = timestamppb.New(createdAt)
+
+	tx.Commit()
+	return attachment, nil
+}
+
+// Open attachment content. The caller closes it
+func (m *sqliteDBRepo) OpenAttachment(params *models.GetAttachmentParams) (*models.GrpcAttachment, io.ReadCloser, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Check attachment store
+	if m.Files == nil {
+		return nil, nil, fmt.Errorf("attachment store is not available")
+	}
+
+	// Define query
+	query := `SELECT
+					id,
+					expense_id,
+					name,
+					content_type,
+					size,
+					hash,
+					created_at
+				FROM attachments
+				WHERE id = $1;`
+
+	// Get attachment
+	attachment := &models.GrpcAttachment{}
+	var createdAt time.Time
+	err := m.DB.QueryRowContext(ctx, query, params.ID).Scan(
+		&attachment.ID,
+		&attachment.ExpenseId,
+		&attachment.Name,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.Hash,
+		&createdAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil, domainerr.NotFound("attachment not found")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	attachment.CreatedAt = timestamppb.New(createdAt)
+
+	// Open content
+	file, err := m.Files.Open(attachment.Hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, file, nil
+}
+
+// Delete attachment. Its content is removed when no other attachment uses it
+func (m *sqliteDBRepo) DeleteAttachment(params *models.DeleteAttachmentParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get content hash
+	hashes, err := attachmentHashes(ctx, tx, `SELECT hash FROM attachments WHERE id = $1`, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(hashes) == 0 {
+		return nil, domainerr.NotFound("attachment not found")
+	}
+
+	// Define query
+	stmt := `DELETE FROM procedure_remove_attachment WHERE id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+
+	// Remove content
+	m.pruneAttachments(ctx, hashes)
+
+	return nil, nil
+}
+
+// Get content hashes of attachments
+func attachmentHashes(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]string, error) {
+	// Get rows
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	hashes := make([]string, 0)
+	for rows.Next() {
+		var hash string
+		err = rows.Scan(&hash)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, rows.Err()
+}
+
+// Remove content no attachment uses. Content of removed attachments is kept while undo can put them back.
+// Failures leave unused files behind, which is only wasted space
+func (m *sqliteDBRepo) pruneAttachments(ctx context.Context, hashes []string) {
+	if m.Files == nil || len(hashes) == 0 {
+		return
+	}
+
+	// Get content undo can still use
+	undoable, err := undoableAttachments(ctx, m.DB)
+	if err != nil {
+		if m.App != nil {
+			m.App.Logger.ErrorContext(ctx, "can't get attachments held for undo", "error", err)
+		}
+		return
+	}
+	held := map[string]bool{}
+	for _, hash := range undoable {
+		held[hash] = true
+	}
+
+	for _, hash := range hashes {
+		if held[hash] {
+			continue
+		}
+
+		var used bool
+		err := m.DB.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM attachments WHERE hash = $1)`, hash).Scan(&used)
+		if err != nil || used {
+			continue
+		}
+
+		err = m.Files.Remove(hash)
+		if err != nil && m.App != nil {
+			m.App.Logger.ErrorContext(ctx, "can't remove attachment content", "hash", hash, "error", err)
+		}
+	}
+}
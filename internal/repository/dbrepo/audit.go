@@ -0,0 +1,1433 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// First user db version with the audit log
+const auditVersion = 14
+
+// First user db version where undo puts back refunds and attachments
+const undoVersion = 20
+
+// Number of entries shown in the audit log
+const auditLogLimit = 100
+
+// Audited records
+const (
+	auditExpense  = "expense"
+	auditAccount  = "account"
+	auditTransfer = "transfer"
+	auditCategory = "category"
+	auditIncome   = "income"
+	auditRefund   = "refund"
+)
+
+// Audited actions
+const (
+	actionAddExpense     = "add_expense"
+	actionEditExpense    = "edit_expense"
+	actionDeleteExpense  = "delete_expense"
+	actionAddAccount     = "add_account"
+	actionRenameAccount  = "rename_account"
+	actionDeleteAccount  = "delete_account"
+	actionTransferFunds  = "transfer_funds"
+	actionAddCategory    = "add_category"
+	actionDeleteCategory = "delete_category"
+	actionResetCategory  = "reset_category"
+	actionAutoReset      = "auto_reset_category"
+	actionAddFreeFunds   = "add_free_funds"
+	actionAddIncome      = "add_income"
+	actionEditIncome     = "edit_income"
+	actionDeleteIncome   = "delete_income"
+	actionAddRefund      = "add_refund"
+	actionDeleteRefund   = "delete_refund"
+	actionReimbursement  = "set_reimbursement"
+	actionSetGoal        = "set_category_goal"
+	actionSetAutoReset   = "set_category_auto_reset"
+	actionPayLiability   = "add_liability_payment"
+	actionBaseCurrency   = "change_base_currency"
+	actionUndo           = "undo"
+)
+
+// Actions that can be undone. Resets archive the category period, liability payments
+// change the liability balance and base currency changes convert every amount, so they can't.
+// Undo stops at them, so actions before them can't be undone either
+var undoableActions = map[string]bool{
+	actionAddExpense:     true,
+	actionEditExpense:    true,
+	actionDeleteExpense:  true,
+	actionAddAccount:     true,
+	actionRenameAccount:  true,
+	actionDeleteAccount:  true,
+	actionTransferFunds:  true,
+	actionAddCategory:    true,
+	actionDeleteCategory: true,
+	actionAddFreeFunds:   true,
+	actionAddIncome:      true,
+	actionEditIncome:     true,
+	actionDeleteIncome:   true,
+	actionAddRefund:      true,
+	actionDeleteRefund:   true,
+	actionReimbursement:  true,
+	actionSetGoal:        true,
+	actionSetAutoReset:   true,
+}
+
+// Audited state of an expense. Split lines, refunds and attachments are kept with their expense
+type expenseState struct {
+	ID              int64              `json:"id"`
+	ParentID        *int64             `json:"parent_id"`
+	PayeeID         *int64             `json:"payee_id"`
+	Amount          int64              `json:"amount"`
+	Currency        string             `json:"currency"`
+	BaseAmount      int64              `json:"base_amount"`
+	Date            time.Time          `json:"date"`
+	FromAccount     int64              `json:"from_account"`
+	FromCategory    int64              `json:"from_category"`
+	Notes           string             `json:"notes"`
+	Tags            []string           `json:"tags"`
+	Splits          []*expenseState    `json:"splits,omitempty"`
+	ReimburseFrom   *string            `json:"reimburse_from,omitempty"`
+	ReimburseAmount *int64             `json:"reimburse_amount,omitempty"`
+	Refunds         []*refundState     `json:"refunds,omitempty"`
+	Attachments     []*attachmentState `json:"attachments,omitempty"`
+}
+
+// Audited state of a refund
+type refundState struct {
+	ID           int64     `json:"id"`
+	ExpenseID    int64     `json:"expense_id"`
+	Kind         string    `json:"kind"`
+	Counterparty string    `json:"counterparty"`
+	Amount       int64     `json:"amount"`
+	Currency     string    `json:"currency"`
+	BaseAmount   int64     `json:"base_amount"`
+	ToAccount    int64     `json:"to_account"`
+	Date         time.Time `json:"date"`
+	FromPeriod   *int64    `json:"from_period"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Audited state of an attachment. Its content is kept while the removal can be undone
+type attachmentState struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Hash        string    `json:"hash"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Audited state of an account
+type accountState struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	Currency      string `json:"currency"`
+	CurrentAmount int64  `json:"current_amount"`
+}
+
+// Audited state of a transfer between accounts
+type transferState struct {
+	ID          int64  `json:"id"`
+	FromAccount int64  `json:"from_account"`
+	ToAccount   int64  `json:"to_account"`
+	FromAmount  int64  `json:"from_amount"`
+	ToAmount    int64  `json:"to_amount"`
+	Rate        string `json:"rate"`
+}
+
+// Audited state of a category
+type categoryState struct {
+	ID             int64      `json:"id"`
+	Name           string     `json:"name"`
+	BudgetInput    int64      `json:"budget_input"`
+	LastInputDate  time.Time  `json:"last_input_date"`
+	InputInterval  int64      `json:"input_interval"`
+	InputPeriod    int64      `json:"input_period"`
+	SpendingLimit  int64      `json:"spending_limit"`
+	SpendingLeft   int64      `json:"spending_left"`
+	InitialAmount  int64      `json:"initial_amount"`
+	CurrentAmount  int64      `json:"current_amount"`
+	RolloverPolicy string     `json:"rollover_policy"`
+	RolloverCap    int64      `json:"rollover_cap"`
+	AutoReset      bool       `json:"auto_reset"`
+	GoalAmount     int64      `json:"goal_amount"`
+	GoalDate       *time.Time `json:"goal_date"`
+}
+
+// Audited state of an income
+type incomeState struct {
+	ID         int64      `json:"id"`
+	Amount     int64      `json:"amount"`
+	Currency   string     `json:"currency"`
+	BaseAmount int64      `json:"base_amount"`
+	Date       *time.Time `json:"date"`
+	Source     string     `json:"source"`
+	ToAccount  int64      `json:"to_account"`
+	TagID      int64      `json:"tag_id"`
+	Tags       []string   `json:"tags"`
+}
+
+// Audit log entry
+type auditEntry struct {
+	id       int64
+	action   string
+	entity   string
+	entityId int64
+	before   sql.NullString
+	after    sql.NullString
+}
+
+// Get audited state of a record. Returns nil when it doesn't exist
+func auditState(ctx context.Context, tx *sql.Tx, entity string, id int64) (any, error) {
+	switch entity {
+	case auditExpense:
+		state, err := getExpenseState(ctx, tx, id)
+		if state == nil {
+			return nil, err
+		}
+		return state, err
+	case auditAccount:
+		state, err := getAccountState(ctx, tx, id)
+		if state == nil {
+			return nil, err
+		}
+		return state, err
+	case auditTransfer:
+		state, err := getTransferState(ctx, tx, id)
+		if state == nil {
+			return nil, err
+		}
+		return state, err
+	case auditCategory:
+		state, err := getCategoryState(ctx, tx, id)
+		if state == nil {
+			return nil, err
+		}
+		return state, err
+	case auditIncome:
+		state, err := getIncomeState(ctx, tx, id)
+		if state == nil {
+			return nil, err
+		}
+		return state, err
+	case auditRefund:
+		state, err := getRefundState(ctx, tx, id)
+		if state == nil {
+			return nil, err
+		}
+		return state, err
+	}
+
+	return nil, fmt.Errorf("unknown audited record %q", entity)
+}
+
+// Get expense state with its tags, split lines, refunds and attachments
+func getExpenseState(ctx context.Context, tx *sql.Tx, id int64) (*expenseState, error) {
+	// Define query
+	query := `SELECT
+				id,
+				parent_id,
+				payee_id,
+				amount,
+				currency,
+				base_amount,
+				date,
+				from_account,
+				from_category,
+				notes,
+				reimburse_from,
+				reimburse_amount
+			FROM expenses
+			WHERE id = $1`
+
+	// Get expense
+	state := &expenseState{}
+	var parentId, payeeId, reimburseAmount sql.NullInt64
+	var reimburseFrom sql.NullString
+	err := tx.QueryRowContext(ctx, query, id).Scan(
+		&state.ID,
+		&parentId,
+		&payeeId,
+		&state.Amount,
+		&state.Currency,
+		&state.BaseAmount,
+		&state.Date,
+		&state.FromAccount,
+		&state.FromCategory,
+		&state.Notes,
+		&reimburseFrom,
+		&reimburseAmount,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if parentId.Valid {
+		state.ParentID = &parentId.Int64
+	}
+	if payeeId.Valid {
+		state.PayeeID = &payeeId.Int64
+	}
+	if reimburseFrom.Valid {
+		state.ReimburseFrom = &reimburseFrom.String
+	}
+	if reimburseAmount.Valid {
+		state.ReimburseAmount = &reimburseAmount.Int64
+	}
+
+	// Get tags
+	state.Tags, err = tagNames(ctx, tx, `SELECT t.name FROM expense_tags et JOIN tags t ON t.id = et.tag_id WHERE et.expense_id = $1 ORDER BY t.name`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get refunds
+	state.Refunds, err = refundStates(ctx, tx, `WHERE expense_id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split lines don't have lines or attachments of their own
+	if state.ParentID != nil {
+		return state, nil
+	}
+
+	// Get attachments
+	state.Attachments, err = attachmentStates(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get split lines
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM expenses WHERE parent_id = $1 ORDER BY id`, id)
+	if err != nil {
+		return nil, err
+	}
+	splitIds := make([]int64, 0)
+	for rows.Next() {
+		var splitId int64
+		err = rows.Scan(&splitId)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		splitIds = append(splitIds, splitId)
+	}
+	rows.Close()
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, splitId := range splitIds {
+		split, err := getExpenseState(ctx, tx, splitId)
+		if err != nil {
+			return nil, err
+		}
+		state.Splits = append(state.Splits, split)
+	}
+
+	return state, nil
+}
+
+// Get refund state
+func getRefundState(ctx context.Context, tx *sql.Tx, id int64) (*refundState, error) {
+	refunds, err := refundStates(ctx, tx, `WHERE id = $1`, id)
+	if err != nil || len(refunds) == 0 {
+		return nil, err
+	}
+
+	return refunds[0], nil
+}
+
+// Get states of the refunds matching a condition
+func refundStates(ctx context.Context, tx *sql.Tx, where string, id int64) ([]*refundState, error) {
+	// Define query
+	query := `SELECT
+				id,
+				expense_id,
+				kind,
+				counterparty,
+				amount,
+				currency,
+				base_amount,
+				to_account,
+				date,
+				from_period,
+				created_at
+			FROM expense_refunds
+			` + where + `
+			ORDER BY id`
+
+	// Get rows
+	rows, err := tx.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	var refunds []*refundState
+	for rows.Next() {
+		refund := &refundState{}
+		var fromPeriod sql.NullInt64
+
+		err = rows.Scan(
+			&refund.ID,
+			&refund.ExpenseID,
+			&refund.Kind,
+			&refund.Counterparty,
+			&refund.Amount,
+			&refund.Currency,
+			&refund.BaseAmount,
+			&refund.ToAccount,
+			&refund.Date,
+			&fromPeriod,
+			&refund.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if fromPeriod.Valid {
+			refund.FromPeriod = &fromPeriod.Int64
+		}
+
+		refunds = append(refunds, refund)
+	}
+
+	return refunds, rows.Err()
+}
+
+// Get states of the attachments of an expense
+func attachmentStates(ctx context.Context, tx *sql.Tx, expenseId int64) ([]*attachmentState, error) {
+	// Define query
+	query := `SELECT id, name, content_type, size, hash, created_at FROM attachments WHERE expense_id = $1 ORDER BY id`
+
+	// Get rows
+	rows, err := tx.QueryContext(ctx, query, expenseId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	var attachments []*attachmentState
+	for rows.Next() {
+		attachment := &attachmentState{}
+
+		err = rows.Scan(
+			&attachment.ID,
+			&attachment.Name,
+			&attachment.ContentType,
+			&attachment.Size,
+			&attachment.Hash,
+			&attachment.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+// Get names of linked tags
+func tagNames(ctx context.Context, q rowsQuerier, query string, id int64) ([]string, error) {
+	// Get rows
+	rows, err := q.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	names := make([]string, 0)
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+
+	return names, rows.Err()
+}
+
+// Get account state
+func getAccountState(ctx context.Context, tx *sql.Tx, id int64) (*accountState, error) {
+	// Define query
+	query := `SELECT id, name, currency, current_amount FROM accounts WHERE id = $1`
+
+	// Get account
+	state := &accountState{}
+	err := tx.QueryRowContext(ctx, query, id).Scan(&state.ID, &state.Name, &state.Currency, &state.CurrentAmount)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Get transfer state
+func getTransferState(ctx context.Context, tx *sql.Tx, id int64) (*transferState, error) {
+	// Define query
+	query := `SELECT id, from_account, to_account, from_amount, to_amount, rate FROM account_transfers WHERE id = $1`
+
+	// Get transfer
+	state := &transferState{}
+	err := tx.QueryRowContext(ctx, query, id).Scan(
+		&state.ID,
+		&state.FromAccount,
+		&state.ToAccount,
+		&state.FromAmount,
+		&state.ToAmount,
+		&state.Rate,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Get category state
+func getCategoryState(ctx context.Context, tx *sql.Tx, id int64) (*categoryState, error) {
+	// Define query
+	query := `SELECT
+				id,
+				name,
+				budget_input,
+				last_input_date,
+				input_interval,
+				input_period,
+				spending_limit,
+				spending_left,
+				initial_amount,
+				current_amount,
+				rollover_policy,
+				rollover_cap,
+				auto_reset,
+				goal_amount,
+				goal_date
+			FROM categories
+			WHERE id = $1`
+
+	// Get category
+	state := &categoryState{}
+	var goalDate sql.NullTime
+	err := tx.QueryRowContext(ctx, query, id).Scan(
+		&state.ID,
+		&state.Name,
+		&state.BudgetInput,
+		&state.LastInputDate,
+		&state.InputInterval,
+		&state.InputPeriod,
+		&state.SpendingLimit,
+		&state.SpendingLeft,
+		&state.InitialAmount,
+		&state.CurrentAmount,
+		&state.RolloverPolicy,
+		&state.RolloverCap,
+		&state.AutoReset,
+		&state.GoalAmount,
+		&goalDate,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if goalDate.Valid {
+		state.GoalDate = &goalDate.Time
+	}
+
+	return state, nil
+}
+
+// Get income state with its tags
+func getIncomeState(ctx context.Context, tx *sql.Tx, id int64) (*incomeState, error) {
+	// Define query
+	query := `SELECT
+				id,
+				amount,
+				currency,
+				base_amount,
+				date,
+				source,
+				account,
+				tag_id
+			FROM accounts_input_log
+			WHERE id = $1`
+
+	// Get income
+	state := &incomeState{}
+	var date sql.NullTime
+	err := tx.QueryRowContext(ctx, query, id).Scan(
+		&state.ID,
+		&state.Amount,
+		&state.Currency,
+		&state.BaseAmount,
+		&date,
+		&state.Source,
+		&state.ToAccount,
+		&state.TagID,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if date.Valid {
+		state.Date = &date.Time
+	}
+
+	// Get tags
+	state.Tags, err = tagNames(ctx, tx, `SELECT t.name FROM income_tags it JOIN tags t ON t.id = it.tag_id WHERE it.income_id = $1 ORDER BY t.name`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Encode state for the audit log. Missing records are null
+func encodeState(state any) (sql.NullString, error) {
+	if state == nil {
+		return sql.NullString{}, nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// Record an action with the state of the record before it. The state after is read from the db
+func audit(ctx context.Context, tx *sql.Tx, action, entity string, id int64, before any) error {
+	after, err := auditState(ctx, tx, entity, id)
+	if err != nil {
+		return err
+	}
+
+	return logAction(ctx, tx, action, entity, id, before, after, sql.NullInt64{})
+}
+
+// Add an entry to the audit log
+func logAction(ctx context.Context, tx *sql.Tx, action, entity string, id int64, before, after any, undoOf sql.NullInt64) error {
+	// Encode states
+	beforeJSON, err := encodeState(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := encodeState(after)
+	if err != nil {
+		return err
+	}
+
+	// Define query
+	stmt := `INSERT INTO procedure_log_action (action, entity, entity_id, before, after, undo_of) VALUES ($1, $2, $3, $4, $5, $6)`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, action, entity, id, beforeJSON, afterJSON, undoOf)
+	return err
+}
+
+// Get the most recent entry that isn't an undo or undone. Returns nil when there is none
+func lastAction(ctx context.Context, q rowQuerier) (*auditEntry, error) {
+	// Define query
+	query := `SELECT
+				id,
+				action,
+				entity,
+				entity_id,
+				before,
+				after
+			FROM audit_log a
+			WHERE undo_of IS NULL
+				AND NOT EXISTS (SELECT 1 FROM audit_log u WHERE u.undo_of = a.id)
+			ORDER BY id DESC
+			LIMIT 1`
+
+	// Get entry
+	entry := &auditEntry{}
+	err := q.QueryRowContext(ctx, query).Scan(
+		&entry.id,
+		&entry.action,
+		&entry.entity,
+		&entry.entityId,
+		&entry.before,
+		&entry.after,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Get content hashes of removed attachments that undo can still put back.
+// Undo goes back through the newest actions until one that can't be undone
+func undoableAttachments(ctx context.Context, q rowsQuerier) ([]string, error) {
+	// Define query. Only removed expenses keep attachments
+	query := `SELECT
+				action,
+				CASE WHEN action = $1 THEN before ELSE '' END
+			FROM audit_log a
+			WHERE undo_of IS NULL
+				AND NOT EXISTS (SELECT 1 FROM audit_log u WHERE u.undo_of = a.id)
+			ORDER BY id DESC`
+
+	// Get rows
+	rows, err := q.QueryContext(ctx, query, actionDeleteExpense)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	hashes := make([]string, 0)
+	for rows.Next() {
+		var action string
+		var before sql.NullString
+		err = rows.Scan(&action, &before)
+		if err != nil {
+			return nil, err
+		}
+
+		if !undoableActions[action] {
+			break
+		}
+		if action != actionDeleteExpense {
+			continue
+		}
+
+		var state expenseState
+		err = json.Unmarshal([]byte(before.String), &state)
+		if err != nil {
+			return nil, err
+		}
+		for _, attachment := range state.Attachments {
+			hashes = append(hashes, attachment.Hash)
+		}
+	}
+
+	return hashes, rows.Err()
+}
+
+// Get audit log, most recent entries first
+func (m *sqliteDBRepo) GetAuditLog(params *models.GrpcEmpty) (*models.GetAuditLogReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	query := `SELECT
+				a.id,
+				a.action,
+				a.entity,
+				a.entity_id,
+				COALESCE(a.before, ''),
+				COALESCE(a.after, ''),
+				COALESCE(a.undo_of, 0),
+				EXISTS (SELECT 1 FROM audit_log u WHERE u.undo_of = a.id),
+				a.created_at
+			FROM audit_log a
+			ORDER BY a.id DESC
+			LIMIT $1`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query, auditLogLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	entries := make([]*models.GrpcAuditEntry, 0)
+	for rows.Next() {
+		entry := &models.GrpcAuditEntry{}
+		var createdAt time.Time
+
+		err = rows.Scan(
+			&entry.ID,
+			&entry.Action,
+			&entry.Entity,
+			&entry.EntityId,
+			&entry.Before,
+			&entry.After,
+			&entry.UndoOf,
+			&entry.Undone,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.CreatedAt = timestamppb.New(createdAt)
+		entries = append(entries, entry)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the entry the next undo reverts
+	last, err := lastAction(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+	var undoId int64
+	if last != nil && undoableActions[last.action] {
+		undoId = last.id
+	}
+
+	return &models.GetAuditLogReturns{Entries: entries, UndoId: undoId}, nil
+}
+
+// Undo the most recent action that isn't undone yet.
+// It's reverted only when the record is as the action left it
+func (m *sqliteDBRepo) UndoLastAction(params *models.GrpcEmpty) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Check db version. Older dbs can't put back refunds and attachments
+	var version int64
+	err = tx.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version)
+	if err != nil {
+		return nil, err
+	}
+	if version < undoVersion {
+		return nil, domainerr.New(codes.FailedPrecondition, domainerr.ReasonRuleViolation, fmt.Sprintf("user db must be migrated to version %d", undoVersion))
+	}
+
+	// Get action
+	entry, err := lastAction(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, domainerr.NotFound("nothing to undo")
+	}
+	if !undoableActions[entry.action] {
+		return nil, domainerr.New(codes.FailedPrecondition, domainerr.ReasonRuleViolation, "the last action can't be undone")
+	}
+
+	// Check that the record wasn't changed since
+	current, err := auditState(ctx, tx, entry.entity, entry.entityId)
+	if err != nil {
+		return nil, err
+	}
+	currentJSON, err := encodeState(current)
+	if err != nil {
+		return nil, err
+	}
+	if currentJSON != entry.after {
+		return nil, domainerr.New(codes.FailedPrecondition, domainerr.ReasonConflict, fmt.Sprintf("the %s was changed since, so the last action can't be undone", entry.entity))
+	}
+
+	// Apply the inverse action
+	id, hashes, err := m.revertAction(ctx, tx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log undo
+	before := current
+	if id != entry.entityId {
+		before = nil
+	}
+	after, err := auditState(ctx, tx, entry.entity, id)
+	if err != nil {
+		return nil, err
+	}
+	err = logAction(ctx, tx, actionUndo, entry.entity, id, before, after, sql.NullInt64{Int64: entry.id, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+
+	// Remove attachments content of removed expenses
+	m.pruneAttachments(ctx, hashes)
+
+	return nil, nil
+}
+
+// Apply the inverse of an action. Returns the id of the changed record and content hashes of removed attachments
+func (m *sqliteDBRepo) revertAction(ctx context.Context, tx *sql.Tx, entry *auditEntry) (int64, []string, error) {
+	var err error
+	var hashes []string
+
+	switch entry.action {
+	case actionAddExpense:
+		hashes, err = removeExpense(ctx, tx, entry.entityId)
+
+	case actionEditExpense:
+		var before expenseState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			err = m.restoreExpenseEdit(ctx, tx, &before)
+		}
+
+	case actionDeleteExpense:
+		var before expenseState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			err = m.restoreExpense(ctx, tx, &before, sql.NullInt64{})
+		}
+
+	case actionAddAccount:
+		_, err = tx.ExecContext(ctx, `DELETE FROM procedure_remove_account WHERE id = $1`, entry.entityId)
+
+	case actionRenameAccount:
+		var before accountState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			_, err = tx.ExecContext(ctx, `UPDATE procedure_account_update_name SET name = $1 WHERE id = $2`, before.Name, before.ID)
+		}
+
+	case actionDeleteAccount:
+		var before accountState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			_, err = tx.ExecContext(ctx, `INSERT INTO procedure_insert_account (id, name, currency) VALUES ($1, $2, $3)`, before.ID, before.Name, before.Currency)
+		}
+
+	case actionTransferFunds:
+		var after transferState
+		err = json.Unmarshal([]byte(entry.after.String), &after)
+		if err != nil {
+			return 0, nil, err
+		}
+		return reverseTransfer(ctx, tx, &after)
+
+	case actionAddCategory:
+		_, err = tx.ExecContext(ctx, `DELETE FROM procedure_remove_category WHERE id = $1`, entry.entityId)
+
+	case actionDeleteCategory:
+		var before categoryState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			err = restoreCategory(ctx, tx, &before)
+		}
+
+	case actionAddFreeFunds, actionAddIncome:
+		_, err = tx.ExecContext(ctx, `DELETE FROM procedure_remove_income WHERE id = $1`, entry.entityId)
+
+	case actionEditIncome:
+		var before incomeState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			err = m.restoreIncomeEdit(ctx, tx, &before)
+		}
+
+	case actionDeleteIncome:
+		var before incomeState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			err = m.restoreIncome(ctx, tx, &before)
+		}
+
+	case actionAddRefund:
+		_, err = tx.ExecContext(ctx, `DELETE FROM procedure_remove_expense_refund WHERE id = $1`, entry.entityId)
+
+	case actionDeleteRefund:
+		var before refundState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			err = restoreRefund(ctx, tx, &before)
+		}
+
+	case actionReimbursement:
+		var before expenseState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			err = restoreReimbursement(ctx, tx, &before)
+		}
+
+	case actionSetGoal:
+		var before categoryState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			err = restoreCategoryGoal(ctx, tx, &before)
+		}
+
+	case actionSetAutoReset:
+		var before categoryState
+		err = json.Unmarshal([]byte(entry.before.String), &before)
+		if err == nil {
+			_, err = tx.ExecContext(ctx, `UPDATE procedure_set_category_auto_reset SET auto_reset = $1 WHERE id = $2`, before.AutoReset, before.ID)
+		}
+
+	default:
+		err = fmt.Errorf("can't undo %q", entry.action)
+	}
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return entry.entityId, hashes, nil
+}
+
+// Get a payee that still exists. Removed payees are dropped
+func existingPayee(ctx context.Context, tx *sql.Tx, id *int64) (sql.NullInt64, error) {
+	if id == nil {
+		return sql.NullInt64{}, nil
+	}
+
+	var exists bool
+	err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM payees WHERE id = $1)`, *id).Scan(&exists)
+	if err != nil || !exists {
+		return sql.NullInt64{}, err
+	}
+
+	return sql.NullInt64{Int64: *id, Valid: true}, nil
+}
+
+// Put back a removed expense with its id, tags, split lines, refunds and attachments
+func (m *sqliteDBRepo) restoreExpense(ctx context.Context, tx *sql.Tx, state *expenseState, parentId sql.NullInt64) error {
+	// Get payee
+	payeeId, err := existingPayee(ctx, tx, state.PayeeID)
+	if err != nil {
+		return err
+	}
+
+	// Define query
+	stmt := `INSERT INTO procedure_new_expense (id, parent_id, payee_id, amount, base_amount, date, from_account, from_category, notes) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9);`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		state.ID,
+		parentId,
+		payeeId,
+		state.Amount,
+		state.BaseAmount,
+		state.Date,
+		state.FromAccount,
+		state.FromCategory,
+		state.Notes,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Link tags
+	err = m.restoreExpenseTags(tx, state)
+	if err != nil {
+		return err
+	}
+
+	// Put back split lines
+	for _, split := range state.Splits {
+		err = m.restoreExpense(ctx, tx, split, sql.NullInt64{Int64: state.ID, Valid: true})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Put back refunds
+	for _, refund := range state.Refunds {
+		err = restoreRefund(ctx, tx, refund)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Put back awaited reimbursement
+	if state.ReimburseFrom != nil {
+		err = restoreReimbursement(ctx, tx, state)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Put back attachments. Their content was kept while the removal could be undone
+	for _, attachment := range state.Attachments {
+		err = restoreAttachment(ctx, tx, state.ID, attachment)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Put back a removed refund with its id and period
+func restoreRefund(ctx context.Context, tx *sql.Tx, state *refundState) error {
+	// Define query
+	stmt := `INSERT INTO procedure_restore_expense_refund (
+		id,
+		expense_id,
+		kind,
+		counterparty,
+		amount,
+		currency,
+		base_amount,
+		to_account,
+		date,
+		created_at,
+		from_period
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7,
+		$8,
+		$9,
+		$10,
+		$11
+	)`
+
+	// Execute query
+	_, err := tx.ExecContext(
+		ctx,
+		stmt,
+		state.ID,
+		state.ExpenseID,
+		state.Kind,
+		state.Counterparty,
+		state.Amount,
+		state.Currency,
+		state.BaseAmount,
+		state.ToAccount,
+		state.Date,
+		state.CreatedAt,
+		state.FromPeriod,
+	)
+	return err
+}
+
+// Set the awaited reimbursement of an expense state. A missing counterparty clears it
+func restoreReimbursement(ctx context.Context, tx *sql.Tx, state *expenseState) error {
+	// Define query
+	stmt := `UPDATE procedure_set_expense_reimbursement SET
+				reimburse_from = $1,
+				reimburse_amount = $2
+			WHERE id = $3`
+
+	// Execute query
+	_, err := tx.ExecContext(ctx, stmt, state.ReimburseFrom, state.ReimburseAmount, state.ID)
+	return err
+}
+
+// Put back a removed attachment with its id
+func restoreAttachment(ctx context.Context, tx *sql.Tx, expenseId int64, state *attachmentState) error {
+	// Define query
+	stmt := `INSERT INTO procedure_restore_attachment (
+		id,
+		expense_id,
+		name,
+		content_type,
+		size,
+		hash,
+		created_at
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7
+	)`
+
+	// Execute query
+	_, err := tx.ExecContext(ctx, stmt, state.ID, expenseId, state.Name, state.ContentType, state.Size, state.Hash, state.CreatedAt)
+	return err
+}
+
+// Put back an expense as it was before an edit
+func (m *sqliteDBRepo) restoreExpenseEdit(ctx context.Context, tx *sql.Tx, state *expenseState) error {
+	// Remove tags
+	_, err := tx.ExecContext(ctx, `DELETE FROM procedure_unlink_tags_from_expense WHERE expense_id = $1;`, state.ID)
+	if err != nil {
+		return err
+	}
+
+	// Get payee
+	payeeId, err := existingPayee(ctx, tx, state.PayeeID)
+	if err != nil {
+		return err
+	}
+
+	// Define query
+	stmt := `UPDATE procedure_update_expense SET
+				amount = $1,
+				base_amount = $2,
+				date = $3,
+				from_account = $4,
+				from_category = $5,
+				payee_id = $6,
+				notes = $7
+			WHERE id = $8`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		state.Amount,
+		state.BaseAmount,
+		state.Date,
+		state.FromAccount,
+		state.FromCategory,
+		payeeId,
+		state.Notes,
+		state.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Link tags
+	err = m.restoreExpenseTags(tx, state)
+	if err != nil {
+		return err
+	}
+
+	// Replace split lines
+	err = removeExpenseRefunds(ctx, tx, state.ID, true)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM procedure_remove_expense WHERE parent_id = $1`, state.ID)
+	if err != nil {
+		return err
+	}
+	for _, split := range state.Splits {
+		err = m.restoreExpense(ctx, tx, split, sql.NullInt64{Int64: state.ID, Valid: true})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Link the tags of an expense state. Removed tags are created again
+func (m *sqliteDBRepo) restoreExpenseTags(tx *sql.Tx, state *expenseState) error {
+	if len(state.Tags) == 0 {
+		return nil
+	}
+
+	tags, err := m.UpdateTags(state.Tags, tx)
+	if err != nil {
+		return err
+	}
+
+	return m.AddExpenseTags(state.ID, tags, tx)
+}
+
+// Transfer the amount of a transfer back. Returns the id of the new transfer
+func reverseTransfer(ctx context.Context, tx *sql.Tx, state *transferState) (int64, []string, error) {
+	// Get inverse rate
+	rate, err := money.ParseRate(state.Rate)
+	if err != nil {
+		return 0, nil, err
+	}
+	rate = new(big.Rat).Inv(rate)
+
+	// Define query
+	stmt := `INSERT INTO procedure_transfer_funds (from_account, to_account, from_amount, to_amount, rate) VALUES ($1, $2, $3, $4, $5)`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, state.ToAccount, state.FromAccount, state.ToAmount, state.FromAmount, money.FormatRate(rate))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Take last inserted transfer
+	var id int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM account_transfers ORDER BY id DESC LIMIT 1;`).Scan(&id)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return id, nil, nil
+}
+
+// Put back a removed category with its amounts
+func restoreCategory(ctx context.Context, tx *sql.Tx, state *categoryState) error {
+	// Define query
+	stmt := `INSERT INTO procedure_restore_category (
+		id,
+		name,
+		budget_input,
+		last_input_date,
+		input_interval,
+		input_period,
+		spending_limit,
+		spending_left,
+		initial_amount,
+		current_amount,
+		rollover_policy,
+		rollover_cap,
+		auto_reset,
+		goal_amount,
+		goal_date
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7,
+		$8,
+		$9,
+		$10,
+		$11,
+		$12,
+		$13,
+		$14,
+		$15
+	)`
+
+	// Execute query
+	_, err := tx.ExecContext(
+		ctx,
+		stmt,
+		state.ID,
+		state.Name,
+		state.BudgetInput,
+		state.LastInputDate,
+		state.InputInterval,
+		state.InputPeriod,
+		state.SpendingLimit,
+		state.SpendingLeft,
+		state.InitialAmount,
+		state.CurrentAmount,
+		state.RolloverPolicy,
+		state.RolloverCap,
+		state.AutoReset,
+		state.GoalAmount,
+		state.GoalDate,
+	)
+	return err
+}
+
+// Set the goal of a category as it was. Setting a goal resets the spending left, so it's set back as well
+func restoreCategoryGoal(ctx context.Context, tx *sql.Tx, state *categoryState) error {
+	_, err := tx.ExecContext(ctx, `UPDATE procedure_set_category_goal SET goal_amount = $1, goal_date = $2 WHERE id = $3`, state.GoalAmount, state.GoalDate, state.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE procedure_fix_category_amounts SET spending_left = $1 WHERE id = $2`, state.SpendingLeft, state.ID)
+	return err
+}
+
+// Put back a removed income with its id and tags
+func (m *sqliteDBRepo) restoreIncome(ctx context.Context, tx *sql.Tx, state *incomeState) error {
+	// Define query
+	stmt := `INSERT INTO procedure_add_free_funds (
+		id,
+		amount,
+		base_amount,
+		date,
+		source,
+		to_account,
+		tag_id
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7
+	)`
+
+	// Execute query
+	_, err := tx.ExecContext(ctx, stmt, state.ID, state.Amount, state.BaseAmount, state.Date, state.Source, state.ToAccount, state.TagID)
+	if err != nil {
+		return err
+	}
+
+	return m.restoreIncomeTags(ctx, tx, state)
+}
+
+// Put back an income as it was before an edit
+func (m *sqliteDBRepo) restoreIncomeEdit(ctx context.Context, tx *sql.Tx, state *incomeState) error {
+	// Remove tags
+	_, err := tx.ExecContext(ctx, `DELETE FROM procedure_unlink_tags_from_income WHERE income_id = $1;`, state.ID)
+	if err != nil {
+		return err
+	}
+
+	// Define query
+	stmt := `UPDATE procedure_update_income SET
+				amount = $1,
+				base_amount = $2,
+				date = $3,
+				source = $4,
+				to_account = $5,
+				tag_id = $6
+			WHERE id = $7`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, state.Amount, state.BaseAmount, state.Date, state.Source, state.ToAccount, state.TagID, state.ID)
+	if err != nil {
+		return err
+	}
+
+	return m.restoreIncomeTags(ctx, tx, state)
+}
+
+// Link the tags of an income state. Removed tags are created again
+func (m *sqliteDBRepo) restoreIncomeTags(ctx context.Context, tx *sql.Tx, state *incomeState) error {
+	if len(state.Tags) == 0 {
+		return nil
+	}
+
+	tags, err := m.UpdateTags(state.Tags, tx)
+	if err != nil {
+		return err
+	}
+
+	return m.addIncomeTags(ctx, tx, state.ID, tags)
+}
@@ -5,10 +5,14 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// First user DB version with automatic category resets
+const autoResetVersion = 7
+
 func (m *sqliteDBRepo) GetCategoriesCount() (int, error) {
 	// Define context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
@@ -53,6 +57,12 @@ func (m *sqliteDBRepo) GetCategories(params *models.GrpcEmpty) (*models.GetCateg
 			FROM view_categories
 			ORDER BY table_order DESC;`
 
+	// Get user currency
+	currency, err := getCurrency(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get rows
 	rows, err := m.DB.QueryContext(ctx, query)
 	if err != nil {
@@ -66,7 +76,13 @@ func (m *sqliteDBRepo) GetCategories(params *models.GrpcEmpty) (*models.GetCateg
 	// Scan rows
 	for rows.Next() {
 		// Define base models
-		category := &models.GrpcCategory{}
+		category := &models.GrpcCategory{
+			BudgetInput:   models.NewGrpcMoney(0, currency),
+			SpendingLimit: models.NewGrpcMoney(0, currency),
+			SpendingLeft:  models.NewGrpcMoney(0, currency),
+			InitialAmount: models.NewGrpcMoney(0, currency),
+			CurrentAmount: models.NewGrpcMoney(0, currency),
+		}
 		var lastInputDate time.Time
 		var createdAt time.Time
 		var updatedAt sql.NullTime
@@ -77,13 +93,13 @@ func (m *sqliteDBRepo) GetCategories(params *models.GrpcEmpty) (*models.GetCateg
 		err = rows.Scan(
 			&category.ID,
 			&category.Name,
-			&category.BudgetInput,
+			&category.BudgetInput.Amount,
 			&lastInputDate,
 			&nextInputDate,
-			&category.SpendingLimit,
-			&category.SpendingLeft,
-			&category.InitialAmount,
-			&category.CurrentAmount,
+			&category.SpendingLimit.Amount,
+			&category.SpendingLeft.Amount,
+			&category.InitialAmount.Amount,
+			&category.CurrentAmount.Amount,
 			&category.TableOrder,
 			&createdAt,
 			&updatedAt,
@@ -136,10 +152,23 @@ func (m *sqliteDBRepo) GetCategoriesOverview(params *models.GrpcEmpty) (*models.
 				initial_amount,
 				current_amount,
 				can_be_deleted,
-				table_order
+				table_order,
+				rollover_policy,
+				rollover_cap,
+				auto_reset,
+				reset_due,
+				goal_amount,
+				goal_date,
+				period_unit
 			FROM view_categories_overview
 			ORDER BY table_order DESC;`
 
+	// Get user currency
+	currency, err := getCurrency(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get rows
 	rows, err := m.DB.QueryContext(ctx, query)
 	if err != nil {
@@ -153,30 +182,59 @@ func (m *sqliteDBRepo) GetCategoriesOverview(params *models.GrpcEmpty) (*models.
 	// Scan rows
 	for rows.Next() {
 		// Define base models
-		category := &models.GrpcCategoryOverview{}
+		category := &models.GrpcCategoryOverview{
+			BudgetInput:   models.NewGrpcMoney(0, currency),
+			SpendingLimit: models.NewGrpcMoney(0, currency),
+			SpendingLeft:  models.NewGrpcMoney(0, currency),
+			InitialAmount: models.NewGrpcMoney(0, currency),
+			CurrentAmount: models.NewGrpcMoney(0, currency),
+			GoalAmount:    models.NewGrpcMoney(0, currency),
+		}
 		var periodStart time.Time
 		var periodEnd string
+		var goalDate sql.NullTime
+		var periodUnit string
 
 		err = rows.Scan(
 			&category.ID,
 			&category.Name,
-			&category.BudgetInput,
+			&category.BudgetInput.Amount,
 			&category.InputInterval,
 			&category.InputPeriodId,
 			&category.InputPeriodCaption,
-			&category.SpendingLimit,
-			&category.SpendingLeft,
+			&category.SpendingLimit.Amount,
+			&category.SpendingLeft.Amount,
 			&periodStart,
 			&periodEnd,
-			&category.InitialAmount,
-			&category.CurrentAmount,
+			&category.InitialAmount.Amount,
+			&category.CurrentAmount.Amount,
 			&category.CanBeDeleted,
 			&category.TableOrder,
+			&category.RolloverPolicy,
+			&category.RolloverCap,
+			&category.AutoReset,
+			&category.ResetDue,
+			&category.GoalAmount.Amount,
+			&goalDate,
+			&periodUnit,
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		// Get goal contribution
+		if goalDate.Valid {
+			category.GoalDate = timestamppb.New(goalDate.Time)
+		}
+		category.GoalContribution = models.NewGrpcMoney(goalContribution(
+			category.GoalAmount.Amount,
+			category.CurrentAmount.Amount,
+			category.BudgetInput.Amount,
+			goalDate,
+			category.InputInterval,
+			periodUnit,
+		), currency)
+
 		t, err := time.Parse("2006-01-02 15:04:05", periodEnd)
 		if err != nil {
 			return nil, err
@@ -207,19 +265,51 @@ func (m *sqliteDBRepo) AddCategory(params *models.AddCategoryParams) (*models.Gr
 	}
 	defer tx.Rollback()
 
+	// Get amounts
+	budgetInput, err := minorUnits(ctx, tx, params.BudgetInput)
+	if err != nil {
+		return nil, err
+	}
+	spendingLimit, err := minorUnits(ctx, tx, params.SpendingLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get rollover policy
+	policy, rolloverCap, err := rolloverPolicy(params.RolloverPolicy, params.RolloverCap)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get goal
+	goalAmount, goalDate, err := categoryGoal(ctx, tx, params.GoalAmount, params.GoalDate)
+	if err != nil {
+		return nil, err
+	}
+
 	// Define query to insert account
 	stmt := `INSERT INTO procedure_new_category (
 		name,
 		budget_input,
 		input_interval,
 		input_period,
-		spending_limit
+		spending_limit,
+		rollover_policy,
+		rollover_cap,
+		auto_reset,
+		goal_amount,
+		goal_date
 	) VALUES (
 		$1,
 		$2,
 		$3,
 		$4,
-		$5
+		$5,
+		$6,
+		$7,
+		$8,
+		$9,
+		$10
 	)`
 
 	// Execute query
@@ -227,15 +317,33 @@ func (m *sqliteDBRepo) AddCategory(params *models.AddCategoryParams) (*models.Gr
 		ctx,
 		stmt,
 		params.Name,
-		params.BudgetInput,
+		budgetInput,
 		params.InputInterval,
 		params.InputPeriod,
-		params.SpendingLimit,
+		spendingLimit,
+		policy,
+		rolloverCap,
+		params.AutoReset,
+		goalAmount,
+		goalDate,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// Take last inserted category
+	var id int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM categories ORDER BY id DESC LIMIT 1;`).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionAddCategory, auditCategory, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return nil, nil
 }
@@ -252,6 +360,15 @@ func (m *sqliteDBRepo) DeleteCategory(params *models.DeleteCategoryParams) (*mod
 	}
 	defer tx.Rollback()
 
+	// Get category before it's removed
+	before, err := auditState(ctx, tx, auditCategory, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, domainerr.NotFound("category not found")
+	}
+
 	// Setup query to delete account
 	stmt := `DELETE FROM procedure_remove_category WHERE id=$1`
 
@@ -261,6 +378,12 @@ func (m *sqliteDBRepo) DeleteCategory(params *models.DeleteCategoryParams) (*mod
 		return nil, err
 	}
 
+	// Log action
+	err = audit(ctx, tx, actionDeleteCategory, auditCategory, params.ID, before)
+	if err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return nil, nil
 }
@@ -295,7 +418,106 @@ func (m *sqliteDBRepo) ReorderCategory(params *models.ReorderCategoryParams) (*m
 	return nil, nil
 }
 
-func (m *sqliteDBRepo) ResetCategory(amount float64, categoryId int64, budgetInput float64, inputInterval int64, inputPeriod int64, spendingLimit float64, etx *sql.Tx) error {
+// Turn automatic period resets on or off
+func (m *sqliteDBRepo) SetCategoryAutoReset(params *models.SetCategoryAutoResetParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get category before the change
+	before, err := auditState(ctx, tx, auditCategory, params.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, domainerr.NotFound("category not found")
+	}
+
+	// Setup query
+	stmt := `UPDATE procedure_set_category_auto_reset SET auto_reset = $1 WHERE id = $2`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		params.AutoReset,
+		params.CategoryId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionSetAutoReset, auditCategory, params.CategoryId, before)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Set or clear category goal
+func (m *sqliteDBRepo) SetCategoryGoal(params *models.SetCategoryGoalParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get goal
+	goalAmount, goalDate, err := categoryGoal(ctx, tx, params.GoalAmount, params.GoalDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get category before the change
+	before, err := auditState(ctx, tx, auditCategory, params.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, domainerr.NotFound("category not found")
+	}
+
+	// Setup query
+	stmt := `UPDATE procedure_set_category_goal SET goal_amount = $1, goal_date = $2 WHERE id = $3`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		goalAmount,
+		goalDate,
+		params.CategoryId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionSetGoal, auditCategory, params.CategoryId, before)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+func (m *sqliteDBRepo) ResetCategory(amount int64, categoryId int64, budgetInput int64, inputInterval int64, inputPeriod int64, spendingLimit int64, policy string, rolloverCap int64, etx *sql.Tx) error {
 	// Define context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
@@ -320,14 +542,18 @@ func (m *sqliteDBRepo) ResetCategory(amount float64, categoryId int64, budgetInp
 		budget_input,
 		input_interval,
 		input_period,
-		spending_limit
+		spending_limit,
+		rollover_policy,
+		rollover_cap
 	) VALUES (
 		$1,
 		$2,
 		$3,
 		$4,
 		$5,
-		$6
+		$6,
+		$7,
+		$8
 	)`
 
 	// Execute query
@@ -340,6 +566,8 @@ func (m *sqliteDBRepo) ResetCategory(amount float64, categoryId int64, budgetInp
 		inputInterval,
 		inputPeriod,
 		spendingLimit,
+		policy,
+		rolloverCap,
 	)
 	if err != nil {
 		return err
@@ -361,13 +589,283 @@ func (m *sqliteDBRepo) ResetCategories(params *models.ResetCategoriesParams) (*m
 	}
 	defer tx.Rollback()
 
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Get attachments content held for undo. Resets can't be undone, so it's released by them
+	released, err := undoableAttachments(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, categoryData := range params.Catgories {
-		err = m.ResetCategory(categoryData.Amount, categoryData.CategoryId, categoryData.BudgetInput, categoryData.InputInterval, categoryData.InputPeriod, categoryData.SpendingLimit, tx)
+		// Get amounts
+		amount, err := minorUnits(ctx, tx, categoryData.Amount)
+		if err != nil {
+			return nil, err
+		}
+		budgetInput, err := minorUnits(ctx, tx, categoryData.BudgetInput)
+		if err != nil {
+			return nil, err
+		}
+		spendingLimit, err := minorUnits(ctx, tx, categoryData.SpendingLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get rollover policy
+		policy, rolloverCap, err := rolloverPolicy(categoryData.RolloverPolicy, categoryData.RolloverCap)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get category before the reset
+		before, err := auditState(ctx, tx, auditCategory, categoryData.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.ResetCategory(amount, categoryData.CategoryId, budgetInput, categoryData.InputInterval, categoryData.InputPeriod, spendingLimit, policy, rolloverCap, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Log action
+		err = audit(ctx, tx, actionResetCategory, auditCategory, categoryData.CategoryId, before)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	tx.Commit()
+
+	// Remove content only undo used
+	if len(params.Catgories) > 0 {
+		m.pruneAttachments(ctx, released)
+	}
+
 	return nil, nil
 }
+
+// Check goal amount and get the goal date. The date is dropped when the goal is cleared
+func categoryGoal(ctx context.Context, tx *sql.Tx, amount *models.GrpcMoney, date *timestamppb.Timestamp) (int64, *time.Time, error) {
+	goalAmount, err := minorUnits(ctx, tx, amount)
+	if err != nil {
+		return 0, nil, err
+	}
+	if goalAmount < 0 {
+		return 0, nil, domainerr.InvalidArgument("goal amount can't be negative")
+	}
+
+	// Clearing the goal clears the date
+	if goalAmount == 0 || date == nil {
+		return goalAmount, nil, nil
+	}
+
+	goalDate := date.AsTime().UTC()
+	return goalAmount, &goalDate, nil
+}
+
+// Get the input needed at the next reset to reach the goal.
+// Goals without a date get the budget input until they are reached
+func goalContribution(goalAmount, currentAmount, budgetInput int64, goalDate sql.NullTime, inputInterval int64, periodUnit string) int64 {
+	remaining := goalAmount - currentAmount
+	if goalAmount <= 0 || remaining <= 0 {
+		return 0
+	}
+
+	if !goalDate.Valid {
+		return min(budgetInput, remaining)
+	}
+
+	return models.GoalContribution(remaining, time.Now(), goalDate.Time, inputInterval, periodUnit)
+}
+
+// Check rollover policy. Empty policy is carry and the cap defaults to one budget input
+func rolloverPolicy(policy string, rolloverCap int64) (string, int64, error) {
+	if policy == "" {
+		policy = models.RolloverCarry
+	}
+	if !models.ValidRolloverPolicy(policy) {
+		return "", 0, domainerr.InvalidArgument("unknown rollover policy")
+	}
+	if rolloverCap == 0 {
+		rolloverCap = 1
+	}
+	if rolloverCap < 0 {
+		return "", 0, domainerr.InvalidArgument("rollover cap must be positive")
+	}
+	return policy, rolloverCap, nil
+}
+
+// Reset categories with auto reset on whose period has ended. The budget input is taken from free funds.
+// Categories are marked as due when free funds are not enough. Returns the number of reset and due categories
+func (m *sqliteDBRepo) AutoResetCategories(now time.Time) (int, int, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	// Skip databases that don't support auto resets yet
+	var version int64
+	err = tx.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version)
+	if err != nil {
+		return 0, 0, err
+	}
+	if version < autoResetVersion {
+		return 0, 0, nil
+	}
+
+	// Get attachments content held for undo. Resets can't be undone, so it's released by them
+	var released []string
+	if version >= auditVersion {
+		released, err = undoableAttachments(ctx, tx)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	// Define query
+	query := `SELECT
+				id,
+				budget_input,
+				input_interval,
+				input_period,
+				spending_limit,
+				rollover_policy,
+				rollover_cap,
+				reset_due,
+				period_end,
+				current_amount,
+				goal_amount,
+				goal_date,
+				period_unit
+			FROM view_categories_overview
+			WHERE auto_reset = 1 AND period_end <= $1
+			ORDER BY table_order DESC;`
+
+	// Get rows
+	rows, err := tx.QueryContext(ctx, query, now.UTC().Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Scan rows
+	type category struct {
+		id, budgetInput, inputInterval, inputPeriod, spendingLimit int64
+		policy                                                     string
+		rolloverCap                                                int64
+		due                                                        bool
+		periodEnd                                                  string
+		currentAmount, goalAmount                                  int64
+		goalDate                                                   sql.NullTime
+		periodUnit                                                 string
+	}
+	categories := make([]category, 0)
+	for rows.Next() {
+		var c category
+		err = rows.Scan(
+			&c.id,
+			&c.budgetInput,
+			&c.inputInterval,
+			&c.inputPeriod,
+			&c.spendingLimit,
+			&c.policy,
+			&c.rolloverCap,
+			&c.due,
+			&c.periodEnd,
+			&c.currentAmount,
+			&c.goalAmount,
+			&c.goalDate,
+			&c.periodUnit,
+		)
+		if err != nil {
+			rows.Close()
+			return 0, 0, err
+		}
+		categories = append(categories, c)
+	}
+	rows.Close()
+	err = rows.Err()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Define query to log runs
+	stmt := `INSERT INTO procedure_log_category_reset (category, period_end, amount, status) VALUES ($1, $2, $3, $4)`
+
+	reset, due := 0, 0
+	for _, c := range categories {
+		// Goals get the input needed to reach them
+		amount := c.budgetInput
+		if c.goalAmount > 0 {
+			amount = goalContribution(c.goalAmount, c.currentAmount, c.budgetInput, c.goalDate, c.inputInterval, c.periodUnit)
+		}
+
+		// Get free funds. Previous resets may have changed them
+		var freeFunds int64
+		err = tx.QueryRowContext(ctx, `SELECT free_funds FROM user`).Scan(&freeFunds)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// Mark as due if free funds are not enough. Due categories are logged once
+		if freeFunds < amount {
+			due++
+			if c.due {
+				continue
+			}
+
+			_, err = tx.ExecContext(ctx, stmt, c.id, c.periodEnd, amount, "due")
+			if err != nil {
+				return 0, 0, err
+			}
+			continue
+		}
+
+		// Get category before the reset. Databases without the audit log skip it
+		var before any
+		if version >= auditVersion {
+			before, err = auditState(ctx, tx, auditCategory, c.id)
+			if err != nil {
+				return 0, 0, err
+			}
+		}
+
+		// Reset category with its current settings
+		err = m.ResetCategory(amount, c.id, c.budgetInput, c.inputInterval, c.inputPeriod, c.spendingLimit, c.policy, c.rolloverCap, tx)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// Log action
+		if version >= auditVersion {
+			err = audit(ctx, tx, actionAutoReset, auditCategory, c.id, before)
+			if err != nil {
+				return 0, 0, err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, stmt, c.id, c.periodEnd, amount, "reset")
+		if err != nil {
+			return 0, 0, err
+		}
+		reset++
+	}
+
+	tx.Commit()
+
+	// Remove content only undo used
+	if reset > 0 {
+		m.pruneAttachments(ctx, released)
+	}
+
+	return reset, due, nil
+}
@@ -2,38 +2,35 @@ package dbrepo
 
 import (
 	"database/sql"
-	"fmt"
-	"net/url"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/attachments"
 	"github.com/dimitargrozev5/expenses-go-1/internal/config"
 	"github.com/dimitargrozev5/expenses-go-1/internal/repository"
+	"github.com/dimitargrozev5/expenses-go-1/internal/userdb"
 )
 
 type sqliteDBRepo struct {
-	App *config.DBNodeConfig
-	DB  *sql.DB
+	App   *config.DBNodeConfig
+	DB    *sql.DB
+	Files *attachments.Store
 }
 
-func NewSqliteRepo(app *config.DBNodeConfig, user string, conn *sql.DB) repository.DatabaseRepo {
-	return &sqliteDBRepo{
+// Create repo of a user db. File id is from userdb.FileID
+func NewSqliteRepo(app *config.DBNodeConfig, fileID string, conn *sql.DB) repository.DatabaseRepo {
+	repo := &sqliteDBRepo{
 		App: app,
 		DB:  conn,
 	}
+
+	// Attachments are kept next to the user DB
+	if app != nil {
+		repo.Files = attachments.New(userdb.FilesPath(app.DBPath, fileID))
+	}
+
+	return repo
 }
 
 // Get user key
 func GetUserKey(user string) string {
 	return user
 }
-
-// Get user db path
-func GetUserDBPath(dbPath, user string, fileOnly bool) string {
-	// Get user key
-	userKey := GetUserKey(user)
-
-	if fileOnly {
-		return fmt.Sprintf("%s%s.db", dbPath, userKey)
-	}
-
-	return fmt.Sprintf("%s%s.db?_fk=%s&_txlock=%s", dbPath, userKey, url.QueryEscape("true"), url.QueryEscape("exclusive"))
-}
@@ -0,0 +1,421 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Records with amounts in the base currency. Queries return the id and the amounts,
+// statements take the amounts and the id
+var baseAmounts = []struct {
+	query string
+	stmt  string
+}{
+	{
+		query: `SELECT id, budget_input, spending_limit, goal_amount, initial_amount FROM categories`,
+		stmt:  `UPDATE procedure_convert_category SET budget_input = $1, spending_limit = $2, goal_amount = $3, initial_amount = $4 WHERE id = $5`,
+	},
+	{
+		query: `SELECT id, budget_input, spending_limit, initial_amount, end_amount, carried_amount, returned_amount, deducted_amount FROM archived_periods`,
+		stmt:  `UPDATE procedure_convert_archived_period SET budget_input = $1, spending_limit = $2, initial_amount = $3, end_amount = $4, carried_amount = $5, returned_amount = $6, deducted_amount = $7 WHERE id = $8`,
+	},
+	{
+		query: `SELECT id, base_amount, reimburse_amount FROM expenses`,
+		stmt:  `UPDATE procedure_convert_expense SET base_amount = $1, reimburse_amount = $2 WHERE id = $3`,
+	},
+	{
+		query: `SELECT id, base_amount FROM expense_refunds`,
+		stmt:  `UPDATE procedure_convert_refund SET base_amount = $1 WHERE id = $2`,
+	},
+	{
+		query: `SELECT id, CAST(base_amount AS INTEGER) FROM accounts_input_log`,
+		stmt:  `UPDATE procedure_convert_income SET base_amount = $1 WHERE id = $2`,
+	},
+	{
+		query: `SELECT id, min_amount, max_amount FROM rules`,
+		stmt:  `UPDATE procedure_convert_rule SET min_amount = $1, max_amount = $2 WHERE id = $3`,
+	},
+	{
+		query: `SELECT id, principal, balance, payment_amount FROM liabilities`,
+		stmt:  `UPDATE procedure_convert_liability SET principal = $1, balance = $2, payment_amount = $3 WHERE id = $4`,
+	},
+	{
+		query: `SELECT id, amount, interest FROM liability_payments`,
+		stmt:  `UPDATE procedure_convert_liability_payment SET amount = $1, interest = $2 WHERE id = $3`,
+	},
+}
+
+// Get exchange rates, latest first
+func (m *sqliteDBRepo) GetExchangeRates(params *models.GrpcEmpty) (*models.GetExchangeRatesReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	query := `SELECT id, from_currency, to_currency, rate, date, created_at, updated_at
+				FROM exchange_rates
+				ORDER BY date DESC, from_currency, to_currency`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Set variable for rates
+	rates := make([]*models.GrpcExchangeRate, 0)
+
+	// Scan rows
+	for rows.Next() {
+		rate := &models.GrpcExchangeRate{}
+
+		// Get time
+		var date time.Time
+		var createdAt time.Time
+		var updatedAt sql.NullTime
+
+		err = rows.Scan(
+			&rate.ID,
+			&rate.FromCurrency,
+			&rate.ToCurrency,
+			&rate.Rate,
+			&date,
+			&createdAt,
+			&updatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rate.Date = timestamppb.New(date)
+		rate.CreatedAt = timestamppb.New(createdAt)
+		if updatedAt.Valid {
+			rate.UpdatedAt = timestamppb.New(updatedAt.Time)
+		}
+
+		// Add to rates
+		rates = append(rates, rate)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GetExchangeRatesReturns{Rates: rates}, nil
+}
+
+// Add or replace exchange rates. Used both for a single rate and for imports
+func (m *sqliteDBRepo) SetExchangeRates(params *models.SetExchangeRatesParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Define query
+	stmt := `INSERT INTO procedure_set_exchange_rate (from_currency, to_currency, rate, date) VALUES ($1, $2, $3, $4)`
+
+	for _, r := range params.Rates {
+		// Check currencies
+		from := money.Normalize(r.FromCurrency)
+		to := money.Normalize(r.ToCurrency)
+		if !money.ValidCurrency(from) || !money.ValidCurrency(to) {
+			return nil, domainerr.InvalidArgument(money.ErrInvalidCurrency.Error())
+		}
+		if from == to {
+			return nil, domainerr.InvalidArgument("exchange rate currencies must differ")
+		}
+
+		// Check rate
+		rate, err := money.ParseRate(r.Rate)
+		if err != nil {
+			return nil, domainerr.InvalidArgument(err.Error())
+		}
+
+		// Check date
+		if r.Date == nil {
+			return nil, domainerr.InvalidArgument("exchange rate date is required")
+		}
+
+		// Execute query
+		_, err = tx.ExecContext(
+			ctx,
+			stmt,
+			from,
+			to,
+			money.FormatRate(rate),
+			r.Date.AsTime().Format(rateDateLayout),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Delete exchange rate
+func (m *sqliteDBRepo) DeleteExchangeRate(params *models.DeleteExchangeRateParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Define query
+	stmt := `DELETE FROM procedure_remove_exchange_rate WHERE id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Change the base currency, re-valuing categories, free funds and expense base amounts
+func (m *sqliteDBRepo) SetBaseCurrency(params *models.SetBaseCurrencyParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout. Every record with an amount is updated
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Change currency
+	released, err := changeBaseCurrency(ctx, tx, params.Currency, params.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+
+	// Remove content only undo used
+	m.pruneAttachments(ctx, released)
+
+	return nil, nil
+}
+
+// Change the base currency within a transaction. The stored rate is used when rate is empty.
+// Every amount is converted with the exact rate and rounded once. Cached totals are recomputed from
+// the converted records, so they keep matching them. Drift they had before is converted and kept.
+// The change can't be undone. Returns the attachments content undo held until then
+func changeBaseCurrency(ctx context.Context, tx *sql.Tx, currency, rateParam string) ([]string, error) {
+	// Check currency
+	currency = money.Normalize(currency)
+	if !money.ValidCurrency(currency) {
+		return nil, domainerr.InvalidArgument(money.ErrInvalidCurrency.Error())
+	}
+
+	// Get current base currency
+	oldCurrency, err := getCurrency(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nothing to do
+	if currency == oldCurrency {
+		return nil, nil
+	}
+
+	// Check db version. Totals are recomputed with the integrity checks
+	var version int64
+	err = tx.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version)
+	if err != nil {
+		return nil, err
+	}
+	if version < integrityVersion {
+		return nil, domainerr.New(codes.FailedPrecondition, domainerr.ReasonRuleViolation, fmt.Sprintf("user db must be migrated to version %d", integrityVersion))
+	}
+
+	// Get free funds before they are converted
+	var oldFreeFunds int64
+	err = tx.QueryRowContext(ctx, `SELECT free_funds FROM user`).Scan(&oldFreeFunds)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get rate, either given or stored
+	var rate *big.Rat
+	if rateParam != "" {
+		rate, err = money.ParseRate(rateParam)
+		if err != nil {
+			return nil, domainerr.InvalidArgument(err.Error())
+		}
+	} else {
+		rate, err = getRate(ctx, tx, oldCurrency, currency, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get drift of cached totals before amounts change
+	totals := make([]integrityCheck, 0)
+	drifts := make([]map[int64]int64, 0)
+	for _, check := range integrityChecks {
+		if !check.base {
+			continue
+		}
+
+		values, err := checkValues(ctx, tx, check)
+		if err != nil {
+			return nil, err
+		}
+
+		drift := map[int64]int64{}
+		for _, value := range values {
+			drift[value.EntityId] = value.Stored - value.Expected
+		}
+
+		totals = append(totals, check)
+		drifts = append(drifts, drift)
+	}
+
+	// Convert amounts of every record
+	for _, amounts := range baseAmounts {
+		err = convertAmounts(ctx, tx, amounts.query, amounts.stmt, rate, oldCurrency, currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Recompute cached totals from the converted records
+	for i, check := range totals {
+		values, err := checkValues(ctx, tx, check)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, value := range values {
+			drift, err := money.Convert(money.New(drifts[i][value.EntityId], oldCurrency), rate, currency)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = tx.ExecContext(ctx, check.stmt, value.Expected+drift.Amount, value.EntityId)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Define query
+	stmt := `UPDATE procedure_change_base_currency SET currency = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get attachments content held for undo. It's released by the change
+	released, err := undoableAttachments(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the change with free funds, which are converted as well
+	var userId, freeFunds int64
+	err = tx.QueryRowContext(ctx, `SELECT id, free_funds FROM user`).Scan(&userId, &freeFunds)
+	if err != nil {
+		return nil, err
+	}
+	before := map[string]any{"currency": oldCurrency, "free_funds": oldFreeFunds}
+	after := map[string]any{"currency": currency, "free_funds": freeFunds}
+	err = logAction(ctx, tx, actionBaseCurrency, auditUser, userId, before, after, sql.NullInt64{})
+	if err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
+
+// Convert the amounts of records. Query returns the id and the amounts of every record,
+// statement takes the converted amounts and the id. Empty amounts stay empty
+func convertAmounts(ctx context.Context, tx *sql.Tx, query, stmt string, rate *big.Rat, from, to string) error {
+	// Get rows
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	// Scan rows. They are updated after reading, since the transaction has a single connection
+	records := make([][]sql.NullInt64, 0)
+	for rows.Next() {
+		record := make([]sql.NullInt64, len(columns))
+		dest := make([]any, len(columns))
+		for i := range record {
+			dest[i] = &record[i]
+		}
+
+		err = rows.Scan(dest...)
+		if err != nil {
+			return err
+		}
+
+		records = append(records, record)
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+	rows.Close()
+
+	// Update records. Amounts come first, id last
+	for _, record := range records {
+		args := make([]any, 0, len(record))
+		for _, amount := range record[1:] {
+			if !amount.Valid {
+				args = append(args, amount)
+				continue
+			}
+
+			converted, err := money.Convert(money.New(amount.Int64, from), rate, to)
+			if err != nil {
+				return err
+			}
+			args = append(args, converted.Amount)
+		}
+		args = append(args, record[0].Int64)
+
+		_, err = tx.ExecContext(ctx, stmt, args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -20,7 +21,10 @@ func (m *sqliteDBRepo) GetExpenses(param *models.GrpcEmpty) (*models.GetExpenses
 	// Define query
 	query := `	SELECT
 					expense_id,
+					parent_id,
 					amount,
+					currency,
+					base_amount,
 					date,
 
 					tag_id,
@@ -31,9 +35,20 @@ func (m *sqliteDBRepo) GetExpenses(param *models.GrpcEmpty) (*models.GetExpenses
 					account_name,
 
 					category_id,
-					category_name
+					category_name,
+
+					payee_id,
+					payee_name,
+
+					notes
 				FROM view_detailed_expenses;`
 
+	// Get user currency
+	currency, err := getCurrency(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get rows
 	rows, err := m.DB.QueryContext(ctx, query)
 	if err != nil {
@@ -48,15 +63,20 @@ func (m *sqliteDBRepo) GetExpenses(param *models.GrpcEmpty) (*models.GetExpenses
 	// Scan rows
 	for rows.Next() {
 		// Define base models
-		expense := models.GrpcExpense{}
+		expense := models.GrpcExpense{Amount: &models.GrpcMoney{}, BaseAmount: models.NewGrpcMoney(0, currency)}
 		tag := models.GrpcTag{}
 		account := models.GrpcAccount{}
 		category := models.GrpcCategory{}
 		var date time.Time
+		var parentId, payeeId sql.NullInt64
+		var payee sql.NullString
 
 		err = rows.Scan(
 			&expense.ID,
-			&expense.Amount,
+			&parentId,
+			&expense.Amount.Amount,
+			&expense.Amount.Currency,
+			&expense.BaseAmount.Amount,
 			&date,
 			&tag.ID,
 			&tag.Name,
@@ -65,12 +85,18 @@ func (m *sqliteDBRepo) GetExpenses(param *models.GrpcEmpty) (*models.GetExpenses
 			&account.Name,
 			&category.ID,
 			&category.Name,
+			&payeeId,
+			&payee,
+			&expense.Notes,
 		)
 		if err != nil {
 			return nil, err
 		}
 
 		expense.Date = timestamppb.New(date)
+		expense.ParentId = parentId.Int64
+		expense.PayeeId = payeeId.Int64
+		expense.Payee = payee.String
 
 		// Get expense
 		oldExpense, ok := expensesMap[expense.ID]
@@ -95,13 +121,56 @@ func (m *sqliteDBRepo) GetExpenses(param *models.GrpcEmpty) (*models.GetExpenses
 		return nil, err
 	}
 
-	// Get expenses slice
+	// Add refunds
+	err = m.getExpenseRefunds(ctx, currency, expensesMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add awaited reimbursements
+	err = m.getExpenseReimbursements(ctx, currency, expensesMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get outstanding reimbursements
+	outstanding, err := m.getOutstandingReimbursements(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add attachments
+	err = m.getExpenseAttachments(ctx, expensesMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get attachment store usage
+	attachmentsSize, err := attachmentsSize(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get expenses slice, with split lines under their expense
 	expenses := make([]*models.GrpcExpense, 0, len(expensesOrder))
 	for _, id := range expensesOrder {
-		expenses = append(expenses, expensesMap[id])
+		expense := expensesMap[id]
+
+		// Lines of archived expenses are shown on their own
+		if parent, ok := expensesMap[expense.ParentId]; ok {
+			parent.Splits = append(parent.Splits, expense)
+			continue
+		}
+
+		expenses = append(expenses, expense)
 	}
 
-	return &models.GetExpensesReturns{Expenses: expenses}, nil
+	return &models.GetExpensesReturns{
+		Expenses:         expenses,
+		Outstanding:      outstanding,
+		AttachmentsSize:  attachmentsSize,
+		AttachmentsQuota: m.attachmentQuota(),
+	}, nil
 }
 
 // Add expense
@@ -117,49 +186,28 @@ func (m *sqliteDBRepo) AddExpense(param *models.ExpensesParams) (*models.GrpcEmp
 	}
 	defer tx.Rollback()
 
-	// Update tags
-	exisitingTags, err := m.UpdateTags(param.Tags, tx)
+	// Apply rules before the tags are updated
+	err = m.applyRules(ctx, tx, param, param.Expense.Date.AsTime())
 	if err != nil {
 		return nil, err
 	}
 
-	// Define query to insert expense
-	// Transactions lock the db so the last expense will be the one inserted
-	// Autoincrement adds one so the larges id will be the last
-	// Can't use RETURNING because expense insert happens through a trigger
-	stmt := `INSERT INTO procedure_new_expense (amount, date, from_account, from_category) VALUES($1, $2, $3, $4);`
-
-	// Exec statement
-	_, err = tx.ExecContext(
-		ctx,
-		stmt,
-		param.Expense.Amount,
-		param.Expense.Date.AsTime(),
-		param.Expense.FromAccountId,
-		param.Expense.FromCategoryId,
-	)
+	// Insert expense
+	expenseId, err := m.insertExpense(ctx, tx, param, sql.NullInt64{}, param.Expense.Date.AsTime())
 	if err != nil {
 		return nil, err
 	}
 
-	// Take last inserted expense
-	query := `SELECT id FROM expenses ORDER BY id DESC LIMIT 1;`
-
-	// Execute query
-	row := tx.QueryRowContext(ctx, query)
-
-	// Get new expense expenseId
-	var expenseId int64
-
-	err = row.Scan(&expenseId)
-
-	// Check for error
-	if err != nil {
-		return nil, err
+	// Insert split lines
+	for _, split := range param.Splits {
+		_, err = m.insertExpense(ctx, tx, split, sql.NullInt64{Int64: expenseId, Valid: true}, param.Expense.Date.AsTime())
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Add tag relations
-	err = m.AddExpenseTags(expenseId, exisitingTags, tx)
+	// Log action
+	err = audit(ctx, tx, actionAddExpense, auditExpense, expenseId, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -182,6 +230,15 @@ func (m *sqliteDBRepo) EditExpense(param *models.ExpensesParams) (*models.GrpcEm
 	}
 	defer tx.Rollback()
 
+	// Get expense before the changes
+	before, err := auditState(ctx, tx, auditExpense, param.Expense.ID)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, domainerr.NotFound("expense not found")
+	}
+
 	// Create query to remove all expense tags
 	stmt := `DELETE FROM procedure_unlink_tags_from_expense WHERE expense_id = $1;`
 
@@ -195,28 +252,46 @@ func (m *sqliteDBRepo) EditExpense(param *models.ExpensesParams) (*models.GrpcEm
 		return nil, err
 	}
 
+	// Get amount in the account currency and in the base currency
+	amount, baseAmount, err := accountAmount(ctx, tx, param.Expense.Amount, param.Expense.FromAccountId, param.Expense.Date.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
 	// Update tags
 	allTags, err := m.UpdateTags(param.Tags, tx)
 	if err != nil {
 		return nil, err
 	}
 
+	// Get payee
+	payeeId, err := payeeID(ctx, tx, param.Payee)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update expense
 	stmt = `UPDATE procedure_update_expense SET
 				amount = $1,
-				date = $2,
-				from_account = $3,
-				from_category = $4
-			WHERE id = $5`
+				base_amount = $2,
+				date = $3,
+				from_account = $4,
+				from_category = $5,
+				payee_id = $6,
+				notes = $7
+			WHERE id = $8`
 
 	// Update expense
 	_, err = tx.ExecContext(
 		ctx,
 		stmt,
-		param.Expense.Amount,
+		amount,
+		baseAmount,
 		param.Expense.Date.AsTime(),
 		param.Expense.FromAccountId,
 		param.Expense.FromCategoryId,
+		payeeId,
+		strings.TrimSpace(param.Expense.Notes),
 		param.Expense.ID,
 	)
 	if err != nil {
@@ -229,6 +304,35 @@ func (m *sqliteDBRepo) EditExpense(param *models.ExpensesParams) (*models.GrpcEm
 		return nil, err
 	}
 
+	// Remove refunds of old split lines
+	err = removeExpenseRefunds(ctx, tx, param.Expense.ID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove old split lines. This restores their balances
+	stmt = `DELETE FROM procedure_remove_expense WHERE parent_id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, param.Expense.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Insert new split lines
+	for _, split := range param.Splits {
+		_, err = m.insertExpense(ctx, tx, split, sql.NullInt64{Int64: param.Expense.ID, Valid: true}, param.Expense.Date.AsTime())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionEditExpense, auditExpense, param.Expense.ID, before)
+	if err != nil {
+		return nil, err
+	}
+
 	// Commit to transaction and exit
 	tx.Commit()
 	return nil, nil
@@ -247,22 +351,138 @@ func (m *sqliteDBRepo) DeleteExpense(param *models.DeleteExpenseParams) (*models
 	}
 	defer tx.Rollback()
 
-	// Define query
-	stmt := `DELETE FROM procedure_remove_expense WHERE id=$1`
+	// Get expense before it's removed
+	before, err := auditState(ctx, tx, auditExpense, param.ID)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, domainerr.NotFound("expense not found")
+	}
+
+	// Remove expense
+	hashes, err := removeExpense(ctx, tx, param.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionDeleteExpense, auditExpense, param.ID, before)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+
+	// Remove attachments content
+	m.pruneAttachments(ctx, hashes)
+
+	return nil, nil
+}
+
+// Remove an expense with its split lines and refunds. Returns the content hashes of its attachments
+func removeExpense(ctx context.Context, tx *sql.Tx, id int64) ([]string, error) {
+	// Remove refunds first, so their balances are restored
+	err := removeExpenseRefunds(ctx, tx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get attachments content. Attachments are removed with the expense
+	hashes, err := attachmentHashes(ctx, tx, `SELECT hash FROM attachments WHERE expense_id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define query. Split lines are removed before the expense
+	stmt := `DELETE FROM procedure_remove_expense WHERE parent_id=$1`
 
 	// Execute query
 	_, err = tx.ExecContext(
 		ctx,
 		stmt,
-		param.ID,
+		id,
 	)
+	if err != nil {
+		return nil, err
+	}
 
+	// Define query
+	stmt = `DELETE FROM procedure_remove_expense WHERE id=$1`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		id,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	tx.Commit()
-	return nil, nil
+	return hashes, nil
+}
+
+// Insert an expense or a split line of the parent expense and link its tags
+func (m *sqliteDBRepo) insertExpense(ctx context.Context, tx *sql.Tx, param *models.ExpensesParams, parentId sql.NullInt64, date time.Time) (int64, error) {
+	// Get amount in the account currency and in the base currency
+	amount, baseAmount, err := accountAmount(ctx, tx, param.Expense.GetAmount(), param.Expense.GetFromAccountId(), date)
+	if err != nil {
+		return 0, err
+	}
+
+	// Update tags
+	exisitingTags, err := m.UpdateTags(param.Tags, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Get payee
+	payeeId, err := payeeID(ctx, tx, param.Payee)
+	if err != nil {
+		return 0, err
+	}
+
+	// Define query to insert expense
+	// Transactions lock the db so the last expense will be the one inserted
+	// Autoincrement adds one so the larges id will be the last
+	// Can't use RETURNING because expense insert happens through a trigger
+	stmt := `INSERT INTO procedure_new_expense (parent_id, payee_id, amount, base_amount, date, from_account, from_category, notes) VALUES($1, $2, $3, $4, $5, $6, $7, $8);`
+
+	// Exec statement
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		parentId,
+		payeeId,
+		amount,
+		baseAmount,
+		date,
+		param.Expense.GetFromAccountId(),
+		param.Expense.GetFromCategoryId(),
+		strings.TrimSpace(param.Expense.GetNotes()),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Take last inserted expense
+	query := `SELECT id FROM expenses ORDER BY id DESC LIMIT 1;`
+
+	// Get new expense expenseId
+	var expenseId int64
+	err = tx.QueryRowContext(ctx, query).Scan(&expenseId)
+	if err != nil {
+		return 0, err
+	}
+
+	// Add tag relations
+	err = m.AddExpenseTags(expenseId, exisitingTags, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	return expenseId, nil
 }
 
 // Add relations based on tags
@@ -0,0 +1,395 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Get incomes ordered by date and a summary of every month
+func (m *sqliteDBRepo) GetIncomes(params *models.GrpcEmpty) (*models.GetIncomesReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	query := `	SELECT
+					income_id,
+					amount,
+					currency,
+					base_amount,
+					date,
+					source,
+
+					tag_id,
+					tag_name,
+					usage_count,
+
+					account_id,
+					account_name
+				FROM view_detailed_incomes;`
+
+	// Get user currency
+	currency, err := getCurrency(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Define incomes map and slice
+	incomesMap := map[int64]*models.GrpcIncome{}
+	incomes := make([]*models.GrpcIncome, 0)
+
+	// Scan rows
+	for rows.Next() {
+		// Define base models
+		income := models.GrpcIncome{Amount: &models.GrpcMoney{}, BaseAmount: models.NewGrpcMoney(0, currency)}
+		tag := models.GrpcTag{}
+		account := models.GrpcAccount{}
+		var date time.Time
+
+		err = rows.Scan(
+			&income.ID,
+			&income.Amount.Amount,
+			&income.Amount.Currency,
+			&income.BaseAmount.Amount,
+			&date,
+			&income.Source,
+			&tag.ID,
+			&tag.Name,
+			&tag.UsageCount,
+			&account.ID,
+			&account.Name,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// If income has been added only add the tag
+		if oldIncome, ok := incomesMap[income.ID]; ok {
+			oldIncome.Tags = append(oldIncome.Tags, &tag)
+			continue
+		}
+
+		income.Date = timestamppb.New(date)
+		income.Tags = []*models.GrpcTag{&tag}
+		income.ToAccountId = account.ID
+		income.ToAccount = &account
+		incomesMap[income.ID] = &income
+		incomes = append(incomes, &income)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get monthly summary
+	months, err := m.getMonthlySummary(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GetIncomesReturns{Incomes: incomes, Months: months}, nil
+}
+
+// Get incomes and expenses of every month, latest first
+func (m *sqliteDBRepo) getMonthlySummary(ctx context.Context, currency string) ([]*models.GrpcMonthlySummary, error) {
+	// Define query
+	query := `SELECT month, income, expenses FROM view_monthly_summary`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	months := make([]*models.GrpcMonthlySummary, 0)
+	for rows.Next() {
+		var month string
+		var income, expenses int64
+
+		err = rows.Scan(&month, &income, &expenses)
+		if err != nil {
+			return nil, err
+		}
+
+		months = append(months, &models.GrpcMonthlySummary{
+			Month:    month,
+			Income:   models.NewGrpcMoney(income, currency),
+			Expenses: models.NewGrpcMoney(expenses, currency),
+			Net:      models.NewGrpcMoney(income-expenses, currency),
+		})
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return months, nil
+}
+
+// Add income
+func (m *sqliteDBRepo) AddIncome(params *models.IncomeParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Insert income
+	id, err := m.insertIncome(ctx, tx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionAddIncome, auditIncome, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Edit income
+func (m *sqliteDBRepo) EditIncome(params *models.IncomeParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get income before the changes
+	before, err := auditState(ctx, tx, auditIncome, params.Income.ID)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, domainerr.NotFound("income not found")
+	}
+
+	// Create query to remove all income tags
+	stmt := `DELETE FROM procedure_unlink_tags_from_income WHERE income_id = $1;`
+
+	// Delete relations
+	_, err = tx.ExecContext(ctx, stmt, params.Income.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get amount in the account currency and in the base currency
+	amount, baseAmount, err := accountAmount(ctx, tx, params.Income.Amount, params.Income.ToAccountId, params.Income.Date.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	// Update tags
+	allTags, err := m.UpdateTags(params.Tags, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update income
+	stmt = `UPDATE procedure_update_income SET
+				amount = $1,
+				base_amount = $2,
+				date = $3,
+				source = $4,
+				to_account = $5,
+				tag_id = $6
+			WHERE id = $7`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		amount,
+		baseAmount,
+		params.Income.Date.AsTime(),
+		strings.TrimSpace(params.Income.Source),
+		params.Income.ToAccountId,
+		allTags[0].ID,
+		params.Income.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add relations
+	err = m.addIncomeTags(ctx, tx, params.Income.ID, allTags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionEditIncome, auditIncome, params.Income.ID, before)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Delete income. Free funds and the account amount are taken back
+func (m *sqliteDBRepo) DeleteIncome(params *models.DeleteIncomeParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get income before it's removed
+	before, err := auditState(ctx, tx, auditIncome, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, domainerr.NotFound("income not found")
+	}
+
+	// Define query
+	stmt := `DELETE FROM procedure_remove_income WHERE id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionDeleteIncome, auditIncome, params.ID, before)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Insert an income and link its tags
+func (m *sqliteDBRepo) insertIncome(ctx context.Context, tx *sql.Tx, params *models.IncomeParams) (int64, error) {
+	// Get date
+	date := time.Now()
+	if params.Income.GetDate() != nil {
+		date = params.Income.Date.AsTime()
+	}
+
+	// Get amount in the account currency and in the base currency
+	amount, baseAmount, err := accountAmount(ctx, tx, params.Income.GetAmount(), params.Income.GetToAccountId(), date)
+	if err != nil {
+		return 0, err
+	}
+
+	// Update tags
+	allTags, err := m.UpdateTags(params.Tags, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Define query to insert income
+	// Can't use RETURNING because the insert happens through a trigger
+	stmt := `INSERT INTO procedure_add_free_funds (
+		amount,
+		base_amount,
+		date,
+		source,
+		to_account,
+		tag_id
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6
+	)`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		amount,
+		baseAmount,
+		date,
+		strings.TrimSpace(params.Income.GetSource()),
+		params.Income.GetToAccountId(),
+		allTags[0].ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Take last inserted income
+	query := `SELECT id FROM accounts_input_log ORDER BY id DESC LIMIT 1;`
+
+	// Get new income id
+	var incomeId int64
+	err = tx.QueryRowContext(ctx, query).Scan(&incomeId)
+	if err != nil {
+		return 0, err
+	}
+
+	// Add tag relations
+	err = m.addIncomeTags(ctx, tx, incomeId, allTags)
+	if err != nil {
+		return 0, err
+	}
+
+	return incomeId, nil
+}
+
+// Add relations between an income and tags
+func (m *sqliteDBRepo) addIncomeTags(ctx context.Context, tx *sql.Tx, incomeId int64, tags []models.Tag) error {
+	// Store VALUES template
+	tagValuesTmpl := make([]string, 0, len(tags))
+
+	// Store values
+	tagValues := make([]interface{}, 0, len(tags)*2)
+
+	// Loop trough tags
+	for i, tag := range tags {
+		tagValuesTmpl = append(tagValuesTmpl, fmt.Sprintf("($%d, $%d)", i*2+1, i*2+2))
+		tagValues = append(tagValues, incomeId, tag.ID)
+	}
+
+	// Define query to insert relations
+	stmt := `INSERT INTO procedure_link_tag_to_income(income_id, tag_id) VALUES `
+
+	// Append templates
+	stmt = fmt.Sprintf("%s%s", stmt, strings.Join(tagValuesTmpl, ","))
+
+	// Insert relations
+	_, err := tx.ExecContext(ctx, stmt, tagValues...)
+	return err
+}
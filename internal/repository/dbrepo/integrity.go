@@ -0,0 +1,264 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"google.golang.org/grpc/codes"
+)
+
+// First user db version with the integrity fixes and refunds linked to their period
+const integrityVersion = 19
+
+// Records with cached values that aren't audited otherwise
+const (
+	auditTag  = "tag"
+	auditUser = "user"
+)
+
+// Action logged for every corrected value
+const actionFixDrift = "fix_drift"
+
+// Cached value with the query that recomputes it and the statement that overwrites it.
+// Queries return id, name, currency, stored and expected value of every record
+type integrityCheck struct {
+	entity string
+	field  string
+	query  string
+	stmt   string
+
+	// Value is in the base currency
+	base bool
+}
+
+// Refunds count towards the current period of the category until a reset links them to the archived period
+var integrityChecks = []integrityCheck{
+	{
+		entity: auditAccount,
+		field:  "current_amount",
+		query: `SELECT
+					a.id,
+					a.name,
+					a.currency,
+					a.current_amount,
+					COALESCE((SELECT SUM(amount) FROM accounts_input_log WHERE account = a.id), 0)
+					- COALESCE((SELECT SUM(amount) FROM expenses WHERE from_account = a.id), 0)
+					+ COALESCE((SELECT SUM(to_amount) FROM account_transfers WHERE to_account = a.id), 0)
+					- COALESCE((SELECT SUM(from_amount) FROM account_transfers WHERE from_account = a.id), 0)
+					+ COALESCE((SELECT SUM(amount) FROM expense_refunds WHERE to_account = a.id), 0)
+				FROM accounts AS a
+				ORDER BY a.id`,
+		stmt: `UPDATE procedure_fix_account_amount SET current_amount = $1 WHERE id = $2`,
+	},
+	{
+		entity: auditCategory,
+		field:  "current_amount",
+		query: `SELECT
+					c.id,
+					c.name,
+					u.currency,
+					c.current_amount,
+					c.initial_amount
+					- COALESCE((SELECT SUM(base_amount) FROM expenses WHERE from_category = c.id AND from_period IS NULL), 0)
+					+ COALESCE((
+						SELECT SUM(r.base_amount)
+						FROM expense_refunds AS r
+						INNER JOIN expenses AS e ON e.id = r.expense_id
+						WHERE e.from_category = c.id AND r.from_period IS NULL
+					), 0)
+				FROM categories AS c, user AS u
+				ORDER BY c.id`,
+		stmt: `UPDATE procedure_fix_category_amounts SET current_amount = $1 WHERE id = $2`,
+		base: true,
+	},
+	{
+		entity: auditCategory,
+		field:  "spending_left",
+		query: `SELECT
+					c.id,
+					c.name,
+					u.currency,
+					c.spending_left,
+					CASE
+						WHEN c.goal_amount > 0 THEN c.spending_limit
+						ELSE c.spending_limit
+						- COALESCE((SELECT deducted_amount FROM archived_periods WHERE category = c.id ORDER BY id DESC LIMIT 1), 0)
+						- COALESCE((SELECT SUM(base_amount) FROM expenses WHERE from_category = c.id AND from_period IS NULL), 0)
+						+ COALESCE((
+							SELECT SUM(r.base_amount)
+							FROM expense_refunds AS r
+							INNER JOIN expenses AS e ON e.id = r.expense_id
+							WHERE e.from_category = c.id AND r.from_period IS NULL
+						), 0)
+					END
+				FROM categories AS c, user AS u
+				ORDER BY c.id`,
+		stmt: `UPDATE procedure_fix_category_amounts SET spending_left = $1 WHERE id = $2`,
+		base: true,
+	},
+	{
+		entity: auditTag,
+		field:  "usage_count",
+		query: `SELECT
+					t.id,
+					t.name,
+					'',
+					t.usage_count,
+					(SELECT COUNT(*) FROM expense_tags WHERE tag_id = t.id)
+					+ (SELECT COUNT(*) FROM income_tags WHERE tag_id = t.id)
+				FROM tags AS t
+				ORDER BY t.id`,
+		stmt: `UPDATE procedure_fix_tag_usage_count SET usage_count = $1 WHERE id = $2`,
+	},
+	{
+		// The budget input of a reset is the opening amount of the next period less what was carried over
+		entity: auditUser,
+		field:  "free_funds",
+		query: `SELECT
+					u.id,
+					u.email,
+					u.currency,
+					u.free_funds,
+					COALESCE((SELECT SUM(CAST(base_amount AS INTEGER)) FROM accounts_input_log), 0)
+					+ COALESCE((SELECT SUM(returned_amount) FROM archived_periods), 0)
+					- COALESCE((
+						SELECT SUM(input)
+						FROM (
+							SELECT
+								COALESCE(LEAD(p.initial_amount) OVER (PARTITION BY p.category ORDER BY p.id), c.initial_amount) - p.carried_amount AS input
+							FROM archived_periods AS p
+							INNER JOIN categories AS c ON c.id = p.category
+						)
+					), 0)
+				FROM user AS u`,
+		stmt: `UPDATE procedure_fix_free_funds SET free_funds = $1 WHERE id = $2`,
+		base: true,
+	},
+}
+
+// Recompute cached amounts, usage counts and free funds from the records they are kept for.
+// Drifted values are overwritten and logged when fix is set
+func (m *sqliteDBRepo) VerifyIntegrity(params *models.VerifyIntegrityParams) (*models.VerifyIntegrityReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Check db version
+	var version int64
+	err = tx.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version)
+	if err != nil {
+		return nil, err
+	}
+	if version < integrityVersion {
+		return nil, domainerr.New(codes.FailedPrecondition, domainerr.ReasonRuleViolation, fmt.Sprintf("user db must be migrated to version %d", integrityVersion))
+	}
+
+	// Run checks. Keep the statement that fixes every drift
+	drifts := make([]*models.GrpcDrift, 0)
+	stmts := make([]string, 0)
+	for _, check := range integrityChecks {
+		found, err := findDrifts(ctx, tx, check)
+		if err != nil {
+			return nil, err
+		}
+
+		drifts = append(drifts, found...)
+		for range found {
+			stmts = append(stmts, check.stmt)
+		}
+	}
+
+	if !params.GetFix() || len(drifts) == 0 {
+		return &models.VerifyIntegrityReturns{Drifts: drifts}, nil
+	}
+
+	// Get attachments content held for undo. Fixes can't be undone, so it's released by them
+	released, err := undoableAttachments(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Overwrite drifted values
+	for i, drift := range drifts {
+		_, err = tx.ExecContext(ctx, stmts[i], drift.Expected, drift.EntityId)
+		if err != nil {
+			return nil, fmt.Errorf("can't fix %s of %s #%d: %w", drift.Field, drift.Entity, drift.EntityId, err)
+		}
+
+		// Log the change. Amounts keep their currency so they can be shown
+		before := map[string]any{drift.Field: drift.Stored}
+		after := map[string]any{drift.Field: drift.Expected}
+		if drift.Currency != "" {
+			before["currency"] = drift.Currency
+			after["currency"] = drift.Currency
+		}
+		err = logAction(ctx, tx, actionFixDrift, drift.Entity, drift.EntityId, before, after, sql.NullInt64{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx.Commit()
+
+	// Remove content only undo used
+	m.pruneAttachments(ctx, released)
+
+	return &models.VerifyIntegrityReturns{Drifts: drifts, Fixed: true}, nil
+}
+
+// Get records whose stored value differs from the recomputed one
+func findDrifts(ctx context.Context, q rowsQuerier, check integrityCheck) ([]*models.GrpcDrift, error) {
+	values, err := checkValues(ctx, q, check)
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := make([]*models.GrpcDrift, 0)
+	for _, value := range values {
+		if value.Stored != value.Expected {
+			drifts = append(drifts, value)
+		}
+	}
+
+	return drifts, nil
+}
+
+// Get stored and recomputed value of every record of a check
+func checkValues(ctx context.Context, q rowsQuerier, check integrityCheck) ([]*models.GrpcDrift, error) {
+	// Get rows
+	rows, err := q.QueryContext(ctx, check.query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	values := make([]*models.GrpcDrift, 0)
+	for rows.Next() {
+		value := &models.GrpcDrift{Entity: check.entity, Field: check.field}
+
+		err = rows.Scan(&value.EntityId, &value.Name, &value.Currency, &value.Stored, &value.Expected)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
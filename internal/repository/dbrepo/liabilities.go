@@ -0,0 +1,442 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Get liabilities with their payments and the net worth
+func (m *sqliteDBRepo) GetLiabilities(params *models.GrpcEmpty) (*models.GetLiabilitiesReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	query := `SELECT
+					id,
+					kind,
+					counterparty,
+					principal,
+					balance,
+					interest_rate,
+					payment_amount,
+					payment_interval,
+					payment_period,
+					payment_period_caption,
+					start_date,
+					next_payment
+				FROM view_liabilities;`
+
+	// Get user currency
+	currency, err := getCurrency(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Define liabilities map and slice
+	liabilitiesMap := map[int64]*models.GrpcLiability{}
+	liabilities := make([]*models.GrpcLiability, 0)
+
+	// Define totals
+	lent := models.NewGrpcMoney(0, currency)
+	borrowed := models.NewGrpcMoney(0, currency)
+
+	// Scan rows
+	for rows.Next() {
+		// Define base models
+		liability := &models.GrpcLiability{
+			Principal:     models.NewGrpcMoney(0, currency),
+			Balance:       models.NewGrpcMoney(0, currency),
+			PaymentAmount: models.NewGrpcMoney(0, currency),
+			Payments:      make([]*models.GrpcLiabilityPayment, 0),
+		}
+		var paymentPeriod sql.NullInt64
+		var startDate time.Time
+		var nextPayment sql.NullString
+
+		err = rows.Scan(
+			&liability.ID,
+			&liability.Kind,
+			&liability.Counterparty,
+			&liability.Principal.Amount,
+			&liability.Balance.Amount,
+			&liability.InterestRate,
+			&liability.PaymentAmount.Amount,
+			&liability.PaymentInterval,
+			&paymentPeriod,
+			&liability.PaymentPeriodCaption,
+			&startDate,
+			&nextPayment,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		liability.PaymentPeriodId = paymentPeriod.Int64
+		liability.StartDate = timestamppb.New(startDate)
+		if nextPayment.Valid {
+			t, err := time.Parse("2006-01-02 15:04:05", nextPayment.String)
+			if err != nil {
+				return nil, err
+			}
+			liability.NextPayment = timestamppb.New(t)
+		}
+
+		// Add to totals
+		if liability.Kind == models.LiabilityLent {
+			lent.Amount += liability.Balance.Amount
+		} else {
+			borrowed.Amount += liability.Balance.Amount
+		}
+
+		liabilitiesMap[liability.ID] = liability
+		liabilities = append(liabilities, liability)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	// Add payments
+	err = m.getLiabilityPayments(ctx, currency, liabilitiesMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get accounts total in the base currency
+	accounts, err := m.GetAccounts(&models.GetAccountsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GetLiabilitiesReturns{
+		Liabilities:  liabilities,
+		Accounts:     accounts.Total,
+		Lent:         lent,
+		Borrowed:     borrowed,
+		NetWorth:     models.NewGrpcMoney(accounts.Total.GetAmount()+lent.Amount-borrowed.Amount, currency),
+		MissingRates: accounts.MissingRates,
+	}, nil
+}
+
+// Add payments to their liabilities, latest first
+func (m *sqliteDBRepo) getLiabilityPayments(ctx context.Context, currency string, liabilities map[int64]*models.GrpcLiability) error {
+	// Define query
+	query := `SELECT
+					id,
+					liability,
+					amount,
+					interest,
+					date,
+					expense_id,
+					income_id
+				FROM liability_payments
+				ORDER BY date DESC, id DESC;`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	for rows.Next() {
+		payment := &models.GrpcLiabilityPayment{
+			Amount:   models.NewGrpcMoney(0, currency),
+			Interest: models.NewGrpcMoney(0, currency),
+		}
+		var liabilityId int64
+		var date time.Time
+		var expenseId, incomeId sql.NullInt64
+
+		err = rows.Scan(
+			&payment.ID,
+			&liabilityId,
+			&payment.Amount.Amount,
+			&payment.Interest.Amount,
+			&date,
+			&expenseId,
+			&incomeId,
+		)
+		if err != nil {
+			return err
+		}
+
+		payment.Date = timestamppb.New(date)
+		payment.ExpenseId = expenseId.Int64
+		payment.IncomeId = incomeId.Int64
+
+		if liability, ok := liabilities[liabilityId]; ok {
+			liability.Payments = append(liability.Payments, payment)
+		}
+	}
+
+	return rows.Err()
+}
+
+// Add liability
+func (m *sqliteDBRepo) AddLiability(params *models.AddLiabilityParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Check fields
+	liability := params.GetLiability()
+	if !models.ValidLiabilityKind(liability.GetKind()) {
+		return nil, domainerr.InvalidArgument("unknown liability kind")
+	}
+	counterparty := strings.TrimSpace(liability.GetCounterparty())
+	if counterparty == "" {
+		return nil, domainerr.InvalidArgument("counterparty is required")
+	}
+	if liability.GetInterestRate() < 0 {
+		return nil, domainerr.InvalidArgument("interest rate can't be negative")
+	}
+
+	// Get amounts
+	principal, err := minorUnits(ctx, tx, liability.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	if principal <= 0 {
+		return nil, domainerr.InvalidArgument("principal must be greater than zero")
+	}
+	paymentAmount, err := minorUnits(ctx, tx, liability.GetPaymentAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	// Liabilities without a payment period have no schedule
+	var paymentPeriod sql.NullInt64
+	if liability.GetPaymentPeriodId() > 0 {
+		paymentPeriod = sql.NullInt64{Int64: liability.GetPaymentPeriodId(), Valid: true}
+	}
+	paymentInterval := max(liability.GetPaymentInterval(), 1)
+
+	// Get start date
+	startDate := time.Now()
+	if liability.GetStartDate() != nil {
+		startDate = liability.StartDate.AsTime()
+	}
+
+	// Define query
+	stmt := `INSERT INTO procedure_new_liability (
+		kind,
+		counterparty,
+		principal,
+		interest_rate,
+		payment_amount,
+		payment_interval,
+		payment_period,
+		start_date
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7,
+		$8
+	)`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		liability.Kind,
+		counterparty,
+		principal,
+		liability.GetInterestRate(),
+		paymentAmount,
+		paymentInterval,
+		paymentPeriod,
+		startDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Delete liability. Expenses and incomes of its payments are kept
+func (m *sqliteDBRepo) DeleteLiability(params *models.DeleteLiabilityParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Define query
+	stmt := `DELETE FROM procedure_remove_liability WHERE id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Record liability payment. Borrowed liabilities are paid with an expense, lent ones with an income
+func (m *sqliteDBRepo) AddLiabilityPayment(params *models.LiabilityPaymentParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get liability
+	var kind, counterparty string
+	err = tx.QueryRowContext(ctx, `SELECT kind, counterparty FROM liabilities WHERE id = $1`, params.LiabilityId).Scan(&kind, &counterparty)
+	if err == sql.ErrNoRows {
+		return nil, domainerr.NotFound("liability not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Expenses need a category
+	if kind == models.LiabilityBorrowed && params.CategoryId == 0 {
+		return nil, domainerr.InvalidArgument("category is required to pay borrowed money")
+	}
+
+	// Get date
+	date := time.Now()
+	if params.GetDate() != nil {
+		date = params.Date.AsTime()
+	}
+
+	// Get interest part in the base currency
+	_, interest, err := accountAmount(ctx, tx, params.GetInterest(), params.AccountId, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create expense or income
+	var expenseId, incomeId sql.NullInt64
+	var amount int64
+	if kind == models.LiabilityBorrowed {
+		id, err := m.insertExpense(ctx, tx, &models.ExpensesParams{
+			Expense: &models.GrpcExpense{
+				Amount:         params.Amount,
+				FromAccountId:  params.AccountId,
+				FromCategoryId: params.CategoryId,
+			},
+			Tags: params.Tags,
+		}, sql.NullInt64{}, date)
+		if err != nil {
+			return nil, err
+		}
+		expenseId = sql.NullInt64{Int64: id, Valid: true}
+
+		err = tx.QueryRowContext(ctx, `SELECT base_amount FROM expenses WHERE id = $1`, id).Scan(&amount)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		id, err := m.insertIncome(ctx, tx, &models.IncomeParams{
+			Income: &models.GrpcIncome{
+				Amount:      params.Amount,
+				Date:        timestamppb.New(date),
+				Source:      counterparty,
+				ToAccountId: params.AccountId,
+			},
+			Tags: params.Tags,
+		})
+		if err != nil {
+			return nil, err
+		}
+		incomeId = sql.NullInt64{Int64: id, Valid: true}
+
+		err = tx.QueryRowContext(ctx, `SELECT base_amount FROM accounts_input_log WHERE id = $1`, id).Scan(&amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Check interest part
+	if interest < 0 || interest > amount {
+		return nil, domainerr.InvalidArgument("interest must be between zero and the payment amount")
+	}
+
+	// Define query
+	stmt := `INSERT INTO procedure_add_liability_payment (
+		liability,
+		amount,
+		interest,
+		expense_id,
+		income_id,
+		date
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6
+	)`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, params.LiabilityId, amount, interest, expenseId, incomeId, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get attachments content held for undo. Payments can't be undone, so it's released by them
+	released, err := undoableAttachments(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the created expense or income
+	if expenseId.Valid {
+		err = audit(ctx, tx, actionPayLiability, auditExpense, expenseId.Int64, nil)
+	} else {
+		err = audit(ctx, tx, actionPayLiability, auditIncome, incomeId.Int64, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+
+	// Remove content only undo used
+	m.pruneAttachments(ctx, released)
+
+	return nil, nil
+}
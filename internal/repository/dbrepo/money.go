@@ -0,0 +1,175 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/money"
+	"google.golang.org/grpc/codes"
+)
+
+// Layout of exchange rate dates
+const rateDateLayout = "2006-01-02"
+
+// Returned when currencies have no exchange rate between them
+var errNoRate = errors.New("no exchange rate")
+
+// Anything that can run a single row query - *sql.DB or *sql.Tx
+type rowQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Get the base currency. Categories and free funds are kept in it
+func getCurrency(ctx context.Context, q rowQuerier) (string, error) {
+	var currency string
+
+	// Define query
+	query := `SELECT currency FROM user LIMIT 1`
+
+	// Get row
+	err := q.QueryRowContext(ctx, query).Scan(&currency)
+	if err != nil {
+		return "", err
+	}
+
+	return currency, nil
+}
+
+// Get the currency of an account
+func getAccountCurrency(ctx context.Context, q rowQuerier, id int64) (string, error) {
+	var currency string
+
+	// Define query
+	query := `SELECT currency FROM accounts WHERE id = $1`
+
+	// Get row
+	err := q.QueryRowContext(ctx, query, id).Scan(&currency)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domainerr.NotFound("account not found")
+	} else if err != nil {
+		return "", err
+	}
+
+	return currency, nil
+}
+
+// Get minor units of an amount, checking it is in the base currency
+func minorUnits(ctx context.Context, q rowQuerier, amount *models.GrpcMoney) (int64, error) {
+	// Get user currency
+	currency, err := getCurrency(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+
+	return inCurrency(amount, currency)
+}
+
+// Get minor units of an amount, checking it is in the given currency
+func inCurrency(amount *models.GrpcMoney, currency string) (int64, error) {
+	// Money without currency is taken to be in the expected currency
+	if amount.GetCurrency() == "" {
+		return amount.GetAmount(), nil
+	}
+
+	// Amounts in other currencies can't be stored
+	if money.Normalize(amount.GetCurrency()) != currency {
+		return 0, domainerr.InvalidArgument(fmt.Sprintf("amount must be in %s", currency))
+	}
+
+	return amount.GetAmount(), nil
+}
+
+// Get the rate from one currency to another, using the latest rate on or before the date
+// The inverse rate is used when only the opposite pair is known
+func getRate(ctx context.Context, q rowQuerier, from, to string, at time.Time) (*big.Rat, error) {
+	// Same currency
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	// Define query
+	query := `SELECT rate FROM exchange_rates
+				WHERE from_currency = $1 AND to_currency = $2 AND date <= $3
+				ORDER BY date DESC LIMIT 1`
+
+	// Try direct rate, then the inverse
+	for _, inverse := range []bool{false, true} {
+		pair := []any{from, to}
+		if inverse {
+			pair = []any{to, from}
+		}
+
+		// Get row
+		var s string
+		err := q.QueryRowContext(ctx, query, pair[0], pair[1], at.Format(rateDateLayout)).Scan(&s)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		rate, err := money.ParseRate(s)
+		if err != nil {
+			return nil, err
+		}
+
+		if inverse {
+			rate.Inv(rate)
+		}
+
+		return rate, nil
+	}
+
+	return nil, &domainerr.Error{
+		Code:    codes.FailedPrecondition,
+		Reason:  domainerr.ReasonRuleViolation,
+		Message: fmt.Sprintf("no exchange rate from %s to %s on %s", from, to, at.Format(rateDateLayout)),
+		Err:     errNoRate,
+	}
+}
+
+// Convert money to a currency with the rate on a date
+func convertMoney(ctx context.Context, q rowQuerier, m money.Money, to string, at time.Time) (money.Money, error) {
+	// Get rate
+	rate, err := getRate(ctx, q, m.Currency, to, at)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return money.Convert(m, rate, to)
+}
+
+// Get amount in the account currency and converted to the base currency
+func accountAmount(ctx context.Context, q rowQuerier, amount *models.GrpcMoney, accountId int64, at time.Time) (int64, int64, error) {
+	// Get account currency
+	currency, err := getAccountCurrency(ctx, q, accountId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Check amount currency
+	value, err := inCurrency(amount, currency)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Get base currency
+	baseCurrency, err := getCurrency(ctx, q)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Convert to base currency
+	base, err := convertMoney(ctx, q, money.New(value, currency), baseCurrency, at)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return value, base.Amount, nil
+}
@@ -0,0 +1,245 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+// Get payees, most used first
+func (m *sqliteDBRepo) GetPayees(params *models.GrpcEmpty) (*models.GetPayeesReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	query := `SELECT
+					id,
+					name,
+					default_account,
+					default_category,
+					default_tags,
+					usage_count,
+					account,
+					category,
+					tags
+				FROM view_payees;`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	payees := make([]*models.GrpcPayee, 0)
+	for rows.Next() {
+		payee := &models.GrpcPayee{}
+		var defaultAccount, defaultCategory, account, category sql.NullInt64
+		var defaultTags, tags string
+
+		err = rows.Scan(
+			&payee.ID,
+			&payee.Name,
+			&defaultAccount,
+			&defaultCategory,
+			&defaultTags,
+			&payee.UsageCount,
+			&account,
+			&category,
+			&tags,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		payee.DefaultAccountId = defaultAccount.Int64
+		payee.DefaultCategoryId = defaultCategory.Int64
+		payee.DefaultTags = splitTags(defaultTags)
+		payee.AccountId = account.Int64
+		payee.CategoryId = category.Int64
+		payee.Tags = splitTags(tags)
+
+		payees = append(payees, payee)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GetPayeesReturns{Payees: payees}, nil
+}
+
+// Add payee
+func (m *sqliteDBRepo) AddPayee(params *models.PayeeParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Get payee
+	payee := params.GetPayee()
+	name := strings.TrimSpace(payee.GetName())
+	if name == "" {
+		return nil, domainerr.InvalidArgument("payee name is required")
+	}
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Define query
+	stmt := `INSERT INTO procedure_new_payee (
+		name,
+		default_account,
+		default_category,
+		default_tags
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4
+	)`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		name,
+		nullID(payee.DefaultAccountId),
+		nullID(payee.DefaultCategoryId),
+		strings.Join(payee.DefaultTags, ","),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Edit payee name and defaults
+func (m *sqliteDBRepo) EditPayee(params *models.PayeeParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Get payee
+	payee := params.GetPayee()
+	name := strings.TrimSpace(payee.GetName())
+	if name == "" {
+		return nil, domainerr.InvalidArgument("payee name is required")
+	}
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Define query
+	stmt := `UPDATE procedure_update_payee SET
+				name = $1,
+				default_account = $2,
+				default_category = $3,
+				default_tags = $4
+			WHERE id = $5`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		name,
+		nullID(payee.DefaultAccountId),
+		nullID(payee.DefaultCategoryId),
+		strings.Join(payee.DefaultTags, ","),
+		payee.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Delete payee. Its expenses are kept without a payee
+func (m *sqliteDBRepo) DeletePayee(params *models.DeletePayeeParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Define query
+	stmt := `DELETE FROM procedure_remove_payee WHERE id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Get id of a payee by name, creating the payee if it doesn't exist. Empty names have no payee
+func payeeID(ctx context.Context, tx *sql.Tx, name string) (sql.NullInt64, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return sql.NullInt64{}, nil
+	}
+
+	// Define query. Names are compared without case
+	query := `SELECT id FROM payees WHERE name = $1`
+
+	// Get existing payee
+	var id int64
+	err := tx.QueryRowContext(ctx, query, name).Scan(&id)
+	if err == nil {
+		return sql.NullInt64{Int64: id, Valid: true}, nil
+	} else if err != sql.ErrNoRows {
+		return sql.NullInt64{}, err
+	}
+
+	// Create payee without defaults
+	_, err = tx.ExecContext(ctx, `INSERT INTO procedure_new_payee (name) VALUES ($1)`, name)
+	if err != nil {
+		return sql.NullInt64{}, err
+	}
+
+	// Get new payee
+	err = tx.QueryRowContext(ctx, query, name).Scan(&id)
+	if err != nil {
+		return sql.NullInt64{}, err
+	}
+
+	return sql.NullInt64{Int64: id, Valid: true}, nil
+}
+
+// Null for zero ids
+func nullID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
+
+// Split comma separated tags
+func splitTags(tags string) []string {
+	if tags == "" {
+		return []string{}
+	}
+	return strings.Split(tags, ",")
+}
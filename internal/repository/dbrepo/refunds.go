@@ -0,0 +1,359 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Add refunds to their expenses or split lines
+func (m *sqliteDBRepo) getExpenseRefunds(ctx context.Context, currency string, expenses map[int64]*models.GrpcExpense) error {
+	// Define query
+	query := `SELECT
+					id,
+					expense_id,
+					kind,
+					counterparty,
+					amount,
+					currency,
+					base_amount,
+					to_account,
+					account_name,
+					date
+				FROM view_detailed_expense_refunds;`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	for rows.Next() {
+		refund := &models.GrpcExpenseRefund{
+			Amount:     &models.GrpcMoney{},
+			BaseAmount: models.NewGrpcMoney(0, currency),
+			ToAccount:  &models.GrpcAccount{},
+		}
+		var date time.Time
+
+		err = rows.Scan(
+			&refund.ID,
+			&refund.ExpenseId,
+			&refund.Kind,
+			&refund.Counterparty,
+			&refund.Amount.Amount,
+			&refund.Amount.Currency,
+			&refund.BaseAmount.Amount,
+			&refund.ToAccountId,
+			&refund.ToAccount.Name,
+			&date,
+		)
+		if err != nil {
+			return err
+		}
+
+		refund.Date = timestamppb.New(date)
+		refund.ToAccount.ID = refund.ToAccountId
+
+		if expense, ok := expenses[refund.ExpenseId]; ok {
+			expense.Refunds = append(expense.Refunds, refund)
+		}
+	}
+
+	return rows.Err()
+}
+
+// Add awaited reimbursements to their expenses
+func (m *sqliteDBRepo) getExpenseReimbursements(ctx context.Context, currency string, expenses map[int64]*models.GrpcExpense) error {
+	// Define query
+	query := `SELECT
+					expense_id,
+					counterparty,
+					expected,
+					outstanding
+				FROM view_expense_reimbursements;`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	for rows.Next() {
+		var expenseId, expected, outstanding int64
+		var counterparty string
+
+		err = rows.Scan(&expenseId, &counterparty, &expected, &outstanding)
+		if err != nil {
+			return err
+		}
+
+		if expense, ok := expenses[expenseId]; ok {
+			expense.ReimburseFrom = counterparty
+			expense.ReimburseAmount = models.NewGrpcMoney(expected, currency)
+			expense.Outstanding = models.NewGrpcMoney(outstanding, currency)
+		}
+	}
+
+	return rows.Err()
+}
+
+// Get outstanding reimbursements by counterparty
+func (m *sqliteDBRepo) getOutstandingReimbursements(ctx context.Context, currency string) ([]*models.GrpcOutstandingReimbursement, error) {
+	// Define query
+	query := `SELECT counterparty, outstanding, expenses FROM view_outstanding_reimbursements;`
+
+	// Get rows
+	rows, err := m.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	outstanding := make([]*models.GrpcOutstandingReimbursement, 0)
+	for rows.Next() {
+		item := &models.GrpcOutstandingReimbursement{Amount: models.NewGrpcMoney(0, currency)}
+
+		err = rows.Scan(&item.Counterparty, &item.Amount.Amount, &item.Expenses)
+		if err != nil {
+			return nil, err
+		}
+
+		outstanding = append(outstanding, item)
+	}
+
+	return outstanding, rows.Err()
+}
+
+// Add refund or reimbursement of an expense
+func (m *sqliteDBRepo) AddExpenseRefund(params *models.ExpenseRefundParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Get refund
+	refund := params.GetRefund()
+
+	// Check kind
+	if !models.ValidRefundKind(refund.GetKind()) {
+		return nil, domainerr.InvalidArgument("unknown refund kind")
+	}
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get expense
+	var fromAccount int64
+	var reimburseFrom sql.NullString
+	err = tx.QueryRowContext(ctx, `SELECT from_account, reimburse_from FROM expenses WHERE id = $1`, refund.ExpenseId).Scan(&fromAccount, &reimburseFrom)
+	if err == sql.ErrNoRows {
+		return nil, domainerr.NotFound("expense not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Reimbursements come from the awaited counterparty by default
+	counterparty := strings.TrimSpace(refund.Counterparty)
+	if counterparty == "" && refund.Kind == models.RefundReimbursement {
+		counterparty = reimburseFrom.String
+	}
+
+	// Refunds go back to the expense account by default
+	account := refund.ToAccountId
+	if account == 0 {
+		account = fromAccount
+	}
+
+	// Get date
+	date := time.Now()
+	if refund.GetDate() != nil {
+		date = refund.Date.AsTime()
+	}
+
+	// Get amount in the account currency and in the base currency
+	amount, baseAmount, err := accountAmount(ctx, tx, refund.GetAmount(), account, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define query
+	stmt := `INSERT INTO procedure_new_expense_refund (
+		expense_id,
+		kind,
+		counterparty,
+		amount,
+		base_amount,
+		to_account,
+		date
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7
+	)`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, refund.ExpenseId, refund.Kind, counterparty, amount, baseAmount, account, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Take last inserted refund
+	var id int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM expense_refunds ORDER BY id DESC LIMIT 1;`).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionAddRefund, auditRefund, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Delete refund. The account and category balances are restored
+func (m *sqliteDBRepo) DeleteExpenseRefund(params *models.DeleteExpenseRefundParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get refund before it's removed
+	before, err := auditState(ctx, tx, auditRefund, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, domainerr.NotFound("refund not found")
+	}
+
+	// Define query
+	stmt := `DELETE FROM procedure_remove_expense_refund WHERE id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionDeleteRefund, auditRefund, params.ID, before)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Set or clear the awaited reimbursement of an expense
+func (m *sqliteDBRepo) SetExpenseReimbursement(params *models.SetExpenseReimbursementParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get amount left after refunds
+	var left int64
+	query := `SELECT
+				e.base_amount - COALESCE((SELECT SUM(base_amount) FROM expense_refunds WHERE expense_id = e.id AND kind = 'refund'), 0)
+			FROM expenses AS e
+			WHERE e.id = $1`
+	err = tx.QueryRowContext(ctx, query, params.ExpenseId).Scan(&left)
+	if err == sql.ErrNoRows {
+		return nil, domainerr.NotFound("expense not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Empty counterparty clears the reimbursement
+	var counterparty sql.NullString
+	var amount sql.NullInt64
+	if name := strings.TrimSpace(params.Counterparty); name != "" {
+		counterparty = sql.NullString{String: name, Valid: true}
+
+		// Get expected amount
+		expected, err := minorUnits(ctx, tx, params.GetAmount())
+		if err != nil {
+			return nil, err
+		}
+		if expected == 0 {
+			expected = left
+		}
+		if expected <= 0 {
+			return nil, domainerr.InvalidArgument("reimbursement amount must be positive")
+		}
+		amount = sql.NullInt64{Int64: expected, Valid: true}
+	}
+
+	// Get expense before the change
+	before, err := auditState(ctx, tx, auditExpense, params.ExpenseId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define query
+	stmt := `UPDATE procedure_set_expense_reimbursement SET
+				reimburse_from = $1,
+				reimburse_amount = $2
+			WHERE id = $3`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, counterparty, amount, params.ExpenseId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log action
+	err = audit(ctx, tx, actionReimbursement, auditExpense, params.ExpenseId, before)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Remove refunds of an expense and its split lines, restoring their balances
+func removeExpenseRefunds(ctx context.Context, tx *sql.Tx, expenseId int64, linesOnly bool) error {
+	// Define query
+	stmt := `DELETE FROM procedure_remove_expense_refund
+			WHERE expense_id IN (SELECT id FROM expenses WHERE parent_id = $1 OR (id = $1 AND NOT $2))`
+
+	// Execute query
+	_, err := tx.ExecContext(ctx, stmt, expenseId, linesOnly)
+	return err
+}
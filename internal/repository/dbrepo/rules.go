@@ -0,0 +1,375 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+// Number of past expenses returned by a rule test
+const ruleTestLimit = 20
+
+// Anything that can run a query - *sql.DB or *sql.Tx
+type rowsQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Get rules in the order they are applied
+func (m *sqliteDBRepo) GetRules(params *models.GrpcEmpty) (*models.GetRulesReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Get user currency
+	currency, err := getCurrency(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get rules
+	rules, err := getRules(ctx, m.DB, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GetRulesReturns{Rules: rules}, nil
+}
+
+// Get rules from the database
+func getRules(ctx context.Context, q rowsQuerier, currency string) ([]*models.GrpcRule, error) {
+	// Define query
+	query := `SELECT
+					id,
+					name,
+					enabled,
+					min_amount,
+					max_amount,
+					text,
+					account,
+					weekdays,
+					add_tags,
+					set_category,
+					set_account
+				FROM rules
+				ORDER BY id;`
+
+	// Get rows
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Scan rows
+	rules := make([]*models.GrpcRule, 0)
+	for rows.Next() {
+		rule := &models.GrpcRule{}
+		var minAmount, maxAmount, account, setCategory, setAccount sql.NullInt64
+		var addTags string
+
+		err = rows.Scan(
+			&rule.ID,
+			&rule.Name,
+			&rule.Enabled,
+			&minAmount,
+			&maxAmount,
+			&rule.Text,
+			&account,
+			&rule.Weekdays,
+			&addTags,
+			&setCategory,
+			&setAccount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if minAmount.Valid {
+			rule.MinAmount = models.NewGrpcMoney(minAmount.Int64, currency)
+		}
+		if maxAmount.Valid {
+			rule.MaxAmount = models.NewGrpcMoney(maxAmount.Int64, currency)
+		}
+		rule.AccountId = account.Int64
+		rule.AddTags = splitTags(addTags)
+		rule.SetCategoryId = setCategory.Int64
+		rule.SetAccountId = setAccount.Int64
+
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// Add rule
+func (m *sqliteDBRepo) AddRule(params *models.RuleParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get rule values
+	values, err := ruleValues(ctx, tx, params.GetRule())
+	if err != nil {
+		return nil, err
+	}
+
+	// Define query
+	stmt := `INSERT INTO procedure_new_rule (
+		name,
+		enabled,
+		min_amount,
+		max_amount,
+		text,
+		account,
+		weekdays,
+		add_tags,
+		set_category,
+		set_account
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7,
+		$8,
+		$9,
+		$10
+	)`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, values...)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Edit rule
+func (m *sqliteDBRepo) EditRule(params *models.RuleParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get rule values
+	values, err := ruleValues(ctx, tx, params.GetRule())
+	if err != nil {
+		return nil, err
+	}
+
+	// Define query
+	stmt := `UPDATE procedure_update_rule SET
+				name = $1,
+				enabled = $2,
+				min_amount = $3,
+				max_amount = $4,
+				text = $5,
+				account = $6,
+				weekdays = $7,
+				add_tags = $8,
+				set_category = $9,
+				set_account = $10
+			WHERE id = $11`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, append(values, params.Rule.ID)...)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Delete rule
+func (m *sqliteDBRepo) DeleteRule(params *models.DeleteRuleParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Define query
+	stmt := `DELETE FROM procedure_remove_rule WHERE id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return nil, nil
+}
+
+// Test rule against past expenses. The rule doesn't have to be saved or enabled
+func (m *sqliteDBRepo) TestRule(params *models.RuleParams) (*models.TestRuleReturns, error) {
+	// Get expenses
+	expenses, err := m.GetExpenses(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Match expenses
+	ret := &models.TestRuleReturns{Expenses: make([]*models.GrpcExpense, 0, ruleTestLimit)}
+	for _, expense := range expenses.Expenses {
+		matches := models.RuleMatches(params.GetRule(), models.RuleExpense{
+			BaseAmount: expense.BaseAmount.GetAmount(),
+			Payee:      expense.Payee,
+			AccountId:  expense.FromAccount.GetID(),
+			Date:       expense.Date.AsTime(),
+		})
+		if !matches {
+			continue
+		}
+
+		ret.Matched++
+		if len(ret.Expenses) < ruleTestLimit {
+			ret.Expenses = append(ret.Expenses, expense)
+		}
+	}
+
+	return ret, nil
+}
+
+// Apply rules to a new expense, before its tags are updated
+// The account is only set when it has the currency of the expense
+func (m *sqliteDBRepo) applyRules(ctx context.Context, tx *sql.Tx, param *models.ExpensesParams, date time.Time) error {
+	// Get base currency
+	currency, err := getCurrency(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	// Get rules
+	rules, err := getRules(ctx, tx, currency)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+
+	// Get amount in the base currency
+	_, baseAmount, err := accountAmount(ctx, tx, param.Expense.GetAmount(), param.Expense.GetFromAccountId(), date)
+	if err != nil {
+		return err
+	}
+
+	// Apply rules
+	result := models.ApplyRules(rules, models.RuleExpense{
+		BaseAmount: baseAmount,
+		Payee:      param.Payee,
+		AccountId:  param.Expense.GetFromAccountId(),
+		Date:       date,
+	})
+
+	// Add tags
+	for _, tag := range result.Tags {
+		if !hasTag(param.Tags, tag) {
+			param.Tags = append(param.Tags, tag)
+		}
+	}
+
+	// Set category
+	if result.CategoryId != 0 {
+		param.Expense.FromCategoryId = result.CategoryId
+	}
+
+	// Set account
+	if result.AccountId != 0 && result.AccountId != param.Expense.GetFromAccountId() {
+		from, err := getAccountCurrency(ctx, tx, param.Expense.GetFromAccountId())
+		if err != nil {
+			return err
+		}
+		to, err := getAccountCurrency(ctx, tx, result.AccountId)
+		if err != nil {
+			return err
+		}
+		if from == to {
+			param.Expense.FromAccountId = result.AccountId
+		}
+	}
+
+	return nil
+}
+
+// Get values of a rule for the rule procedures
+func ruleValues(ctx context.Context, tx *sql.Tx, rule *models.GrpcRule) ([]any, error) {
+	// Check name
+	name := strings.TrimSpace(rule.GetName())
+	if name == "" {
+		return nil, domainerr.InvalidArgument("rule name is required")
+	}
+
+	// Get amount range
+	var minAmount, maxAmount sql.NullInt64
+	if rule.GetMinAmount() != nil {
+		amount, err := minorUnits(ctx, tx, rule.MinAmount)
+		if err != nil {
+			return nil, err
+		}
+		minAmount = sql.NullInt64{Int64: amount, Valid: true}
+	}
+	if rule.GetMaxAmount() != nil {
+		amount, err := minorUnits(ctx, tx, rule.MaxAmount)
+		if err != nil {
+			return nil, err
+		}
+		maxAmount = sql.NullInt64{Int64: amount, Valid: true}
+	}
+	if minAmount.Valid && maxAmount.Valid && maxAmount.Int64 < minAmount.Int64 {
+		return nil, domainerr.InvalidArgument("maximum amount is smaller than the minimum")
+	}
+
+	// Rules need an action
+	if len(rule.AddTags) == 0 && rule.SetCategoryId == 0 && rule.SetAccountId == 0 {
+		return nil, domainerr.InvalidArgument("rule has no actions")
+	}
+
+	return []any{
+		name,
+		rule.Enabled,
+		minAmount,
+		maxAmount,
+		strings.TrimSpace(rule.Text),
+		nullID(rule.AccountId),
+		rule.Weekdays,
+		strings.Join(rule.AddTags, ","),
+		nullID(rule.SetCategoryId),
+		nullID(rule.SetAccountId),
+	}, nil
+}
+
+// Check if tags contain a tag, without case
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(strings.TrimSpace(t), strings.TrimSpace(tag)) {
+			return true
+		}
+	}
+	return false
+}
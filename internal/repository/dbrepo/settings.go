@@ -0,0 +1,196 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/prefs"
+	"google.golang.org/grpc/codes"
+)
+
+// First user db version with the settings table
+const settingsVersion = 17
+
+// Check if the db has the settings table
+func hasSettings(ctx context.Context, q rowQuerier) (bool, error) {
+	var version int64
+	err := q.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version)
+	if err != nil {
+		return false, err
+	}
+
+	return version >= settingsVersion, nil
+}
+
+// Get user settings. Dbs before the settings version get the defaults
+func (m *sqliteDBRepo) GetSettings(params *models.GrpcEmpty) (*models.GrpcSettings, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Get base currency
+	currency, err := getCurrency(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Default settings
+	settings := &models.GrpcSettings{
+		BaseCurrency:   currency,
+		FirstDayOfWeek: int64(prefs.Default().FirstDayOfWeek),
+		ExpenseRange:   prefs.RangeAll,
+	}
+
+	// Check db version
+	supported, err := hasSettings(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return settings, nil
+	}
+
+	// Define query
+	query := `	SELECT
+					locale,
+					number_format,
+					date_format,
+					first_day_of_week,
+					default_account,
+					default_category,
+					expense_range
+				FROM settings
+				WHERE id = 1`
+
+	// Get settings
+	var account, category sql.NullInt64
+	err = m.DB.QueryRowContext(ctx, query).Scan(
+		&settings.Locale,
+		&settings.NumberFormat,
+		&settings.DateFormat,
+		&settings.FirstDayOfWeek,
+		&account,
+		&category,
+		&settings.ExpenseRange,
+	)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	settings.DefaultAccountID = account.Int64
+	settings.DefaultCategoryID = category.Int64
+
+	return settings, nil
+}
+
+// Update user settings. A new base currency re-values amounts like SetBaseCurrency
+func (m *sqliteDBRepo) UpdateSettings(params *models.UpdateSettingsParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout. A new base currency updates every record with an amount
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Check settings
+	settings := params.GetSettings()
+	if settings == nil {
+		return nil, domainerr.InvalidArgument("settings are required")
+	}
+	if !prefs.ValidLocale(settings.Locale) {
+		return nil, domainerr.InvalidArgument("unsupported locale")
+	}
+	if !prefs.ValidNumberFormat(settings.NumberFormat) {
+		return nil, domainerr.InvalidArgument("unsupported number format")
+	}
+	if !prefs.ValidDateFormat(settings.DateFormat) {
+		return nil, domainerr.InvalidArgument("unsupported date format")
+	}
+	if settings.FirstDayOfWeek < 0 || settings.FirstDayOfWeek > 6 {
+		return nil, domainerr.InvalidArgument("first day of week must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	if !prefs.ValidExpenseRange(settings.ExpenseRange) {
+		return nil, domainerr.InvalidArgument("unsupported expense range")
+	}
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Check db version
+	supported, err := hasSettings(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, domainerr.New(codes.FailedPrecondition, domainerr.ReasonRuleViolation, fmt.Sprintf("user db must be migrated to version %d", settingsVersion))
+	}
+
+	// Check default account and category
+	account := sql.NullInt64{Int64: settings.DefaultAccountID, Valid: settings.DefaultAccountID != 0}
+	if account.Valid {
+		_, err = getAccountCurrency(ctx, tx, account.Int64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	category := sql.NullInt64{Int64: settings.DefaultCategoryID, Valid: settings.DefaultCategoryID != 0}
+	if category.Valid {
+		var id int64
+		err = tx.QueryRowContext(ctx, `SELECT id FROM categories WHERE id = $1`, category.Int64).Scan(&id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domainerr.NotFound("category not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Change base currency
+	var released []string
+	if settings.BaseCurrency != "" {
+		released, err = changeBaseCurrency(ctx, tx, settings.BaseCurrency, params.Rate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Define query
+	stmt := `	UPDATE procedure_update_settings
+				SET
+					locale = $1,
+					number_format = $2,
+					date_format = $3,
+					first_day_of_week = $4,
+					default_account = $5,
+					default_category = $6,
+					expense_range = $7`
+
+	// Execute query
+	_, err = tx.ExecContext(
+		ctx,
+		stmt,
+		settings.Locale,
+		settings.NumberFormat,
+		settings.DateFormat,
+		settings.FirstDayOfWeek,
+		account,
+		category,
+		settings.ExpenseRange,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+
+	// Remove content only undo used
+	m.pruneAttachments(ctx, released)
+
+	return nil, nil
+}
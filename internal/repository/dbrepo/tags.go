@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 )
 
@@ -72,7 +73,7 @@ func (m *sqliteDBRepo) UpdateTags(tags []string, etx *sql.Tx) ([]models.Tag, err
 
 	// There must be tags
 	if len(tags) < 1 {
-		return nil, fmt.Errorf("you must have at least one tag")
+		return nil, domainerr.InvalidArgument("you must have at least one tag")
 	}
 
 	// Store VALUES template
@@ -0,0 +1,389 @@
+package dbrepo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/totp"
+	"google.golang.org/grpc/codes"
+)
+
+// First user db version with two-factor authentication
+const twoFactorVersion = 16
+
+// Issuer shown in authenticator apps
+const totpIssuer = "Expenses"
+
+// Code is neither a current one-time password nor an unused recovery code
+var errInvalidCode = domainerr.InvalidArgument("invalid code")
+
+// Two-factor settings of the user
+type twoFactorState struct {
+	id          int64
+	email       string
+	secret      sql.NullString
+	pending     sql.NullString
+	lastCounter int64
+}
+
+// Get two-factor settings of the user. Dbs before the two-factor version have it disabled
+func getTwoFactorState(ctx context.Context, q rowQuerier) (*twoFactorState, bool, error) {
+	// Check db version
+	var version int64
+	err := q.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version)
+	if err != nil {
+		return nil, false, err
+	}
+	if version < twoFactorVersion {
+		return nil, false, nil
+	}
+
+	// Define query
+	query := `SELECT id, email, totp_secret, totp_pending_secret, totp_last_counter FROM user LIMIT 1`
+
+	// Get state
+	state := &twoFactorState{}
+	err = q.QueryRowContext(ctx, query).Scan(&state.id, &state.email, &state.secret, &state.pending, &state.lastCounter)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return state, true, nil
+}
+
+// Require a db with two-factor support
+func requireTwoFactorVersion(supported bool) error {
+	if !supported {
+		return domainerr.New(codes.FailedPrecondition, domainerr.ReasonRuleViolation, fmt.Sprintf("user db must be migrated to version %d", twoFactorVersion))
+	}
+	return nil
+}
+
+// Get whether two-factor authentication is on and how many recovery codes are left
+func (m *sqliteDBRepo) GetTwoFactor(params *models.GrpcEmpty) (*models.GrpcTwoFactor, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Get state
+	state, supported, err := getTwoFactorState(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+	if !supported || !state.secret.Valid {
+		return &models.GrpcTwoFactor{}, nil
+	}
+
+	// Count unused recovery codes
+	var left int64
+	err = m.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM recovery_codes WHERE used_at IS NULL`).Scan(&left)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GrpcTwoFactor{Enabled: true, RecoveryCodesLeft: left}, nil
+}
+
+// Create a new secret. It's used once a code from it is confirmed with EnableTwoFactor
+func (m *sqliteDBRepo) BeginTwoFactorSetup(params *models.GrpcEmpty) (*models.BeginTwoFactorSetupReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get state
+	state, supported, err := getTwoFactorState(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	err = requireTwoFactorVersion(supported)
+	if err != nil {
+		return nil, err
+	}
+	if state.secret.Valid {
+		return nil, domainerr.New(codes.FailedPrecondition, domainerr.ReasonRuleViolation, "two-factor authentication is already enabled")
+	}
+
+	// Create secret
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	// Define query
+	stmt := `UPDATE procedure_set_totp_pending_secret SET totp_pending_secret = $1 WHERE id = $2`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, hex.EncodeToString(secret), state.id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return &models.BeginTwoFactorSetupReturns{
+		Secret: totp.EncodeSecret(secret),
+		URI:    totp.URI(totpIssuer, state.email, secret),
+	}, nil
+}
+
+// Turn two-factor authentication on with a code from the pending secret. Returns new recovery codes
+func (m *sqliteDBRepo) EnableTwoFactor(params *models.TwoFactorCodeParams) (*models.RecoveryCodesReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get state
+	state, supported, err := getTwoFactorState(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	err = requireTwoFactorVersion(supported)
+	if err != nil {
+		return nil, err
+	}
+	if !state.pending.Valid {
+		return nil, domainerr.New(codes.FailedPrecondition, domainerr.ReasonRuleViolation, "two-factor setup was not started")
+	}
+
+	// Check code against the pending secret
+	secret, err := hex.DecodeString(state.pending.String)
+	if err != nil {
+		return nil, err
+	}
+	counter, ok := totp.Validate(secret, params.GetCode(), time.Now())
+	if !ok {
+		return nil, errInvalidCode
+	}
+
+	// Define query
+	stmt := `UPDATE procedure_enable_totp SET totp_last_counter = $1 WHERE id = $2`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, int64(counter), state.id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add recovery codes
+	codes, err := addRecoveryCodes(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return &models.RecoveryCodesReturns{Codes: codes}, nil
+}
+
+// Turn two-factor authentication off. It takes a one-time password or a recovery code
+func (m *sqliteDBRepo) DisableTwoFactor(params *models.TwoFactorCodeParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Check code
+	state, err := checkTwoFactorCode(ctx, tx, params.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// Define query
+	stmt := `DELETE FROM procedure_disable_totp WHERE id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, state.id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return &models.GrpcEmpty{}, nil
+}
+
+// Replace recovery codes. It takes a one-time password or a recovery code
+func (m *sqliteDBRepo) RegenerateRecoveryCodes(params *models.TwoFactorCodeParams) (*models.RecoveryCodesReturns, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Check code
+	_, err = checkTwoFactorCode(ctx, tx, params.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove old codes
+	_, err = tx.ExecContext(ctx, `DELETE FROM recovery_codes`)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add recovery codes
+	codes, err := addRecoveryCodes(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return &models.RecoveryCodesReturns{Codes: codes}, nil
+}
+
+// Check the second login step. The code is used up, so it can't be checked twice
+func (m *sqliteDBRepo) CheckTwoFactor(params *models.TwoFactorCodeParams) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Check code
+	_, err = checkTwoFactorCode(ctx, tx, params.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return &models.GrpcEmpty{}, nil
+}
+
+// Turn two-factor authentication off without a code. Used by administrators when the user lost their device and codes
+func (m *sqliteDBRepo) ResetTwoFactor(params *models.GrpcEmpty) (*models.GrpcEmpty, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Start transaction
+	tx, err := m.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Get state
+	state, supported, err := getTwoFactorState(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	err = requireTwoFactorVersion(supported)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define query
+	stmt := `DELETE FROM procedure_disable_totp WHERE id = $1`
+
+	// Execute query
+	_, err = tx.ExecContext(ctx, stmt, state.id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return &models.GrpcEmpty{}, nil
+}
+
+// Check a one-time password or a recovery code and use it up
+func checkTwoFactorCode(ctx context.Context, tx *sql.Tx, code string) (*twoFactorState, error) {
+	// Get state
+	state, supported, err := getTwoFactorState(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !supported || !state.secret.Valid {
+		return nil, domainerr.New(codes.FailedPrecondition, domainerr.ReasonRuleViolation, "two-factor authentication is not enabled")
+	}
+
+	// Check one-time password. Steps that were used already are rejected
+	secret, err := hex.DecodeString(state.secret.String)
+	if err != nil {
+		return nil, err
+	}
+	counter, ok := totp.Validate(secret, code, time.Now())
+	if ok {
+		if int64(counter) <= state.lastCounter {
+			return nil, errInvalidCode
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE procedure_use_totp_counter SET totp_last_counter = $1 WHERE id = $2`, int64(counter), state.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return state, nil
+	}
+
+	// Check recovery code
+	var id int64
+	query := `SELECT id FROM recovery_codes WHERE code_hash = $1 AND used_at IS NULL`
+	err = tx.QueryRowContext(ctx, query, totp.HashRecoveryCode(code)).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, errInvalidCode
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE procedure_use_recovery_code SET used_at = datetime('now') WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Create recovery codes and store their hashes
+func addRecoveryCodes(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	codes, err := totp.NewRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	// Define query
+	stmt := `INSERT INTO procedure_add_recovery_code (code_hash) VALUES ($1)`
+
+	// Execute query for every code
+	for _, code := range codes {
+		_, err = tx.ExecContext(ctx, stmt, totp.HashRecoveryCode(code))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return codes, nil
+}
@@ -23,12 +23,12 @@ func (m *sqliteDBRepo) GetUser(empty *models.GrpcEmpty) (*models.GrpcUser, error
 	defer cancel()
 
 	// Define empty model
-	u := &models.GrpcUser{}
+	u := &models.GrpcUser{FreeFunds: &models.GrpcMoney{}}
 	var createdAt time.Time
 	var updatedAt sql.NullTime
 
 	// Define query
-	query := `SELECT id, email, password, db_version, free_funds, created_at, updated_at
+	query := `SELECT id, email, password, db_version, free_funds, currency, created_at, updated_at
 				FROM user LIMIT 1`
 
 	// Get row
@@ -40,7 +40,8 @@ func (m *sqliteDBRepo) GetUser(empty *models.GrpcEmpty) (*models.GrpcUser, error
 		&u.Email,
 		&u.Password,
 		&u.DBVersion,
-		&u.FreeFunds,
+		&u.FreeFunds.Amount,
+		&u.Currency,
 		&createdAt,
 		&updatedAt,
 	)
@@ -50,6 +51,7 @@ func (m *sqliteDBRepo) GetUser(empty *models.GrpcEmpty) (*models.GrpcUser, error
 		return u, err
 	}
 
+	u.FreeFunds.Currency = u.Currency
 	u.CreatedAt = timestamppb.New(createdAt)
 	if updatedAt.Valid {
 		u.UpdatedAt = timestamppb.New(updatedAt.Time)
@@ -96,31 +98,20 @@ func (m *sqliteDBRepo) ModifyFreeFunds(params *models.ModifyFreeFundsParams) (*m
 	}
 	defer tx.Rollback()
 
-	// Update tags
-	tags, err := m.UpdateTags([]string{params.TagName}, tx)
-	if err != nil || len(tags) != 1 {
+	// Add as an income dated now
+	id, err := m.insertIncome(ctx, tx, &models.IncomeParams{
+		Income: &models.GrpcIncome{
+			Amount:      params.Amount,
+			ToAccountId: params.ToAccountId,
+		},
+		Tags: []string{params.TagName},
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// Define query to insert account
-	stmt := `INSERT INTO procedure_add_free_funds (
-		amount,
-		to_account,
-		tag_id
-	) VALUES (
-		$1,
-		$2,
-		$3
-	)`
-
-	// Execute query
-	_, err = tx.ExecContext(
-		ctx,
-		stmt,
-		params.Amount,
-		params.ToAccountId,
-		tags[0].ID,
-	)
+	// Log action
+	err = audit(ctx, tx, actionAddFreeFunds, auditIncome, id, nil)
 	if err != nil {
 		return nil, err
 	}
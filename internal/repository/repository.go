@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"io"
+	"time"
+
 	"github.com/dimitargrozev5/expenses-go-1/internal/models"
 )
 
@@ -10,6 +13,19 @@ type DatabaseRepo interface {
 	Authenticate(testPassword string) (int64, string, int64, error)
 	ModifyFreeFunds(params *models.ModifyFreeFundsParams) (*models.GrpcEmpty, error)
 
+	// Two-factor methods
+	GetTwoFactor(params *models.GrpcEmpty) (*models.GrpcTwoFactor, error)
+	BeginTwoFactorSetup(params *models.GrpcEmpty) (*models.BeginTwoFactorSetupReturns, error)
+	EnableTwoFactor(params *models.TwoFactorCodeParams) (*models.RecoveryCodesReturns, error)
+	DisableTwoFactor(params *models.TwoFactorCodeParams) (*models.GrpcEmpty, error)
+	RegenerateRecoveryCodes(params *models.TwoFactorCodeParams) (*models.RecoveryCodesReturns, error)
+	CheckTwoFactor(params *models.TwoFactorCodeParams) (*models.GrpcEmpty, error)
+	ResetTwoFactor(params *models.GrpcEmpty) (*models.GrpcEmpty, error)
+
+	// Settings methods
+	GetSettings(params *models.GrpcEmpty) (*models.GrpcSettings, error)
+	UpdateSettings(params *models.UpdateSettingsParams) (*models.GrpcEmpty, error)
+
 	// Tags methods
 	GetTags(empty *models.GrpcEmpty) (*models.GetTagsReturns, error)
 
@@ -18,6 +34,46 @@ type DatabaseRepo interface {
 	AddExpense(param *models.ExpensesParams) (*models.GrpcEmpty, error)
 	EditExpense(param *models.ExpensesParams) (*models.GrpcEmpty, error)
 	DeleteExpense(param *models.DeleteExpenseParams) (*models.GrpcEmpty, error)
+	AddExpenseRefund(params *models.ExpenseRefundParams) (*models.GrpcEmpty, error)
+	DeleteExpenseRefund(params *models.DeleteExpenseRefundParams) (*models.GrpcEmpty, error)
+	SetExpenseReimbursement(params *models.SetExpenseReimbursementParams) (*models.GrpcEmpty, error)
+
+	// Payee methods
+	GetPayees(params *models.GrpcEmpty) (*models.GetPayeesReturns, error)
+	AddPayee(params *models.PayeeParams) (*models.GrpcEmpty, error)
+	EditPayee(params *models.PayeeParams) (*models.GrpcEmpty, error)
+	DeletePayee(params *models.DeletePayeeParams) (*models.GrpcEmpty, error)
+
+	// Rule methods
+	GetRules(params *models.GrpcEmpty) (*models.GetRulesReturns, error)
+	AddRule(params *models.RuleParams) (*models.GrpcEmpty, error)
+	EditRule(params *models.RuleParams) (*models.GrpcEmpty, error)
+	DeleteRule(params *models.DeleteRuleParams) (*models.GrpcEmpty, error)
+	TestRule(params *models.RuleParams) (*models.TestRuleReturns, error)
+
+	// Attachment methods
+	AddAttachment(params *models.GrpcAttachment, content io.Reader) (*models.GrpcAttachment, error)
+	OpenAttachment(params *models.GetAttachmentParams) (*models.GrpcAttachment, io.ReadCloser, error)
+	DeleteAttachment(params *models.DeleteAttachmentParams) (*models.GrpcEmpty, error)
+
+	// Audit log methods
+	GetAuditLog(params *models.GrpcEmpty) (*models.GetAuditLogReturns, error)
+	UndoLastAction(params *models.GrpcEmpty) (*models.GrpcEmpty, error)
+
+	// Integrity methods
+	VerifyIntegrity(params *models.VerifyIntegrityParams) (*models.VerifyIntegrityReturns, error)
+
+	// Income methods
+	GetIncomes(params *models.GrpcEmpty) (*models.GetIncomesReturns, error)
+	AddIncome(params *models.IncomeParams) (*models.GrpcEmpty, error)
+	EditIncome(params *models.IncomeParams) (*models.GrpcEmpty, error)
+	DeleteIncome(params *models.DeleteIncomeParams) (*models.GrpcEmpty, error)
+
+	// Liability methods
+	GetLiabilities(params *models.GrpcEmpty) (*models.GetLiabilitiesReturns, error)
+	AddLiability(params *models.AddLiabilityParams) (*models.GrpcEmpty, error)
+	DeleteLiability(params *models.DeleteLiabilityParams) (*models.GrpcEmpty, error)
+	AddLiabilityPayment(params *models.LiabilityPaymentParams) (*models.GrpcEmpty, error)
 
 	// Account methods
 	GetAccounts(params *models.GetAccountsParams) (*models.GetAccountsReturns, error)
@@ -27,6 +83,12 @@ type DatabaseRepo interface {
 	TransferFunds(params *models.TransferFundsParams) (*models.GrpcEmpty, error)
 	ReorderAccount(params *models.ReorderAccountParams) (*models.GrpcEmpty, error)
 
+	// Exchange rates methods
+	GetExchangeRates(params *models.GrpcEmpty) (*models.GetExchangeRatesReturns, error)
+	SetExchangeRates(params *models.SetExchangeRatesParams) (*models.GrpcEmpty, error)
+	DeleteExchangeRate(params *models.DeleteExchangeRateParams) (*models.GrpcEmpty, error)
+	SetBaseCurrency(params *models.SetBaseCurrencyParams) (*models.GrpcEmpty, error)
+
 	// Categories methods
 	// GetCategoriesCount(params *models.GrpcEmpty) (*models.GetCategoriesCountReturns, error)
 	GetCategories(params *models.GrpcEmpty) (*models.GetCategoriesReturns, error)
@@ -35,6 +97,9 @@ type DatabaseRepo interface {
 	ReorderCategory(params *models.ReorderCategoryParams) (*models.GrpcEmpty, error)
 	DeleteCategory(params *models.DeleteCategoryParams) (*models.GrpcEmpty, error)
 	ResetCategories(params *models.ResetCategoriesParams) (*models.GrpcEmpty, error)
+	SetCategoryAutoReset(params *models.SetCategoryAutoResetParams) (*models.GrpcEmpty, error)
+	SetCategoryGoal(params *models.SetCategoryGoalParams) (*models.GrpcEmpty, error)
+	AutoResetCategories(now time.Time) (int, int, error)
 
 	// Time periods
 	GetTimePeriods(empty *models.GrpcEmpty) (*models.GetTimePeriodsReturns, error)
@@ -55,8 +55,7 @@ func (m *DatabaseServer) Authenticate(ctx context.Context, lc *models.LoginCrede
 	// key := dbrepo.GetUserKey(lc.Email)
 
 	// // Add connection to repo
-	// m.App.DBConnections[key] = dbconn
-	// m.App.DBRepos[key] = repo
+	// m.App.OpenDBs.Add(key, config.OpenDB{Conn: dbconn, Repo: repo})
 
 	// // Crate JWT to authenticate user
 	// t := jwt.NewWithClaims(jwt.SigningMethodHS256, //jwt.SigningMethodES256,
@@ -79,17 +78,17 @@ func (m *DatabaseServer) Authenticate(ctx context.Context, lc *models.LoginCrede
 // Handle posting to login
 func (m *DatabaseServer) Logout(ctx context.Context, params *models.LogoutParams) (*models.GrpcEmpty, error) {
 
-	// Get db
-	dbconn, ok := m.GetDBConn(ctx)
+	// Get current token id
+	jti, ok := ctx.Value("jti").(string)
 	if !ok {
-		return nil, fmt.Errorf("can't find user db connection")
+		return nil, fmt.Errorf("can't find user session")
 	}
 
-	// Close connection
-	err := dbconn.SQL.Close()
+	// Revoke session
+	err := m.App.CtrlDBRepo.RevokeSessionByJti(jti)
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, nil
+	return &models.GrpcEmpty{}, nil
 }
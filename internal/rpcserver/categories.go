@@ -96,3 +96,33 @@ func (m *DatabaseServer) ResetCategories(ctx context.Context, params *models.Res
 
 	return ret, nil
 }
+
+func (m *DatabaseServer) SetCategoryAutoReset(ctx context.Context, params *models.SetCategoryAutoResetParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.SetCategoryAutoReset(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *DatabaseServer) SetCategoryGoal(ctx context.Context, params *models.SetCategoryGoalParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.SetCategoryGoal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
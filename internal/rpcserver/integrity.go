@@ -0,0 +1,23 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+)
+
+func (m *DatabaseServer) VerifyIntegrity(ctx context.Context, params *models.VerifyIntegrityParams) (*models.VerifyIntegrityReturns, error) {
+	// Get db
+	db, ok := m.GetDB(ctx)
+	if !ok {
+		return nil, fmt.Errorf("can't find user db connection")
+	}
+
+	ret, err := db.VerifyIntegrity(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -2,7 +2,13 @@ package rpcserver
 
 import (
 	"context"
+	"runtime/debug"
 	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/logging"
+	"github.com/dimitargrozev5/expenses-go-1/internal/tracing"
 
 	"github.com/dimitargrozev5/expenses-go-1/internal/jwtutil"
 	"google.golang.org/grpc"
@@ -14,14 +20,31 @@ import (
 var (
 	errMissingMetadata = status.Errorf(codes.InvalidArgument, "missing metadata")
 	errInvalidToken    = status.Errorf(codes.Unauthenticated, "invalid token")
+	errRevokedToken    = status.Errorf(codes.Unauthenticated, "session revoked")
+	errNodeOnly        = status.Errorf(codes.PermissionDenied, "method is available to DB Nodes only")
 )
 
+// Methods that don't require a token
+var publicMethods = []string{"/Authenticate", "/RefreshToken", "/grpc.health.v1.Health/Check"}
+
+// Methods called by DB Nodes only
+var nodeMethods = []string{"/RegisterNode", "/DeregisterNode", "/CreateSession", "/ValidateSession", "/RotateSession", "/GetUserSessions", "/RevokeUserSession", "/CheckLoginAttempt", "/RecordLoginAttempt"}
+
+func hasMethodSuffix(fullMethod string, methods []string) bool {
+	for _, method := range methods {
+		if strings.HasSuffix(fullMethod, method) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s DatabaseServer) AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 	// Create context
 	userCtx := ctx
 
 	// Skip auth for some methods
-	if !strings.HasSuffix(info.FullMethod, "/Authenticate") {
+	if !hasMethodSuffix(info.FullMethod, publicMethods) {
 		// authentication (token verification)
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
@@ -39,14 +62,115 @@ func (s DatabaseServer) AuthInterceptor(ctx context.Context, req any, info *grpc
 			return nil, errInvalidToken
 		}
 
+		// Node tokens don't carry a user key
+		userKey, isUser := claims["userKey"]
+		if isUser {
+			logging.AddFields(ctx, "userKey", userKey)
+		}
+		if isUser && hasMethodSuffix(info.FullMethod, nodeMethods) {
+			return nil, errNodeOnly
+		}
+
+		// Check if user session is still active
+		if jti, ok := claims["jti"].(string); ok {
+			active, err := s.App.CtrlDBRepo.IsSessionActive(jti)
+			if err != nil || !active {
+				return nil, errRevokedToken
+			}
+			userCtx = context.WithValue(userCtx, "jti", jti)
+		} else if isUser {
+			return nil, errInvalidToken
+		}
+
 		// Store token details
-		userCtx = context.WithValue(ctx, "userKey", claims["userKey"])
+		userCtx = context.WithValue(userCtx, "userKey", claims["userKey"])
 		userCtx = context.WithValue(userCtx, "dbVersion", claims["dbVersion"])
 	}
 
-	m, err := handler(userCtx, req)
+	return handler(userCtx, req)
+}
+
+// Limit calls of every user with a token bucket. Calls of DB Nodes aren't limited
+func (s DatabaseServer) RateLimitInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	userKey, ok := ctx.Value("userKey").(string)
+	if ok && s.App.RateLimiter != nil {
+		allowed, wait := s.App.RateLimiter.Allow(userKey)
+		if !allowed {
+			return nil, domainerr.ResourceExhausted("too many requests", wait)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// Convert panics in handlers to internal errors
+func (s DatabaseServer) RecoveryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (m any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.App.Logger.ErrorContext(ctx, "rpc panicked", "method", info.FullMethod, "panic", r, "stack", string(debug.Stack()))
+			err = status.Errorf(codes.Internal, "internal error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// Log calls and convert handler errors to gRPC statuses
+func (s DatabaseServer) LoggingInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+
+	// Continue request started by the caller
+	ctx = logging.IncomingContext(ctx)
+	ctx = logging.WithFields(ctx)
+	ctx, span := tracing.StartServerSpan(tracing.Extract(ctx), info.FullMethod)
+
+	m, err := handler(ctx, req)
+	err = domainerr.ToStatus(err)
+
+	tracing.EndRPCSpan(span, err)
+
+	// Log call
+	attrs := []any{"method", info.FullMethod, "latency", time.Since(start), "status", status.Code(err).String()}
 	if err != nil {
-		s.App.ErrorLog.Fatalf("RPC failed with error: %v", err)
+		s.App.Logger.ErrorContext(ctx, "rpc failed", append(attrs, "error", err)...)
+	} else {
+		s.App.Logger.InfoContext(ctx, "rpc finished", attrs...)
 	}
+
 	return m, err
 }
+
+// Stream with the context set by an interceptor
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// Run a unary interceptor around a stream handler
+func asStream(interceptor grpc.UnaryServerInterceptor, srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	unaryInfo := &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod}
+	_, err := interceptor(ss.Context(), nil, unaryInfo, func(ctx context.Context, req any) (any, error) {
+		return nil, handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	})
+	return err
+}
+
+func (s DatabaseServer) AuthStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return asStream(s.AuthInterceptor, srv, ss, info, handler)
+}
+
+func (s DatabaseServer) RateLimitStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return asStream(s.RateLimitInterceptor, srv, ss, info, handler)
+}
+
+func (s DatabaseServer) RecoveryStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return asStream(s.RecoveryInterceptor, srv, ss, info, handler)
+}
+
+func (s DatabaseServer) LoggingStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return asStream(s.LoggingInterceptor, srv, ss, info, handler)
+}
@@ -18,3 +18,16 @@ func (m *DatabaseServer) RegisterNode(ctx context.Context, params *models.DBNode
 
 	return nil, nil
 }
+
+// Mark node as stopped
+func (m *DatabaseServer) DeregisterNode(ctx context.Context, params *models.DBNodeData) (*models.GrpcEmpty, error) {
+	// Get db
+	db := m.App.CtrlDBRepo
+
+	_, err := db.DeregisterNode(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GrpcEmpty{}, nil
+}
@@ -40,9 +40,9 @@ func (m *DatabaseServer) GetDB(ctx context.Context) (repository.DatabaseRepo, bo
 	}
 
 	// Get db connection
-	db, ok := m.App.DBRepos[userKey]
+	db, ok := m.App.OpenDBs.Get(userKey)
 
-	return db, ok
+	return db.Repo, ok
 }
 
 // Get user connection
@@ -54,7 +54,7 @@ func (m *DatabaseServer) GetDBConn(ctx context.Context) (*driver.DB, bool) {
 	}
 
 	// Get db connection
-	db, ok := m.App.DBConnections[userKey]
+	db, ok := m.App.OpenDBs.Get(userKey)
 
-	return db, ok
+	return db.Conn, ok
 }
@@ -0,0 +1,201 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/jwtutil"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Create a session for a user that has logged in on a DB Node
+func (m *DatabaseServer) CreateSession(ctx context.Context, params *models.CreateSessionParams) (*models.GrpcSessionToken, error) {
+	// Get db
+	db := m.App.CtrlDBRepo
+
+	// Generate token identifiers
+	jti, err := jwtutil.NewJTI()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := jwtutil.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get session expiry
+	expiresAt := time.Now().Add(jwtutil.RefreshTokenLifetime)
+
+	// Store session
+	id, err := db.AddSession(params.UserKey, jti, jwtutil.HashRefreshToken(refreshToken), params.Device, params.RemoteAddress, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GrpcSessionToken{
+		ID:           id,
+		UserKey:      params.UserKey,
+		Jti:          jti,
+		RefreshToken: refreshToken,
+		ExpiresAt:    timestamppb.New(expiresAt),
+	}, nil
+}
+
+// Check if a session is active
+func (m *DatabaseServer) ValidateSession(ctx context.Context, params *models.ValidateSessionParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db := m.App.CtrlDBRepo
+
+	active, err := db.IsSessionActive(params.Jti)
+	if err != nil {
+		return nil, err
+	}
+
+	if !active {
+		return nil, errRevokedToken
+	}
+
+	return &models.GrpcEmpty{}, nil
+}
+
+// Exchange a refresh token for new session identifiers
+func (m *DatabaseServer) RotateSession(ctx context.Context, params *models.RotateSessionParams) (*models.GrpcSessionToken, error) {
+	// Get db
+	db := m.App.CtrlDBRepo
+
+	// Generate new token identifiers
+	jti, err := jwtutil.NewJTI()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := jwtutil.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get session expiry
+	expiresAt := time.Now().Add(jwtutil.RefreshTokenLifetime)
+
+	// Rotate session
+	session, err := db.RotateSession(jwtutil.HashRefreshToken(params.RefreshToken), jti, jwtutil.HashRefreshToken(refreshToken), expiresAt)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return &models.GrpcSessionToken{
+		ID:           session.ID,
+		UserKey:      session.UserKey,
+		Jti:          jti,
+		RefreshToken: refreshToken,
+		ExpiresAt:    timestamppb.New(expiresAt),
+	}, nil
+}
+
+// Get active sessions of a user
+func (m *DatabaseServer) GetUserSessions(ctx context.Context, params *models.UserSessionsParams) (*models.GetSessionsReturns, error) {
+	// Get db
+	db := m.App.CtrlDBRepo
+
+	sessions, err := db.GetActiveSessions(params.UserKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to grpc models
+	ret := make([]*models.GrpcSession, 0, len(sessions))
+	for _, session := range sessions {
+		ret = append(ret, sessionToGrpc(session, params.CurrentJti))
+	}
+
+	return &models.GetSessionsReturns{Sessions: ret}, nil
+}
+
+// Revoke a session of a user either by id or by jti
+func (m *DatabaseServer) RevokeUserSession(ctx context.Context, params *models.RevokeUserSessionParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db := m.App.CtrlDBRepo
+
+	var err error
+	if len(params.Jti) > 0 {
+		err = db.RevokeSessionByJti(params.Jti)
+	} else {
+		err = db.RevokeSession(params.UserKey, params.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GrpcEmpty{}, nil
+}
+
+func (m *DatabaseServer) RefreshToken(ctx context.Context, params *models.RefreshTokenParams) (*models.LoginToken, error) {
+	// Rotate session
+	session, err := m.RotateSession(ctx, &models.RotateSessionParams{RefreshToken: params.RefreshToken})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get db
+	db, ok := m.App.OpenDBs.Get(session.UserKey)
+	if !ok {
+		return nil, errInvalidToken
+	}
+
+	// Get user db version
+	user, err := db.Repo.GetUser(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign new access token
+	token, expiresAt, err := jwtutil.Repo.GenerateAccessToken(session.UserKey, user.DBVersion, session.Jti)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.LoginToken{
+		Token:        token,
+		RefreshToken: session.RefreshToken,
+		ExpiresAt:    timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (m *DatabaseServer) GetSessions(ctx context.Context, params *models.GrpcEmpty) (*models.GetSessionsReturns, error) {
+	// Get user key
+	userKey, ok := ctx.Value("userKey").(string)
+	if !ok {
+		return nil, fmt.Errorf("can't find user")
+	}
+
+	// Get current token id
+	jti, _ := ctx.Value("jti").(string)
+
+	return m.GetUserSessions(ctx, &models.UserSessionsParams{UserKey: userKey, CurrentJti: jti})
+}
+
+func (m *DatabaseServer) RevokeSession(ctx context.Context, params *models.RevokeSessionParams) (*models.GrpcEmpty, error) {
+	// Get user key
+	userKey, ok := ctx.Value("userKey").(string)
+	if !ok {
+		return nil, fmt.Errorf("can't find user")
+	}
+
+	return m.RevokeUserSession(ctx, &models.RevokeUserSessionParams{UserKey: userKey, ID: params.ID})
+}
+
+// Convert session to grpc model
+func sessionToGrpc(session models.Session, currentJti string) *models.GrpcSession {
+	return &models.GrpcSession{
+		ID:            session.ID,
+		Device:        session.Device,
+		RemoteAddress: session.RemoteAddress,
+		Current:       session.Jti == currentJti,
+		LastSeenAt:    timestamppb.New(session.LastSeenAt),
+		ExpiresAt:     timestamppb.New(session.ExpiresAt),
+		CreatedAt:     timestamppb.New(session.CreatedAt),
+	}
+}
@@ -0,0 +1,104 @@
+package rpcserver
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/domainerr"
+	"github.com/dimitargrozev5/expenses-go-1/internal/models"
+	"github.com/dimitargrozev5/expenses-go-1/internal/ratelimit"
+)
+
+// Scopes of login throttles
+const (
+	accountScope = "account"
+	ipScope      = "ip"
+)
+
+// Backoff of failed logins of an account. Guessing passwords of one user gets slow quickly
+var accountBackoff = ratelimit.Backoff{
+	Free:   5,
+	Base:   30 * time.Second,
+	Max:    15 * time.Minute,
+	Window: 24 * time.Hour,
+}
+
+// Backoff of failed logins from an address. It allows for users sharing an address
+var ipBackoff = ratelimit.Backoff{
+	Free:   20,
+	Base:   30 * time.Second,
+	Max:    time.Hour,
+	Window: 24 * time.Hour,
+}
+
+// Get address without port
+func throttleIP(remoteAddress string) string {
+	host, _, err := net.SplitHostPort(remoteAddress)
+	if err != nil {
+		return remoteAddress
+	}
+	return host
+}
+
+// Check if a login is allowed before the password is checked
+func (m *DatabaseServer) CheckLoginAttempt(ctx context.Context, params *models.LoginAttemptParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db := m.App.CtrlDBRepo
+
+	// Get lockouts
+	accountLockout, err := db.GetLoginLockout(accountScope, params.UserKey)
+	if err != nil {
+		return nil, err
+	}
+	ipLockout, err := db.GetLoginLockout(ipScope, throttleIP(params.RemoteAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for the later one
+	lockedUntil := accountLockout
+	if ipLockout.After(lockedUntil) {
+		lockedUntil = ipLockout
+	}
+	if wait := time.Until(lockedUntil); wait > 0 {
+		return nil, domainerr.ResourceExhausted("too many login attempts", wait.Round(time.Second)+time.Second)
+	}
+
+	return &models.GrpcEmpty{}, nil
+}
+
+// Record the result of a login. Failures extend the lockouts and success clears the account one
+func (m *DatabaseServer) RecordLoginAttempt(ctx context.Context, params *models.LoginAttemptParams) (*models.GrpcEmpty, error) {
+	// Get db
+	db := m.App.CtrlDBRepo
+
+	if params.Success {
+		err := db.ClearLoginFailures(accountScope, params.UserKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.GrpcEmpty{}, nil
+	}
+
+	// Count failure
+	lockedUntil, err := db.AddLoginFailure(accountScope, params.UserKey, accountBackoff)
+	if err != nil {
+		return nil, err
+	}
+	if !lockedUntil.IsZero() {
+		m.App.Logger.WarnContext(ctx, "account login locked", "userKey", params.UserKey, "until", lockedUntil)
+	}
+
+	ip := throttleIP(params.RemoteAddress)
+	lockedUntil, err = db.AddLoginFailure(ipScope, ip, ipBackoff)
+	if err != nil {
+		return nil, err
+	}
+	if !lockedUntil.IsZero() {
+		m.App.Logger.WarnContext(ctx, "address login locked", "remoteAddress", ip, "until", lockedUntil)
+	}
+
+	return &models.GrpcEmpty{}, nil
+}
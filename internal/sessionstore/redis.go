@@ -0,0 +1,70 @@
+package sessionstore
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Prefix of session keys
+const redisPrefix = "scs:session:"
+
+// Sessions in a server that speaks the Redis protocol. Keys expire with the sessions,
+// so there's no cleanup. Several web instances can share it
+type RedisStore struct {
+	client *redisClient
+}
+
+// Create a Redis session store. Connections are made on first use
+func NewRedis(addr, password string) *RedisStore {
+	return &RedisStore{
+		client: newRedisClient(addr, password, 3*time.Second, 10),
+	}
+}
+
+// Check that the server can be reached
+func (s *RedisStore) Ping() error {
+	_, err := s.client.do("PING")
+	return err
+}
+
+// Get data of an unexpired session
+func (s *RedisStore) Find(token string) ([]byte, bool, error) {
+	reply, err := s.client.do("GET", redisPrefix+token)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+
+	data, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected reply to GET: %v", reply)
+	}
+
+	return data, true, nil
+}
+
+// Add or replace a session. The key expires with the session
+func (s *RedisStore) Commit(token string, data []byte, expiry time.Time) error {
+	ttl := time.Until(expiry).Milliseconds()
+	if ttl <= 0 {
+		return s.Delete(token)
+	}
+
+	_, err := s.client.do("SET", redisPrefix+token, string(data), "PX", strconv.FormatInt(ttl, 10))
+	return err
+}
+
+// Remove a session
+func (s *RedisStore) Delete(token string) error {
+	_, err := s.client.do("DEL", redisPrefix+token)
+	return err
+}
+
+// Close idle connections
+func (s *RedisStore) Close() error {
+	s.client.close()
+	return nil
+}
@@ -0,0 +1,197 @@
+package sessionstore
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Error reply of the server
+type RedisError string
+
+func (e RedisError) Error() string {
+	return string(e)
+}
+
+// Minimal client of the Redis serialization protocol (RESP2). It covers the commands the
+// session store needs and works with Redis, Valkey, KeyDB and other compatible servers
+type redisClient struct {
+	addr     string
+	password string
+	timeout  time.Duration
+
+	// Idle connections
+	pool chan *redisConn
+}
+
+// Connection to the server
+type redisConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	w    *bufio.Writer
+}
+
+func newRedisClient(addr, password string, timeout time.Duration, poolSize int) *redisClient {
+	return &redisClient{
+		addr:     addr,
+		password: password,
+		timeout:  timeout,
+		pool:     make(chan *redisConn, poolSize),
+	}
+}
+
+// Run a command. Replies are string, int64, []byte, []any or nil
+func (c *redisClient) do(args ...string) (any, error) {
+	conn, err := c.get()
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := conn.do(c.timeout, args...)
+
+	// Connections with protocol or network errors can't be reused
+	var redisErr RedisError
+	if err != nil && !errors.As(err, &redisErr) {
+		conn.conn.Close()
+		return nil, err
+	}
+
+	c.put(conn)
+	return reply, err
+}
+
+// Get idle connection or dial a new one
+func (c *redisClient) get() (*redisConn, error) {
+	select {
+	case conn := <-c.pool:
+		return conn, nil
+	default:
+	}
+
+	netConn, err := net.DialTimeout("tcp", c.addr, c.timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &redisConn{conn: netConn, r: bufio.NewReader(netConn), w: bufio.NewWriter(netConn)}
+
+	// Authenticate new connections
+	if c.password != "" {
+		_, err := conn.do(c.timeout, "AUTH", c.password)
+		if err != nil {
+			netConn.Close()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+// Return connection to the pool. It's closed when the pool is full
+func (c *redisClient) put(conn *redisConn) {
+	select {
+	case c.pool <- conn:
+	default:
+		conn.conn.Close()
+	}
+}
+
+// Close idle connections
+func (c *redisClient) close() {
+	for {
+		select {
+		case conn := <-c.pool:
+			conn.conn.Close()
+		default:
+			return
+		}
+	}
+}
+
+// Send a command and read its reply
+func (c *redisConn) do(timeout time.Duration, args ...string) (any, error) {
+	c.conn.SetDeadline(time.Now().Add(timeout))
+
+	// Commands are arrays of bulk strings
+	fmt.Fprintf(c.w, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(c.w, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	err := c.w.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	return readReply(c.r)
+}
+
+// Read a reply
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, errors.New("redis: empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, RedisError(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, nil
+		}
+
+		// Read data and the trailing CRLF
+		data := make([]byte, size+2)
+		_, err = io.ReadFull(r, data)
+		if err != nil {
+			return nil, err
+		}
+		return data[:size], nil
+	case '*':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, nil
+		}
+
+		items := make([]any, size)
+		for i := range items {
+			items[i], err = readReply(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+
+	return nil, fmt.Errorf("redis: unexpected reply %q", line)
+}
+
+// Read a line without the CRLF
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return "", fmt.Errorf("redis: malformed line %q", line)
+	}
+	return line[:len(line)-2], nil
+}
@@ -0,0 +1,31 @@
+package sessionstore
+
+import (
+	"github.com/alexedwards/scs/v2"
+	"github.com/alexedwards/scs/v2/memstore"
+)
+
+// Session store of the web app. Stores that keep sessions outside the process survive
+// restarts and can be shared by several web instances
+type Store interface {
+	scs.Store
+
+	// Stop background work and release connections
+	Close() error
+}
+
+// Sessions in process memory. They are lost on restart
+type MemoryStore struct {
+	*memstore.MemStore
+}
+
+// Create a memory session store
+func NewMemory() *MemoryStore {
+	return &MemoryStore{MemStore: memstore.New()}
+}
+
+// Stop cleanup
+func (s *MemoryStore) Close() error {
+	s.StopCleanup()
+	return nil
+}
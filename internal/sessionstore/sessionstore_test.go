@@ -0,0 +1,195 @@
+package sessionstore
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Check the behaviour every store must have
+func testStore(t *testing.T, store Store) {
+	// Missing session
+	_, found, err := store.Find("missing")
+	if err != nil || found {
+		t.Fatalf("missing session found; found %v, error %v", found, err)
+	}
+
+	// Add and replace session
+	data := []byte("session\r\ndata\x00")
+	err = store.Commit("token", []byte("old"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.Commit("token", data, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received, found, err := store.Find("token")
+	if err != nil || !found || !bytes.Equal(received, data) {
+		t.Fatalf("wrong session; expected %q; received %q, found %v, error %v", data, received, found, err)
+	}
+
+	// Delete session
+	err = store.Delete("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, found, _ = store.Find("token")
+	if found {
+		t.Error("deleted session found")
+	}
+
+	// Expired session
+	err = store.Commit("expiring", data, time.Now().Add(50*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	_, found, _ = store.Find("expiring")
+	if found {
+		t.Error("expired session found")
+	}
+}
+
+func TestSQLiteStore(t *testing.T) {
+	store, err := NewSQLite(filepath.Join(t.TempDir(), "sessions.db"), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	testStore(t, store)
+}
+
+func TestRedisStore(t *testing.T) {
+	addr := startStandIn(t, "secret")
+
+	store := NewRedis(addr, "secret")
+	defer store.Close()
+
+	err := store.Ping()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testStore(t, store)
+}
+
+func TestRedisStoreAuth(t *testing.T) {
+	addr := startStandIn(t, "secret")
+
+	store := NewRedis(addr, "wrong")
+	defer store.Close()
+
+	err := store.Ping()
+	if err == nil {
+		t.Fatal("wrong password accepted")
+	}
+}
+
+// Start a local stand-in for a Redis server. It knows the commands of the store
+func startStandIn(t *testing.T, password string) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+
+	s := &standIn{password: password, keys: make(map[string]standInKey)}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	return lis.Addr().String()
+}
+
+type standInKey struct {
+	value  string
+	expiry time.Time
+}
+
+type standIn struct {
+	password string
+
+	mu   sync.Mutex
+	keys map[string]standInKey
+}
+
+func (s *standIn) serve(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	authenticated := s.password == ""
+	for {
+		reply, err := readReply(r)
+		if err != nil {
+			return
+		}
+
+		items, _ := reply.([]any)
+		args := make([]string, len(items))
+		for i, item := range items {
+			b, _ := item.([]byte)
+			args[i] = string(b)
+		}
+		if len(args) == 0 {
+			return
+		}
+
+		cmd := strings.ToUpper(args[0])
+		switch {
+		case cmd == "AUTH":
+			if len(args) == 2 && args[1] == s.password {
+				authenticated = true
+				conn.Write([]byte("+OK\r\n"))
+			} else {
+				conn.Write([]byte("-WRONGPASS invalid password\r\n"))
+			}
+		case !authenticated:
+			conn.Write([]byte("-NOAUTH Authentication required.\r\n"))
+		case cmd == "PING":
+			conn.Write([]byte("+PONG\r\n"))
+		case cmd == "GET" && len(args) == 2:
+			s.mu.Lock()
+			key, ok := s.keys[args[1]]
+			s.mu.Unlock()
+			if !ok || time.Now().After(key.expiry) {
+				conn.Write([]byte("$-1\r\n"))
+			} else {
+				conn.Write([]byte("$" + strconv.Itoa(len(key.value)) + "\r\n" + key.value + "\r\n"))
+			}
+		case cmd == "SET" && len(args) == 5 && strings.ToUpper(args[3]) == "PX":
+			ms, _ := strconv.Atoi(args[4])
+			s.mu.Lock()
+			s.keys[args[1]] = standInKey{value: args[2], expiry: time.Now().Add(time.Duration(ms) * time.Millisecond)}
+			s.mu.Unlock()
+			conn.Write([]byte("+OK\r\n"))
+		case cmd == "DEL":
+			s.mu.Lock()
+			deleted := 0
+			for _, key := range args[1:] {
+				if _, ok := s.keys[key]; ok {
+					delete(s.keys, key)
+					deleted++
+				}
+			}
+			s.mu.Unlock()
+			conn.Write([]byte(":" + strconv.Itoa(deleted) + "\r\n"))
+		default:
+			conn.Write([]byte("-ERR unknown command\r\n"))
+		}
+	}
+}
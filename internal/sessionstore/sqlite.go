@@ -0,0 +1,152 @@
+package sessionstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+)
+
+// Sessions table. The web db holds nothing else, so it's created by the store
+const sqliteSchema = `
+CREATE TABLE
+    IF NOT EXISTS sessions (
+        token TEXT NOT NULL PRIMARY KEY,
+        data BLOB NOT NULL,
+        expiry INTEGER NOT NULL
+    );
+
+CREATE INDEX IF NOT EXISTS sessions_expiry ON sessions (expiry);
+`
+
+// Sessions in a sqlite db local to the web app. Expired sessions are removed in the background
+type SQLiteStore struct {
+	db          *sql.DB
+	stopCleanup chan bool
+}
+
+// Open a sqlite session store. Cleanup interval of 0 keeps expired sessions in the db
+func NewSQLite(path string, cleanupInterval time.Duration) (*SQLiteStore, error) {
+	// Wait for locks instead of failing, since every request may write its session
+	dsn := fmt.Sprintf("%s?_busy_timeout=%s&_journal_mode=%s", path, url.QueryEscape("5000"), url.QueryEscape("WAL"))
+	dbconn, err := driver.ConnectSQL(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create sessions table
+	_, err = dbconn.SQL.Exec(sqliteSchema)
+	if err != nil {
+		dbconn.SQL.Close()
+		return nil, err
+	}
+
+	s := &SQLiteStore{db: dbconn.SQL}
+	if cleanupInterval > 0 {
+		s.stopCleanup = make(chan bool)
+		go s.startCleanup(cleanupInterval)
+	}
+
+	return s, nil
+}
+
+// Get data of an unexpired session
+func (s *SQLiteStore) Find(token string) ([]byte, bool, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	query := `SELECT data FROM sessions WHERE token = $1 AND expiry > $2`
+
+	// Scan row
+	var data []byte
+	err := s.db.QueryRowContext(ctx, query, token, time.Now().UnixNano()).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return data, true, nil
+}
+
+// Add or replace a session
+func (s *SQLiteStore) Commit(token string, data []byte, expiry time.Time) error {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// Define query
+	stmt := `INSERT INTO sessions (token, data, expiry) VALUES ($1, $2, $3)
+			ON CONFLICT (token) DO UPDATE SET data = excluded.data, expiry = excluded.expiry`
+
+	// Execute query
+	_, err := s.db.ExecContext(ctx, stmt, token, data, expiry.UnixNano())
+	return err
+}
+
+// Remove a session
+func (s *SQLiteStore) Delete(token string) error {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := s.db.ExecContext(ctx, `DELETE FROM sessions WHERE token = $1`, token)
+	return err
+}
+
+// Get data of all unexpired sessions
+func (s *SQLiteStore) All() (map[string][]byte, error) {
+	// Define context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, `SELECT token, data FROM sessions WHERE expiry > $1`, time.Now().UnixNano())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make(map[string][]byte)
+	for rows.Next() {
+		var token string
+		var data []byte
+		err := rows.Scan(&token, &data)
+		if err != nil {
+			return nil, err
+		}
+		sessions[token] = data
+	}
+
+	return sessions, rows.Err()
+}
+
+// Stop cleanup and close the db
+func (s *SQLiteStore) Close() error {
+	if s.stopCleanup != nil {
+		s.stopCleanup <- true
+	}
+	return s.db.Close()
+}
+
+// Remove expired sessions on every tick
+func (s *SQLiteStore) startCleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// Failed cleanups are retried on the next tick
+			s.db.Exec(`DELETE FROM sessions WHERE expiry <= $1`, time.Now().UnixNano())
+		case <-s.stopCleanup:
+			return
+		}
+	}
+}
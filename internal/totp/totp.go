@@ -0,0 +1,141 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Settings understood by every authenticator app: SHA-1, 6 digits, 30 second steps
+const (
+	Digits = 6
+	Period = 30 * time.Second
+)
+
+// Size of generated secrets. RFC 4226 recommends 160 bits
+const SecretSize = 20
+
+// Steps of clock drift accepted on each side of the current step
+const Skew = 1
+
+// Secrets are shown and put in URIs as unpadded base32
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Create a random secret
+func NewSecret() ([]byte, error) {
+	secret := make([]byte, SecretSize)
+	_, err := rand.Read(secret)
+	return secret, err
+}
+
+// Encode a secret for authenticator apps
+func EncodeSecret(secret []byte) string {
+	return encoding.EncodeToString(secret)
+}
+
+// Decode a secret from EncodeSecret. Spaces and lower case are accepted
+func DecodeSecret(s string) ([]byte, error) {
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	return encoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// Get HMAC-based one-time password of a counter (RFC 4226)
+func HOTP(secret []byte, counter uint64, digits int, h func() hash.Hash) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(h, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// Get time step of a time
+func Counter(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(Period/time.Second)
+}
+
+// Get time-based one-time password at a time (RFC 6238)
+func Code(secret []byte, t time.Time) string {
+	return HOTP(secret, Counter(t), Digits, sha1.New)
+}
+
+// Check a code at a time, accepting Skew steps of clock drift.
+// Returns the step the code belongs to, so callers can reject codes that were used already
+func Validate(secret []byte, code string, t time.Time) (uint64, bool) {
+	code = strings.ReplaceAll(code, " ", "")
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	now := Counter(t)
+	for i := -Skew; i <= Skew; i++ {
+		counter := now + uint64(i)
+		if subtle.ConstantTimeCompare([]byte(HOTP(secret, counter, Digits, sha1.New)), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+
+	return 0, false
+}
+
+// Get key URI that authenticator apps read from QR codes
+func URI(issuer, account string, secret []byte) string {
+	query := url.Values{
+		"secret":    {EncodeSecret(secret)},
+		"issuer":    {issuer},
+		"algorithm": {"SHA1"},
+		"digits":    {fmt.Sprint(Digits)},
+		"period":    {fmt.Sprint(int(Period / time.Second))},
+	}
+
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// Number of recovery codes given to a user
+const RecoveryCodes = 10
+
+// Create recovery codes. Every code can replace a one-time password once
+func NewRecoveryCodes() ([]string, error) {
+	codes := make([]string, RecoveryCodes)
+	for i := range codes {
+		b := make([]byte, 5)
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, err
+		}
+
+		code := hex.EncodeToString(b)
+		codes[i] = code[:5] + "-" + code[5:]
+	}
+
+	return codes, nil
+}
+
+// Get hash of a recovery code. Codes are stored hashed. They are random, so a fast hash is enough
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
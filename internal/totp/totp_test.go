@@ -0,0 +1,132 @@
+package totp
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test vectors of RFC 4226, appendix D
+func TestHOTP(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	expected := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+
+	for counter, code := range expected {
+		received := HOTP(secret, uint64(counter), 6, sha1.New)
+		if received != code {
+			t.Errorf("wrong code for counter %d; expected %s; received %s", counter, code, received)
+		}
+	}
+}
+
+// Test vectors of RFC 6238, appendix B. They use 8 digits
+func TestTOTPVectors(t *testing.T) {
+	seeds := map[string][]byte{
+		"SHA1":   []byte("12345678901234567890"),
+		"SHA256": []byte("12345678901234567890123456789012"),
+		"SHA512": []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+	}
+	hashes := map[string]func() hash.Hash{
+		"SHA1":   sha1.New,
+		"SHA256": sha256.New,
+		"SHA512": sha512.New,
+	}
+
+	tests := []struct {
+		time int64
+		mode string
+		code string
+	}{
+		{59, "SHA1", "94287082"},
+		{59, "SHA256", "46119246"},
+		{59, "SHA512", "90693936"},
+		{1111111109, "SHA1", "07081804"},
+		{1111111109, "SHA256", "68084774"},
+		{1111111109, "SHA512", "25091201"},
+		{1111111111, "SHA1", "14050471"},
+		{1111111111, "SHA256", "67062674"},
+		{1111111111, "SHA512", "99943326"},
+		{1234567890, "SHA1", "89005924"},
+		{1234567890, "SHA256", "91819424"},
+		{1234567890, "SHA512", "93441116"},
+		{2000000000, "SHA1", "69279037"},
+		{2000000000, "SHA256", "90698825"},
+		{2000000000, "SHA512", "38618901"},
+		{20000000000, "SHA1", "65353130"},
+		{20000000000, "SHA256", "77737706"},
+		{20000000000, "SHA512", "47863826"},
+	}
+
+	for _, test := range tests {
+		received := HOTP(seeds[test.mode], Counter(time.Unix(test.time, 0)), 8, hashes[test.mode])
+		if received != test.code {
+			t.Errorf("wrong %s code at %d; expected %s; received %s", test.mode, test.time, test.code, received)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111111, 0)
+
+	// Codes of the current and neighbouring steps are accepted
+	for _, offset := range []time.Duration{-Period, 0, Period} {
+		code := Code(secret, now.Add(offset))
+		counter, ok := Validate(secret, code, now)
+		if !ok {
+			t.Errorf("code %s at offset %s not accepted", code, offset)
+		}
+		if counter != Counter(now.Add(offset)) {
+			t.Errorf("wrong counter at offset %s; expected %d; received %d", offset, Counter(now.Add(offset)), counter)
+		}
+	}
+
+	// Codes further away are rejected
+	for _, offset := range []time.Duration{-2 * Period, 2 * Period} {
+		code := Code(secret, now.Add(offset))
+		if _, ok := Validate(secret, code, now); ok {
+			t.Errorf("code %s at offset %s accepted", code, offset)
+		}
+	}
+
+	// Malformed codes are rejected
+	for _, code := range []string{"", "12345", "1234567", "abcdef"} {
+		if _, ok := Validate(secret, code, now); ok {
+			t.Errorf("malformed code %q accepted", code)
+		}
+	}
+}
+
+func TestSecretEncoding(t *testing.T) {
+	secret := []byte("12345678901234567890")
+
+	encoded := EncodeSecret(secret)
+	if encoded != "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" {
+		t.Errorf("wrong encoded secret; received %s", encoded)
+	}
+
+	decoded, err := DecodeSecret(strings.ToLower("GEZD GNBV GY3T QOJQ GEZD GNBV GY3T QOJQ"))
+	if err != nil || string(decoded) != string(secret) {
+		t.Errorf("wrong decoded secret; received %q, %v", decoded, err)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := NewRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(codes) != RecoveryCodes {
+		t.Errorf("wrong number of codes; expected %d; received %d", RecoveryCodes, len(codes))
+	}
+
+	// Hash ignores case and separators
+	if HashRecoveryCode(codes[0]) != HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(codes[0], "-", " "))) {
+		t.Errorf("hash of %s depends on formatting", codes[0])
+	}
+}
@@ -0,0 +1,187 @@
+package userdb
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Start of encrypted files. It's authenticated with the content, along with the file id
+const magic = "EXPDB\x00\x01\x00"
+
+// Size of master and data keys. Keys are for AES-256-GCM
+const keySize = 32
+
+// Encrypted file can't be opened with the master key
+var ErrDecrypt = errors.New("can't decrypt user db: wrong master key or damaged file")
+
+// Parse a hex encoded master key
+func ParseMasterKey(s string) ([]byte, error) {
+	key, err := hex.DecodeString(strings.TrimSpace(s))
+	if err != nil || len(key) != keySize {
+		return nil, fmt.Errorf("master key must be %d hex encoded bytes", keySize)
+	}
+	return key, nil
+}
+
+// Read a hex encoded master key from a file
+func ReadMasterKey(file string) ([]byte, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMasterKey(string(data))
+}
+
+// File with the key of file ids of stores without a master key
+const idKeyFile = "file-id.key"
+
+// Read the key of file ids from the db folder. It's created on the first read
+func readIDKey(dir string) ([]byte, error) {
+	file := filepath.Join(dir, idKeyFile)
+
+	key, err := ReadMasterKey(file)
+	if err == nil {
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("can't read file id key %s: %w", file, err)
+	}
+
+	// Create key. Another process that created it first wins
+	key, err = newDataKey()
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(dir, 0o700)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, os.ErrExist) {
+		return ReadMasterKey(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(hex.EncodeToString(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return key, f.Close()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// Create a random data key
+func newDataKey() ([]byte, error) {
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	return key, err
+}
+
+// Encrypt with AES-256-GCM. The nonce is prepended to the ciphertext
+func encrypt(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(plaintext)+gcm.Overhead())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// Decrypt the output of encrypt
+func decrypt(key, ciphertext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, ErrDecrypt
+	}
+
+	plaintext, err := gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], aad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Size of a wrapped data key: nonce, key and tag
+const wrappedKeySize = 12 + keySize + 16
+
+// Encrypt data with a data key. The data key is wrapped by the master key and stored in front of the data:
+// magic, wrapped data key, encrypted data
+func seal(masterKey, dataKey []byte, id string, data []byte) ([]byte, error) {
+	aad := []byte(magic + id)
+
+	wrapped, err := encrypt(masterKey, dataKey, aad)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := encrypt(dataKey, data, aad)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(len(magic) + len(wrapped) + len(sealed))
+	buf.WriteString(magic)
+	buf.Write(wrapped)
+	buf.Write(sealed)
+
+	return buf.Bytes(), nil
+}
+
+// Decrypt the output of seal. Returns the data key along with the data
+func unseal(masterKey []byte, id string, sealed []byte) ([]byte, []byte, error) {
+	if len(sealed) < len(magic)+wrappedKeySize || string(sealed[:len(magic)]) != magic {
+		return nil, nil, ErrDecrypt
+	}
+	aad := []byte(magic + id)
+
+	dataKey, err := decrypt(masterKey, sealed[len(magic):len(magic)+wrappedKeySize], aad)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := decrypt(dataKey, sealed[len(magic)+wrappedKeySize:], aad)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dataKey, data, nil
+}
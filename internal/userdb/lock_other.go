@@ -0,0 +1,21 @@
+//go:build !unix
+
+package userdb
+
+import (
+	"errors"
+	"os"
+)
+
+// User db is decrypted by another process
+var ErrLocked = errors.New("user db is open in another process")
+
+// Files aren't locked on this platform. Only one process may open encrypted dbs at a time
+func lockFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+}
+
+// Release a lock from lockFile
+func unlockFile(f *os.File) {
+	f.Close()
+}
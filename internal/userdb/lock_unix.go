@@ -0,0 +1,38 @@
+//go:build unix
+
+package userdb
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// User db is decrypted by another process
+var ErrLocked = errors.New("user db is open in another process")
+
+// Take an exclusive lock on a file. The lock is released when the process exits
+func lockFile(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		f.Close()
+		return nil, ErrLocked
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Release a lock from lockFile
+func unlockFile(f *os.File) {
+	syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+	f.Close()
+}
@@ -0,0 +1,283 @@
+package userdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+)
+
+// File with the fingerprint of the key the file ids in the db folder were made with
+const idFingerprintFile = "file-ids"
+
+// Bring the db folder to the layout of the store. User dbs and attachment stores named after emails
+// or after file ids of another key are renamed to file ids, then plain dbs are encrypted when there's a master key.
+// Dbs must not be open while they are migrated. Returns the number of renamed and encrypted dbs
+func (s *Store) Migrate() (int, int, error) {
+	renamed, err := s.renameLegacy()
+	if err != nil {
+		return renamed, 0, err
+	}
+
+	reidentified, err := s.reidentify()
+	renamed += reidentified
+	if err != nil {
+		return renamed, 0, err
+	}
+
+	if !s.Encrypted() {
+		return renamed, 0, nil
+	}
+
+	encrypted, err := s.encryptPlain()
+	return renamed, encrypted, err
+}
+
+// Rename dbs named after emails. Other dbs in the folder, like the controller db, are kept
+func (s *Store) renameLegacy() (int, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return 0, err
+	}
+
+	renamed := 0
+	for _, entry := range entries {
+		user, ok := strings.CutSuffix(entry.Name(), plainExt)
+		if !ok || entry.IsDir() || !strings.Contains(user, "@") {
+			continue
+		}
+		id := s.FileID(user)
+
+		// Don't overwrite dbs that were already migrated
+		for _, ext := range []string{plainExt, encryptedExt, ".files"} {
+			_, err := os.Stat(filepath.Join(s.Dir, id+ext))
+			if err == nil {
+				return renamed, fmt.Errorf("can't rename user db %s: %s%s exists", entry.Name(), id, ext)
+			}
+		}
+
+		// Rename db with its rollback journal and attachments
+		renames := [][2]string{
+			{user + plainExt, id + plainExt},
+			{user + plainExt + "-journal", id + plainExt + "-journal"},
+			{user + ".files", id + ".files"},
+		}
+		for _, r := range renames {
+			err := os.Rename(filepath.Join(s.Dir, r[0]), filepath.Join(s.Dir, r[1]))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return renamed, err
+			}
+		}
+
+		renamed++
+	}
+
+	return renamed, nil
+}
+
+// Rename dbs named by file ids of another key, like the unkeyed ids of earlier versions or the ids
+// of a store that got a master key. The email is read from every db, so it only runs when the key changed
+func (s *Store) reidentify() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	// Skip folders whose ids are made with the key
+	fingerprintFile := filepath.Join(s.Dir, idFingerprintFile)
+	fingerprint := hex.EncodeToString(hmacSHA256(s.idKey, idFingerprintFile)[:8])
+	data, err := os.ReadFile(fingerprintFile)
+	if err == nil && strings.TrimSpace(string(data)) == fingerprint {
+		return 0, nil
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, err
+	}
+
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return 0, err
+	}
+
+	renamed := 0
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		// Encrypted dbs can only be read with the master key
+		id, sealed := strings.CutSuffix(entry.Name(), encryptedExt)
+		if !sealed {
+			var ok bool
+			id, ok = strings.CutSuffix(entry.Name(), plainExt)
+			if !ok {
+				continue
+			}
+		}
+		if !idRe.MatchString(id) || (sealed && !s.Encrypted()) {
+			continue
+		}
+
+		ok, err := s.renameID(ctx, id, sealed)
+		if err != nil {
+			return renamed, fmt.Errorf("can't rename user db %s: %w", entry.Name(), err)
+		}
+		if ok {
+			renamed++
+		}
+	}
+
+	// Remember the key
+	err = writeFile(fingerprintFile, []byte(fingerprint))
+	return renamed, err
+}
+
+// Rename a db to the file id of its user. Encrypted dbs are encrypted again, since the id is authenticated with the content.
+// Returns false when the db already has the id
+func (s *Store) renameID(ctx context.Context, id string, sealed bool) (bool, error) {
+	// Read db
+	var dataKey, data []byte
+	var db *sql.DB
+	var err error
+	if sealed {
+		var file []byte
+		file, err = os.ReadFile(s.Path(id))
+		if err != nil {
+			return false, err
+		}
+		dataKey, data, err = unseal(s.masterKey, id, file)
+		if err != nil {
+			return false, err
+		}
+		db, err = driver.OpenMemory(ctx, data)
+	} else {
+		var dbconn *driver.DB
+		dbconn, err = driver.ConnectSQL(s.plainDSN(id))
+		if dbconn != nil {
+			db = dbconn.SQL
+		}
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// Get id of the user
+	var email string
+	err = db.QueryRowContext(ctx, `SELECT email FROM user`).Scan(&email)
+	db.Close()
+	if err != nil {
+		return false, err
+	}
+	newID := s.FileID(email)
+	if newID == id {
+		return false, nil
+	}
+
+	// Don't overwrite other dbs
+	for _, ext := range []string{plainExt, encryptedExt, ".files"} {
+		_, err := os.Stat(filepath.Join(s.Dir, newID+ext))
+		if err == nil {
+			return false, fmt.Errorf("%s%s exists", newID, ext)
+		}
+	}
+
+	// Move attachments, then the db
+	err = os.Rename(FilesPath(s.Dir, id), FilesPath(s.Dir, newID))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	if sealed {
+		file, err := seal(s.masterKey, dataKey, newID, data)
+		if err != nil {
+			return false, err
+		}
+		err = writeFile(s.Path(newID), file)
+		if err != nil {
+			return false, err
+		}
+		os.Remove(filepath.Join(s.Dir, id+".lock"))
+		return true, os.Remove(s.Path(id))
+	}
+
+	for _, ext := range []string{plainExt + "-journal", plainExt} {
+		err = os.Rename(filepath.Join(s.Dir, id+ext), filepath.Join(s.Dir, newID+ext))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// Encrypt plain user dbs and remove the plain files
+func (s *Store) encryptPlain() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return 0, err
+	}
+
+	encrypted := 0
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), plainExt)
+		if !ok || entry.IsDir() || !idRe.MatchString(id) {
+			continue
+		}
+
+		_, err := os.Stat(s.Path(id))
+		if err == nil {
+			return encrypted, fmt.Errorf("can't encrypt user db %s: %s exists", entry.Name(), filepath.Base(s.Path(id)))
+		}
+
+		err = s.encryptFile(ctx, id)
+		if err != nil {
+			return encrypted, fmt.Errorf("can't encrypt user db %s: %w", entry.Name(), err)
+		}
+
+		encrypted++
+	}
+
+	return encrypted, nil
+}
+
+// Encrypt a plain user db with a new data key
+func (s *Store) encryptFile(ctx context.Context, id string) error {
+	// Read db. Opening it rolls back an interrupted transaction
+	dbconn, err := driver.ConnectSQL(s.plainDSN(id))
+	if err != nil {
+		return err
+	}
+	data, err := driver.Serialize(ctx, dbconn.SQL)
+	dbconn.SQL.Close()
+	if err != nil {
+		return err
+	}
+
+	dataKey, err := newDataKey()
+	if err != nil {
+		return err
+	}
+
+	sealed, err := seal(s.masterKey, dataKey, id, data)
+	if err != nil {
+		return err
+	}
+
+	err = writeFile(s.Path(id), sealed)
+	if err != nil {
+		return err
+	}
+
+	// Remove plain db
+	plain := filepath.Join(s.Dir, id+plainExt)
+	os.Remove(plain + "-journal")
+	return os.Remove(plain)
+}
@@ -0,0 +1,424 @@
+package userdb
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+)
+
+// Extensions of plain and encrypted user dbs
+const (
+	plainExt     = ".db"
+	encryptedExt = ".db.enc"
+)
+
+// Valid file ids
+var idRe = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// User db doesn't exist
+var ErrNotFound = errors.New("user db not found")
+
+// Get file id of a user. User dbs and attachment stores are named by it, so emails aren't on disk.
+// The id is keyed, so it can't be found from an email without the key of the store
+func (s *Store) FileID(user string) string {
+	return hex.EncodeToString(hmacSHA256(s.idKey, user)[:16])
+}
+
+// Get the unkeyed file id dbs were named by before. Backups taken before the migration are kept under it
+func LegacyFileID(user string) string {
+	sum := sha256.Sum256([]byte("expenses-user-db:" + user))
+	return hex.EncodeToString(sum[:16])
+}
+
+// Get path of the attachment store of a user. It's moved and exported with the user db
+func FilesPath(dir, id string) string {
+	return filepath.Join(dir, id+".files")
+}
+
+// User dbs in the db folder. Without a master key dbs are plain sqlite files.
+// With a master key every db is kept in a file encrypted with its own data key, and the data key
+// is wrapped by the master key. Encrypted dbs are decrypted into memory while they are open
+// and written back to the file on Flush and on the last Close
+type Store struct {
+	Dir string
+
+	masterKey []byte
+
+	// Key of file ids
+	idKey []byte
+
+	mu     sync.Mutex
+	loaded map[string]*memDB
+}
+
+// Decrypted user db
+type memDB struct {
+	mu sync.Mutex
+
+	// Connection that keeps the in-memory db alive
+	db  *sql.DB
+	pin *sql.Conn
+
+	// Lock file that keeps other processes from loading the db
+	lock *os.File
+
+	dataKey []byte
+
+	// Data version at the last write to the file
+	version int64
+
+	// Connections handed out by Open
+	refs int
+
+	// Db was freed
+	closed bool
+}
+
+// Create a store. Nil master key keeps dbs unencrypted.
+// File ids are keyed with the master key, or without one with a random key kept in the db folder
+func New(dir string, masterKey []byte) (*Store, error) {
+	s := &Store{
+		Dir:       dir,
+		masterKey: masterKey,
+		loaded:    make(map[string]*memDB),
+	}
+
+	if masterKey != nil {
+		s.idKey = hmacSHA256(masterKey, "expenses-user-db-file-id")
+		return s, nil
+	}
+
+	var err error
+	s.idKey, err = readIDKey(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Dbs are encrypted
+func (s *Store) Encrypted() bool {
+	return s.masterKey != nil
+}
+
+// Get path of a user db
+func (s *Store) Path(id string) string {
+	if s.Encrypted() {
+		return filepath.Join(s.Dir, id+encryptedExt)
+	}
+	return filepath.Join(s.Dir, id+plainExt)
+}
+
+// Get plain sqlite dsn of a user db
+func (s *Store) plainDSN(id string) string {
+	return fmt.Sprintf("%s?_fk=%s&_txlock=%s", filepath.Join(s.Dir, id+plainExt), url.QueryEscape("true"), url.QueryEscape("exclusive"))
+}
+
+// Get dsn of a decrypted user db. Every connection in the process shares it
+func memDSN(id string) string {
+	return fmt.Sprintf("file:/%s?vfs=memdb&_fk=%s&_txlock=%s", id, url.QueryEscape("true"), url.QueryEscape("exclusive"))
+}
+
+// User db exists
+func (s *Store) Exists(id string) bool {
+	if !idRe.MatchString(id) {
+		return false
+	}
+	_, err := os.Stat(s.Path(id))
+	return err == nil
+}
+
+// Get ids of the user dbs in the db folder
+func (s *Store) IDs() ([]string, error) {
+	ext := plainExt
+	if s.Encrypted() {
+		ext = encryptedExt
+	}
+
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ext)
+		if ok && !entry.IsDir() && idRe.MatchString(id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// Open a connection pool to a user db. Every pool must be closed with Close
+func (s *Store) Open(id string) (*driver.DB, error) {
+	if !s.Exists(id) {
+		return nil, ErrNotFound
+	}
+
+	if !s.Encrypted() {
+		return driver.ConnectSQL(s.plainDSN(id))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Decrypt db on the first open
+	m, ok := s.loaded[id]
+	if !ok {
+		var err error
+		m, err = s.load(id)
+		if err != nil {
+			return nil, err
+		}
+		s.loaded[id] = m
+	}
+
+	dbconn, err := driver.ConnectSQL(memDSN(id))
+	if err != nil {
+		if m.refs == 0 {
+			s.unload(id, m)
+		}
+		return nil, err
+	}
+	m.refs++
+
+	return dbconn, nil
+}
+
+// Close a pool from Open. Encrypted dbs are written back and removed from memory with the last pool
+func (s *Store) Close(id string, dbconn *driver.DB) error {
+	err := dbconn.SQL.Close()
+	if !s.Encrypted() {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.loaded[id]
+	if !ok {
+		return err
+	}
+
+	m.refs--
+	if m.refs > 0 {
+		return err
+	}
+
+	return errors.Join(err, s.unload(id, m))
+}
+
+// Write changes of an open encrypted db to its file. Dbs that didn't change aren't written
+func (s *Store) Flush(id string) error {
+	if !s.Encrypted() {
+		return nil
+	}
+
+	s.mu.Lock()
+	m, ok := s.loaded[id]
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	return s.flush(id, m)
+}
+
+// Decrypt a user db into memory
+func (s *Store) load(id string) (*memDB, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// Keep other processes out while the db is in memory
+	lock, err := lockFile(filepath.Join(s.Dir, id+".lock"))
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.decrypt(ctx, id)
+	if err != nil {
+		unlockFile(lock)
+		return nil, err
+	}
+	m.lock = lock
+
+	return m, nil
+}
+
+// Decrypt a user db file into a new in-memory db
+func (s *Store) decrypt(ctx context.Context, id string) (*memDB, error) {
+	sealed, err := os.ReadFile(s.Path(id))
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, data, err := unseal(s.masterKey, id, sealed)
+	if err != nil {
+		return nil, err
+	}
+
+	// Open shared in-memory db. It lives while the pinned connection is open
+	db, err := sql.Open("sqlite3", memDSN(id))
+	if err != nil {
+		return nil, err
+	}
+	db.SetConnMaxLifetime(0)
+
+	pin, err := db.Conn(ctx)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// Copy content in. Deserializing would give the content to a single connection only
+	src, err := driver.OpenMemory(ctx, data)
+	if err != nil {
+		pin.Close()
+		db.Close()
+		return nil, err
+	}
+	defer src.Close()
+
+	err = driver.CopyDB(ctx, db, src)
+	if err != nil {
+		pin.Close()
+		db.Close()
+		return nil, err
+	}
+
+	m := &memDB{db: db, pin: pin, dataKey: dataKey}
+	m.version, err = dataVersion(ctx, pin)
+	if err != nil {
+		pin.Close()
+		db.Close()
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Write an in-memory db to its file when it changed since the last write
+func (s *Store) flush(id string, m *memDB) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil
+	}
+
+	// Writes of other connections change the data version of the pinned one
+	version, err := dataVersion(ctx, m.pin)
+	if err != nil {
+		return err
+	}
+	if version == m.version {
+		return nil
+	}
+
+	data, err := driver.Serialize(ctx, m.db)
+	if err != nil {
+		return err
+	}
+
+	sealed, err := seal(s.masterKey, m.dataKey, id, data)
+	if err != nil {
+		return err
+	}
+
+	err = writeFile(s.Path(id), sealed)
+	if err != nil {
+		return err
+	}
+
+	m.version = version
+	return nil
+}
+
+// Write an in-memory db back and free it
+func (s *Store) unload(id string, m *memDB) error {
+	err := s.flush(id, m)
+
+	m.mu.Lock()
+	m.closed = true
+	m.pin.Close()
+	m.db.Close()
+	unlockFile(m.lock)
+	m.mu.Unlock()
+	delete(s.loaded, id)
+
+	return err
+}
+
+// Get data version of a connection
+func dataVersion(ctx context.Context, conn *sql.Conn) (int64, error) {
+	var version int64
+	err := conn.QueryRowContext(ctx, `PRAGMA data_version`).Scan(&version)
+	return version, err
+}
+
+// Replace a file. Content is written to a temporary file first, so the file is never partially written
+func writeFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		return err
+	}
+	err = tmp.Sync()
+	if err != nil {
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Encrypt a backup of a user db with a new data key. Backups can be restored while the db file is lost
+func (s *Store) Seal(id string, data []byte) ([]byte, error) {
+	if !s.Encrypted() {
+		return nil, errors.New("user dbs aren't encrypted")
+	}
+
+	dataKey, err := newDataKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return seal(s.masterKey, dataKey, id, data)
+}
+
+// Decrypt a backup of a user db
+func (s *Store) Unseal(id string, sealed []byte) ([]byte, error) {
+	if !s.Encrypted() {
+		return nil, errors.New("encrypted backups need the master key")
+	}
+
+	_, data, err := unseal(s.masterKey, id, sealed)
+	return data, err
+}
@@ -0,0 +1,232 @@
+package userdb
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dimitargrozev5/expenses-go-1/internal/driver"
+)
+
+// Get a master key of a repeated byte
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, keySize)
+}
+
+func TestSealUnseal(t *testing.T) {
+	store, err := New(t.TempDir(), testKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("user db content")
+	id := store.FileID("user@example.com")
+
+	sealed, err := store.Seal(id, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(sealed, data) {
+		t.Errorf("sealed data contains the plain data")
+	}
+
+	// Round trip
+	received, err := store.Unseal(id, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(received, data) {
+		t.Errorf("wrong unsealed data; expected %q; received %q", data, received)
+	}
+
+	tests := []struct {
+		name   string
+		key    []byte
+		id     string
+		sealed []byte
+	}{
+		{"wrong master key", testKey(2), id, sealed},
+		{"wrong id", testKey(1), store.FileID("other@example.com"), sealed},
+		{"damaged data", testKey(1), id, append(append([]byte{}, sealed[:len(sealed)-1]...), sealed[len(sealed)-1]^1)},
+		{"truncated", testKey(1), id, sealed[:len(magic)+wrappedKeySize-1]},
+		{"not sealed", testKey(1), id, data},
+	}
+
+	for _, test := range tests {
+		_, _, err := unseal(test.key, test.id, test.sealed)
+		if !errors.Is(err, ErrDecrypt) {
+			t.Errorf("wrong error for %s; expected %v; received %v", test.name, ErrDecrypt, err)
+		}
+	}
+
+	// Plain stores don't seal
+	plain, err := New(t.TempDir(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = plain.Seal(id, data)
+	if err == nil {
+		t.Errorf("plain store sealed data")
+	}
+}
+
+func TestFileID(t *testing.T) {
+	dir := t.TempDir()
+	user := "user@example.com"
+
+	keyed, _ := New(t.TempDir(), testKey(1))
+	otherKey, _ := New(t.TempDir(), testKey(2))
+	plain, err := New(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Plain stores keep their key in the db folder
+	reopened, err := New(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherFolder, _ := New(t.TempDir(), nil)
+
+	id := keyed.FileID(user)
+	if !idRe.MatchString(id) {
+		t.Errorf("wrong file id format; received %s", id)
+	}
+
+	tests := []struct {
+		name  string
+		id    string
+		equal bool
+	}{
+		{"same key", keyed.FileID(user), true},
+		{"other user", keyed.FileID("other@example.com"), false},
+		{"other master key", otherKey.FileID(user), false},
+		{"unkeyed", LegacyFileID(user), false},
+		{"plain store", plain.FileID(user), false},
+	}
+
+	for _, test := range tests {
+		if (test.id == id) != test.equal {
+			t.Errorf("wrong file id for %s; expected equal %v; received %s and %s", test.name, test.equal, id, test.id)
+		}
+	}
+
+	if reopened.FileID(user) != plain.FileID(user) {
+		t.Errorf("file id of a plain store changed when it was reopened")
+	}
+	if otherFolder.FileID(user) == plain.FileID(user) {
+		t.Errorf("plain stores in different folders have the same file ids")
+	}
+}
+
+// Create a plain user db of a user in a folder
+func createUserDB(t *testing.T, dir, name, email string) {
+	t.Helper()
+
+	dbconn, err := driver.ConnectSQL(filepath.Join(dir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbconn.SQL.Close()
+
+	_, err = dbconn.SQL.Exec(`CREATE TABLE user (id INTEGER PRIMARY KEY, email TEXT NOT NULL)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = dbconn.SQL.Exec(`INSERT INTO user (email) VALUES ($1)`, email)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Read the email from an open user db
+func readEmail(t *testing.T, store *Store, id string) string {
+	t.Helper()
+
+	dbconn, err := store.Open(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close(id, dbconn)
+
+	var email string
+	err = dbconn.SQL.QueryRow(`SELECT email FROM user`).Scan(&email)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return email
+}
+
+func TestMigrate(t *testing.T) {
+	dir := t.TempDir()
+
+	// Db named after the email with attachments, db named by the unkeyed id and a db that isn't a user db
+	createUserDB(t, dir, "legacy@example.com.db", "legacy@example.com")
+	err := os.MkdirAll(filepath.Join(dir, "legacy@example.com.files", "ab"), 0o700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	createUserDB(t, dir, LegacyFileID("unkeyed@example.com")+plainExt, "unkeyed@example.com")
+	createUserDB(t, dir, "controller.db", "admin")
+
+	store, err := New(dir, testKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	renamed, encrypted, err := store.Migrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renamed != 2 || encrypted != 2 {
+		t.Errorf("wrong migrated dbs; expected 2 renamed and 2 encrypted; received %d and %d", renamed, encrypted)
+	}
+
+	for _, email := range []string{"legacy@example.com", "unkeyed@example.com"} {
+		id := store.FileID(email)
+		if !store.Exists(id) {
+			t.Errorf("db of %s wasn't renamed to its file id", email)
+			continue
+		}
+
+		// Plain file is gone and the encrypted one has the data
+		_, err = os.Stat(filepath.Join(dir, id+plainExt))
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("plain db of %s wasn't removed", email)
+		}
+		received := readEmail(t, store, id)
+		if received != email {
+			t.Errorf("wrong email in db of %s; received %s", email, received)
+		}
+	}
+
+	// Attachments are moved with the db
+	_, err = os.Stat(filepath.Join(FilesPath(dir, store.FileID("legacy@example.com")), "ab"))
+	if err != nil {
+		t.Errorf("attachments weren't moved with the db: %v", err)
+	}
+
+	// Other dbs are kept
+	_, err = os.Stat(filepath.Join(dir, "controller.db"))
+	if err != nil {
+		t.Errorf("controller db was changed: %v", err)
+	}
+
+	// Migrated folders aren't migrated again
+	renamed, encrypted, err = store.Migrate()
+	if err != nil || renamed != 0 || encrypted != 0 {
+		t.Errorf("wrong second migration; expected nothing; received %d renamed, %d encrypted, %v", renamed, encrypted, err)
+	}
+
+	// Encrypted dbs are renamed when the key of file ids changes
+	rekeyed := &Store{Dir: dir, masterKey: testKey(1), idKey: testKey(3), loaded: make(map[string]*memDB)}
+	renamed, _, err = rekeyed.Migrate()
+	if err != nil || renamed != 2 {
+		t.Errorf("wrong migration to other file ids; expected 2 renamed; received %d, %v", renamed, err)
+	}
+	received := readEmail(t, rekeyed, rekeyed.FileID("unkeyed@example.com"))
+	if received != "unkeyed@example.com" {
+		t.Errorf("wrong email in renamed encrypted db; received %s", received)
+	}
+}
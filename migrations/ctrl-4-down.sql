@@ -0,0 +1,21 @@
+/*
+ * Disable foreign key constraints just in case
+ */
+PRAGMA foreign_keys = OFF;
+
+/*
+ * Remove sessions table
+ */
+DROP INDEX IF EXISTS sessions_user_key;
+
+DROP TABLE IF EXISTS sessions;
+
+/*
+ * Enable foreign key constraints
+ */
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 3;
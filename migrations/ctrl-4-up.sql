@@ -0,0 +1,28 @@
+/*
+ * Sessions table
+ *
+ * Every login creates a session that is identified by the jti claim of the access token
+ * The refresh token is stored hashed and is rotated on every refresh
+ * A session is active until it expires or is revoked
+ */
+CREATE TABLE
+    IF NOT EXISTS sessions (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        user_key TEXT NOT NULL,
+        jti TEXT UNIQUE NOT NULL,
+        refresh_token_hash TEXT UNIQUE NOT NULL,
+        device TEXT NOT NULL DEFAULT '',
+        remote_address TEXT NOT NULL DEFAULT '',
+        expires_at DATETIME NOT NULL,
+        last_seen_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        revoked_at DATETIME DEFAULT null,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+CREATE INDEX IF NOT EXISTS sessions_user_key ON sessions (user_key);
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 4;
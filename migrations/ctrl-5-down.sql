@@ -0,0 +1,22 @@
+/*
+ * Disable foreign key constraints just in case
+ */
+PRAGMA foreign_keys = OFF;
+
+/*
+ * DB nodes table
+ *
+ * Remove deregistered_at column
+ */
+ALTER TABLE db_nodes
+DROP COLUMN deregistered_at;
+
+/*
+ * Enable foreign key constraints
+ */
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 4;
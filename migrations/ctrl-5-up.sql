@@ -0,0 +1,12 @@
+/*
+ * DB nodes table
+ *
+ * Add deregistered_at column, set when a node shuts down
+ */
+ALTER TABLE db_nodes
+ADD COLUMN deregistered_at DATETIME DEFAULT null;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 5;
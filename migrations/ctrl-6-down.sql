@@ -0,0 +1,19 @@
+/*
+ * Disable foreign key constraints just in case
+ */
+PRAGMA foreign_keys = OFF;
+
+/*
+ * Remove login throttles table
+ */
+DROP TABLE IF EXISTS login_throttles;
+
+/*
+ * Enable foreign key constraints
+ */
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 5;
@@ -0,0 +1,24 @@
+/*
+ * Login throttles table
+ *
+ * Failed logins are counted per account and per IP address
+ * After a few failures every new failure locks logins for twice as long, up to a maximum
+ * A successful login clears the account throttle
+ */
+CREATE TABLE
+    IF NOT EXISTS login_throttles (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        scope TEXT NOT NULL CHECK (scope IN ('account', 'ip')),
+        throttle_key TEXT NOT NULL,
+        failures INTEGER NOT NULL DEFAULT 0,
+        locked_until DATETIME DEFAULT null,
+        last_failure_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null,
+        UNIQUE (scope, throttle_key)
+    );
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 6;
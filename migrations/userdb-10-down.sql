@@ -0,0 +1,104 @@
+/*
+ * Remove refunds and reimbursements
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Restore change base currency
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    goal_amount = CAST(ROUND(goal_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER),
+    carried_amount = CAST(ROUND(carried_amount * new.factor) AS INTEGER),
+    returned_amount = CAST(ROUND(returned_amount * new.factor) AS INTEGER),
+    deducted_amount = CAST(ROUND(deducted_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE accounts_input_log
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE liabilities
+SET
+    principal = CAST(ROUND(principal * new.factor) AS INTEGER),
+    balance = CAST(ROUND(balance * new.factor) AS INTEGER),
+    payment_amount = CAST(ROUND(payment_amount * new.factor) AS INTEGER);
+
+UPDATE liability_payments
+SET
+    amount = CAST(ROUND(amount * new.factor) AS INTEGER),
+    interest = CAST(ROUND(interest * new.factor) AS INTEGER);
+
+END;
+
+DROP TRIGGER IF EXISTS triggers__procedure_remove_expense_refund__remove;
+
+DROP VIEW IF EXISTS procedure_remove_expense_refund;
+
+DROP TRIGGER IF EXISTS triggers__procedure_new_expense_refund__insert;
+
+DROP VIEW IF EXISTS procedure_new_expense_refund;
+
+DROP TRIGGER IF EXISTS triggers__procedure_set_expense_reimbursement__update;
+
+DROP VIEW IF EXISTS procedure_set_expense_reimbursement;
+
+DROP VIEW IF EXISTS view_outstanding_reimbursements;
+
+DROP VIEW IF EXISTS view_expense_reimbursements;
+
+DROP VIEW IF EXISTS view_detailed_expense_refunds;
+
+DROP TABLE IF EXISTS expense_refunds;
+
+ALTER TABLE expenses
+DROP COLUMN reimburse_amount;
+
+ALTER TABLE expenses
+DROP COLUMN reimburse_from;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 9;
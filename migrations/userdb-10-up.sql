@@ -0,0 +1,349 @@
+/*
+ * Refunds and reimbursements
+ *
+ * Refunds and reimbursements reference the expense they pay back
+ * Their amount goes back to an account and restores the spending left of the expense category
+ * Expenses can wait for a reimbursement from a counterparty, until reimbursements cover the expected amount
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Awaited reimbursement
+ *
+ * Expected amount is in the base currency
+ */
+ALTER TABLE expenses
+ADD COLUMN reimburse_from TEXT DEFAULT null;
+
+ALTER TABLE expenses
+ADD COLUMN reimburse_amount INTEGER DEFAULT null CHECK (reimburse_amount > 0);
+
+/*
+ * Expense refunds table
+ *
+ * Amount is in the account currency and the base amount in the base currency
+ * Base amounts of all refunds can't be more than the base amount of the expense
+ */
+CREATE TABLE
+    IF NOT EXISTS expense_refunds (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        expense_id INTEGER NOT NULL REFERENCES expenses (id) ON DELETE CASCADE ON UPDATE CASCADE,
+        kind TEXT NOT NULL CHECK (kind IN ('refund', 'reimbursement')),
+        counterparty TEXT NOT NULL DEFAULT '',
+        amount INTEGER NOT NULL CHECK (amount > 0),
+        currency TEXT NOT NULL,
+        base_amount INTEGER NOT NULL CHECK (base_amount >= 0),
+        to_account INTEGER NOT NULL REFERENCES accounts (id) ON DELETE RESTRICT ON UPDATE CASCADE,
+        date DATETIME NOT NULL,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+/*
+ * Detailed refunds view
+ */
+CREATE VIEW
+    IF NOT EXISTS view_detailed_expense_refunds AS
+SELECT
+    r.id,
+    r.expense_id,
+    r.kind,
+    r.counterparty,
+    r.amount,
+    r.currency,
+    r.base_amount,
+    r.to_account,
+    accounts.name AS account_name,
+    r.date
+FROM
+    expense_refunds AS r
+    JOIN accounts ON (r.to_account = accounts.id)
+ORDER BY
+    r.date,
+    r.id;
+
+/*
+ * Reimbursements view
+ *
+ * Received is the sum of the reimbursements of the expense
+ */
+CREATE VIEW
+    IF NOT EXISTS view_expense_reimbursements AS
+SELECT
+    e.id AS expense_id,
+    e.reimburse_from AS counterparty,
+    e.reimburse_amount AS expected,
+    COALESCE(SUM(r.base_amount), 0) AS received,
+    MAX(e.reimburse_amount - COALESCE(SUM(r.base_amount), 0), 0) AS outstanding
+FROM
+    expenses AS e
+    LEFT JOIN expense_refunds AS r ON (
+        r.expense_id = e.id
+        AND r.kind = 'reimbursement'
+    )
+WHERE
+    e.reimburse_from IS NOT NULL
+GROUP BY
+    e.id;
+
+/*
+ * Outstanding reimbursements by counterparty
+ */
+CREATE VIEW
+    IF NOT EXISTS view_outstanding_reimbursements AS
+SELECT
+    counterparty,
+    SUM(outstanding) AS outstanding,
+    COUNT(*) AS expenses
+FROM
+    view_expense_reimbursements
+WHERE
+    outstanding > 0
+GROUP BY
+    counterparty
+ORDER BY
+    outstanding DESC;
+
+/*
+ * Set awaited reimbursement
+ *
+ * A null counterparty clears the awaited reimbursement
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_set_expense_reimbursement AS
+SELECT
+    id,
+    reimburse_from,
+    reimburse_amount
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_set_expense_reimbursement__update INSTEAD OF
+UPDATE ON procedure_set_expense_reimbursement BEGIN
+UPDATE expenses
+SET
+    reimburse_from = new.reimburse_from,
+    reimburse_amount = CASE
+        WHEN new.reimburse_from IS NULL THEN null
+        ELSE new.reimburse_amount
+    END,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Add refund
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_new_expense_refund AS
+SELECT
+    expense_id,
+    kind,
+    counterparty,
+    amount,
+    base_amount,
+    to_account,
+    date
+FROM
+    expense_refunds;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_expense_refund__insert INSTEAD OF INSERT ON procedure_new_expense_refund BEGIN
+SELECT
+    CASE
+        WHEN (
+            SELECT
+                base_amount
+            FROM
+                expenses
+            WHERE
+                id = new.expense_id
+        ) < (
+            SELECT
+                COALESCE(SUM(base_amount), 0)
+            FROM
+                expense_refunds
+            WHERE
+                expense_id = new.expense_id
+        ) + new.base_amount THEN RAISE (ABORT, 'refund is larger than the expense')
+    END;
+
+INSERT INTO
+    expense_refunds (
+        expense_id,
+        kind,
+        counterparty,
+        amount,
+        currency,
+        base_amount,
+        to_account,
+        date
+    )
+VALUES
+    (
+        new.expense_id,
+        new.kind,
+        COALESCE(new.counterparty, ''),
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.to_account
+        ),
+        new.base_amount,
+        new.to_account,
+        new.date
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.amount,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.to_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount + new.base_amount,
+    spending_left = spending_left + new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = (
+        SELECT
+            from_category
+        FROM
+            expenses
+        WHERE
+            id = new.expense_id
+    );
+
+END;
+
+/*
+ * Remove refund
+ *
+ * Refunds have to be removed before their expense, so balances are restored
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_expense_refund AS
+SELECT
+    id,
+    expense_id,
+    amount,
+    base_amount,
+    to_account
+FROM
+    expense_refunds;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_expense_refund__remove INSTEAD OF DELETE ON procedure_remove_expense_refund BEGIN
+UPDATE accounts
+SET
+    current_amount = current_amount - old.amount,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = old.to_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - old.base_amount,
+    spending_left = spending_left - old.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = (
+        SELECT
+            from_category
+        FROM
+            expenses
+        WHERE
+            id = old.expense_id
+    );
+
+DELETE FROM expense_refunds
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Change base currency
+ *
+ * Refunds and awaited reimbursements are re-valued as well
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    goal_amount = CAST(ROUND(goal_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER),
+    carried_amount = CAST(ROUND(carried_amount * new.factor) AS INTEGER),
+    returned_amount = CAST(ROUND(returned_amount * new.factor) AS INTEGER),
+    deducted_amount = CAST(ROUND(deducted_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER),
+    reimburse_amount = CAST(ROUND(reimburse_amount * new.factor) AS INTEGER);
+
+UPDATE expense_refunds
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE accounts_input_log
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE liabilities
+SET
+    principal = CAST(ROUND(principal * new.factor) AS INTEGER),
+    balance = CAST(ROUND(balance * new.factor) AS INTEGER),
+    payment_amount = CAST(ROUND(payment_amount * new.factor) AS INTEGER);
+
+UPDATE liability_payments
+SET
+    amount = CAST(ROUND(amount * new.factor) AS INTEGER),
+    interest = CAST(ROUND(interest * new.factor) AS INTEGER);
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 10;
@@ -0,0 +1,359 @@
+/*
+ * Payees
+ *
+ * Expenses can have a payee with a default account, category and tags
+ * Payees without defaults are prefilled from their most recent expense
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Payees table
+ *
+ * Default tags are a comma separated list
+ */
+CREATE TABLE
+    IF NOT EXISTS payees (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+        default_account INTEGER DEFAULT null REFERENCES accounts (id) ON DELETE SET NULL ON UPDATE CASCADE,
+        default_category INTEGER DEFAULT null REFERENCES categories (id) ON DELETE SET NULL ON UPDATE CASCADE,
+        default_tags TEXT NOT NULL DEFAULT '',
+        usage_count INTEGER NOT NULL DEFAULT 0,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+/*
+ * Expenses payee
+ */
+ALTER TABLE expenses
+ADD COLUMN payee_id INTEGER DEFAULT null REFERENCES payees (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+/*
+ * Payees view
+ *
+ * Account, category and tags are the defaults or the ones of the most recent expense
+ */
+CREATE VIEW
+    IF NOT EXISTS view_payees AS
+SELECT
+    p.id,
+    p.name,
+    p.default_account,
+    p.default_category,
+    p.default_tags,
+    p.usage_count,
+    COALESCE(p.default_account, last.from_account) AS account,
+    COALESCE(p.default_category, last.from_category) AS category,
+    CASE
+        WHEN p.default_tags <> '' THEN p.default_tags
+        ELSE COALESCE(
+            (
+                SELECT
+                    GROUP_CONCAT (tags.name, ',')
+                FROM
+                    expense_tags
+                    JOIN tags ON (expense_tags.tag_id = tags.id)
+                WHERE
+                    expense_tags.expense_id = last.id
+            ),
+            ''
+        )
+    END AS tags
+FROM
+    payees AS p
+    LEFT JOIN expenses AS last ON last.id = (
+        SELECT
+            id
+        FROM
+            expenses
+        WHERE
+            payee_id = p.id
+            AND parent_id IS NULL
+        ORDER BY
+            date DESC,
+            id DESC
+        LIMIT
+            1
+    )
+ORDER BY
+    p.usage_count DESC,
+    p.name;
+
+/*
+ * Add payee
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_new_payee AS
+SELECT
+    name,
+    default_account,
+    default_category,
+    default_tags
+FROM
+    payees;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_payee__insert INSTEAD OF INSERT ON procedure_new_payee BEGIN
+INSERT INTO
+    payees (
+        name,
+        default_account,
+        default_category,
+        default_tags
+    )
+VALUES
+    (
+        new.name,
+        new.default_account,
+        new.default_category,
+        COALESCE(new.default_tags, '')
+    );
+
+END;
+
+/*
+ * Update payee
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_update_payee AS
+SELECT
+    id,
+    name,
+    default_account,
+    default_category,
+    default_tags
+FROM
+    payees;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_payee__update INSTEAD OF
+UPDATE ON procedure_update_payee BEGIN
+UPDATE payees
+SET
+    name = new.name,
+    default_account = new.default_account,
+    default_category = new.default_category,
+    default_tags = COALESCE(new.default_tags, ''),
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Remove payee
+ *
+ * Expenses of the payee are kept without a payee
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_payee AS
+SELECT
+    id
+FROM
+    payees;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_payee__remove INSTEAD OF DELETE ON procedure_remove_payee BEGIN
+DELETE FROM payees
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Expense views
+ */
+DROP VIEW IF EXISTS view_detailed_expenses;
+
+DROP VIEW IF EXISTS view_current_expenses;
+
+CREATE VIEW
+    IF NOT EXISTS view_current_expenses AS
+SELECT
+    id,
+    parent_id,
+    payee_id,
+    amount,
+    currency,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    created_at,
+    updated_at
+FROM
+    expenses
+WHERE
+    from_period IS NULL;
+
+CREATE VIEW
+    IF NOT EXISTS view_detailed_expenses AS
+SELECT
+    e.id AS expense_id,
+    e.parent_id,
+    e.amount,
+    e.currency,
+    e.base_amount,
+    e.date,
+    e.from_account,
+    e.from_category,
+    e.created_at,
+    e.updated_at,
+    tags.id AS tag_id,
+    tags.name AS tag_name,
+    tags.usage_count,
+    accounts.id AS account_id,
+    accounts.name AS account_name,
+    categories.id AS category_id,
+    categories.name AS category_name,
+    payees.id AS payee_id,
+    payees.name AS payee_name
+FROM
+    view_current_expenses AS e
+    JOIN expense_tags ON (e.id = expense_tags.expense_id)
+    JOIN tags ON (expense_tags.tag_id = tags.id)
+    JOIN accounts ON (e.from_account = accounts.id)
+    JOIN categories ON (e.from_category = categories.id)
+    LEFT JOIN payees ON (e.payee_id = payees.id)
+ORDER BY
+    e.date DESC,
+    e.id,
+    tags.usage_count DESC;
+
+/*
+ * Insert new expense or expense line
+ *
+ * Payees count how many times they are used
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_new_expense__add_expense;
+
+DROP VIEW IF EXISTS procedure_new_expense;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_expense AS
+SELECT
+    parent_id,
+    payee_id,
+    amount,
+    base_amount,
+    date,
+    from_account,
+    from_category
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_expense__add_expense INSTEAD OF INSERT ON procedure_new_expense BEGIN
+INSERT INTO
+    expenses (
+        parent_id,
+        payee_id,
+        amount,
+        currency,
+        base_amount,
+        date,
+        from_account,
+        from_category
+    )
+VALUES
+    (
+        new.parent_id,
+        new.payee_id,
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.from_account
+        ),
+        new.base_amount,
+        new.date,
+        new.from_account,
+        new.from_category
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.base_amount,
+    spending_left = spending_left - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+UPDATE payees
+SET
+    usage_count = usage_count + 1
+WHERE
+    payees.id = new.payee_id;
+
+END;
+
+/*
+ * Update expense
+ *
+ * The payee is always set, so it can be cleared
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__update;
+
+DROP VIEW IF EXISTS procedure_update_expense;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_update_expense AS
+SELECT
+    id,
+    amount,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    from_period,
+    payee_id
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__update INSTEAD OF
+UPDATE ON procedure_update_expense BEGIN
+SELECT
+    CASE
+        WHEN old.from_period IS NOT NULL THEN RAISE (ABORT, 'cant delete archived expense')
+    END;
+
+UPDATE expenses
+SET
+    amount = COALESCE(new.amount, old.amount),
+    base_amount = COALESCE(new.base_amount, old.base_amount),
+    date = COALESCE(new.date, old.date),
+    from_account = COALESCE(new.from_account, old.from_account),
+    currency = (
+        SELECT
+            currency
+        FROM
+            accounts
+        WHERE
+            id = COALESCE(new.from_account, old.from_account)
+    ),
+    from_category = COALESCE(new.from_category, old.from_category),
+    payee_id = new.payee_id,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 11;
@@ -0,0 +1,231 @@
+/*
+ * Rules
+ *
+ * Rules tag and categorise new expenses
+ * Empty conditions match any expense. Every matching rule adds its tags, the first matching rule sets the category and the account
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Rules table
+ *
+ * Amounts are in the base currency
+ * Text is matched against the payee without case
+ * Weekdays is a bit mask, with Sunday as the first bit
+ * Add tags is a comma separated list
+ */
+CREATE TABLE
+    IF NOT EXISTS rules (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        name TEXT NOT NULL,
+        enabled INTEGER NOT NULL DEFAULT 1 CHECK (enabled IN (0, 1)),
+        min_amount INTEGER DEFAULT null,
+        max_amount INTEGER DEFAULT null CHECK (
+            min_amount IS NULL
+            OR max_amount IS NULL
+            OR max_amount >= min_amount
+        ),
+        text TEXT NOT NULL DEFAULT '',
+        account INTEGER DEFAULT null REFERENCES accounts (id) ON DELETE CASCADE ON UPDATE CASCADE,
+        weekdays INTEGER NOT NULL DEFAULT 0 CHECK (
+            weekdays >= 0
+            AND weekdays < 128
+        ),
+        add_tags TEXT NOT NULL DEFAULT '',
+        set_category INTEGER DEFAULT null REFERENCES categories (id) ON DELETE SET NULL ON UPDATE CASCADE,
+        set_account INTEGER DEFAULT null REFERENCES accounts (id) ON DELETE SET NULL ON UPDATE CASCADE,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+/*
+ * Add rule
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_new_rule AS
+SELECT
+    name,
+    enabled,
+    min_amount,
+    max_amount,
+    text,
+    account,
+    weekdays,
+    add_tags,
+    set_category,
+    set_account
+FROM
+    rules;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_rule__insert INSTEAD OF INSERT ON procedure_new_rule BEGIN
+INSERT INTO
+    rules (
+        name,
+        enabled,
+        min_amount,
+        max_amount,
+        text,
+        account,
+        weekdays,
+        add_tags,
+        set_category,
+        set_account
+    )
+VALUES
+    (
+        new.name,
+        COALESCE(new.enabled, 1),
+        new.min_amount,
+        new.max_amount,
+        COALESCE(new.text, ''),
+        new.account,
+        COALESCE(new.weekdays, 0),
+        COALESCE(new.add_tags, ''),
+        new.set_category,
+        new.set_account
+    );
+
+END;
+
+/*
+ * Update rule
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_update_rule AS
+SELECT
+    id,
+    name,
+    enabled,
+    min_amount,
+    max_amount,
+    text,
+    account,
+    weekdays,
+    add_tags,
+    set_category,
+    set_account
+FROM
+    rules;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_rule__update INSTEAD OF
+UPDATE ON procedure_update_rule BEGIN
+UPDATE rules
+SET
+    name = new.name,
+    enabled = new.enabled,
+    min_amount = new.min_amount,
+    max_amount = new.max_amount,
+    text = COALESCE(new.text, ''),
+    account = new.account,
+    weekdays = COALESCE(new.weekdays, 0),
+    add_tags = COALESCE(new.add_tags, ''),
+    set_category = new.set_category,
+    set_account = new.set_account,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Remove rule
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_rule AS
+SELECT
+    id
+FROM
+    rules;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_rule__remove INSTEAD OF DELETE ON procedure_remove_rule BEGIN
+DELETE FROM rules
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Change base currency
+ *
+ * Rule amount ranges are re-valued as well
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    goal_amount = CAST(ROUND(goal_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER),
+    carried_amount = CAST(ROUND(carried_amount * new.factor) AS INTEGER),
+    returned_amount = CAST(ROUND(returned_amount * new.factor) AS INTEGER),
+    deducted_amount = CAST(ROUND(deducted_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER),
+    reimburse_amount = CAST(ROUND(reimburse_amount * new.factor) AS INTEGER);
+
+UPDATE expense_refunds
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE rules
+SET
+    min_amount = CAST(ROUND(min_amount * new.factor) AS INTEGER),
+    max_amount = CAST(ROUND(max_amount * new.factor) AS INTEGER);
+
+UPDATE accounts_input_log
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE liabilities
+SET
+    principal = CAST(ROUND(principal * new.factor) AS INTEGER),
+    balance = CAST(ROUND(balance * new.factor) AS INTEGER),
+    payment_amount = CAST(ROUND(payment_amount * new.factor) AS INTEGER);
+
+UPDATE liability_payments
+SET
+    amount = CAST(ROUND(amount * new.factor) AS INTEGER),
+    interest = CAST(ROUND(interest * new.factor) AS INTEGER);
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 12;
@@ -0,0 +1,299 @@
+/*
+ * Expense notes and attachments
+ *
+ * Attachment content is kept in a content-addressed store next to the user DB. The table holds the details and the content hash
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Expense notes
+ */
+ALTER TABLE expenses
+ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+
+/*
+ * Attachments table
+ *
+ * Hash is the SHA-256 of the content. Expenses can share content
+ */
+CREATE TABLE
+    IF NOT EXISTS attachments (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        expense_id INTEGER NOT NULL REFERENCES expenses (id) ON DELETE CASCADE ON UPDATE CASCADE,
+        name TEXT NOT NULL,
+        content_type TEXT NOT NULL DEFAULT 'application/octet-stream',
+        size INTEGER NOT NULL CHECK (size >= 0),
+        hash TEXT NOT NULL,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS attachments_expense_id ON attachments (expense_id);
+
+CREATE INDEX IF NOT EXISTS attachments_hash ON attachments (hash);
+
+/*
+ * Add attachment
+ *
+ * Split lines share the attachments of their expense
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_new_attachment AS
+SELECT
+    expense_id,
+    name,
+    content_type,
+    size,
+    hash
+FROM
+    attachments;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_attachment__insert INSTEAD OF INSERT ON procedure_new_attachment BEGIN
+SELECT
+    CASE
+        WHEN NOT EXISTS (
+            SELECT
+                1
+            FROM
+                view_current_expenses
+            WHERE
+                id = new.expense_id
+                AND parent_id IS NULL
+        ) THEN RAISE (ABORT, 'attachments can only be added to current expenses')
+    END;
+
+INSERT INTO
+    attachments (expense_id, name, content_type, size, hash)
+VALUES
+    (
+        new.expense_id,
+        new.name,
+        new.content_type,
+        new.size,
+        new.hash
+    );
+
+END;
+
+/*
+ * Remove attachment
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_attachment AS
+SELECT
+    id
+FROM
+    attachments;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_attachment__remove INSTEAD OF DELETE ON procedure_remove_attachment BEGIN
+DELETE FROM attachments
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Expense views
+ */
+DROP VIEW IF EXISTS view_detailed_expenses;
+
+DROP VIEW IF EXISTS view_current_expenses;
+
+CREATE VIEW
+    IF NOT EXISTS view_current_expenses AS
+SELECT
+    id,
+    parent_id,
+    payee_id,
+    amount,
+    currency,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    notes,
+    created_at,
+    updated_at
+FROM
+    expenses
+WHERE
+    from_period IS NULL;
+
+CREATE VIEW
+    IF NOT EXISTS view_detailed_expenses AS
+SELECT
+    e.id AS expense_id,
+    e.parent_id,
+    e.amount,
+    e.currency,
+    e.base_amount,
+    e.date,
+    e.from_account,
+    e.from_category,
+    e.notes,
+    e.created_at,
+    e.updated_at,
+    tags.id AS tag_id,
+    tags.name AS tag_name,
+    tags.usage_count,
+    accounts.id AS account_id,
+    accounts.name AS account_name,
+    categories.id AS category_id,
+    categories.name AS category_name,
+    payees.id AS payee_id,
+    payees.name AS payee_name
+FROM
+    view_current_expenses AS e
+    JOIN expense_tags ON (e.id = expense_tags.expense_id)
+    JOIN tags ON (expense_tags.tag_id = tags.id)
+    JOIN accounts ON (e.from_account = accounts.id)
+    JOIN categories ON (e.from_category = categories.id)
+    LEFT JOIN payees ON (e.payee_id = payees.id)
+ORDER BY
+    e.date DESC,
+    e.id,
+    tags.usage_count DESC;
+
+/*
+ * Insert new expense or expense line
+ *
+ * Payees count how many times they are used
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_new_expense__add_expense;
+
+DROP VIEW IF EXISTS procedure_new_expense;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_expense AS
+SELECT
+    parent_id,
+    payee_id,
+    amount,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    notes
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_expense__add_expense INSTEAD OF INSERT ON procedure_new_expense BEGIN
+INSERT INTO
+    expenses (
+        parent_id,
+        payee_id,
+        amount,
+        currency,
+        base_amount,
+        date,
+        from_account,
+        from_category,
+        notes
+    )
+VALUES
+    (
+        new.parent_id,
+        new.payee_id,
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.from_account
+        ),
+        new.base_amount,
+        new.date,
+        new.from_account,
+        new.from_category,
+        COALESCE(new.notes, '')
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.base_amount,
+    spending_left = spending_left - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+UPDATE payees
+SET
+    usage_count = usage_count + 1
+WHERE
+    payees.id = new.payee_id;
+
+END;
+
+/*
+ * Update expense
+ *
+ * The payee and the notes are always set, so they can be cleared
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__update;
+
+DROP VIEW IF EXISTS procedure_update_expense;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_update_expense AS
+SELECT
+    id,
+    amount,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    from_period,
+    payee_id,
+    notes
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__update INSTEAD OF
+UPDATE ON procedure_update_expense BEGIN
+SELECT
+    CASE
+        WHEN old.from_period IS NOT NULL THEN RAISE (ABORT, 'cant delete archived expense')
+    END;
+
+UPDATE expenses
+SET
+    amount = COALESCE(new.amount, old.amount),
+    base_amount = COALESCE(new.base_amount, old.base_amount),
+    date = COALESCE(new.date, old.date),
+    from_account = COALESCE(new.from_account, old.from_account),
+    currency = (
+        SELECT
+            currency
+        FROM
+            accounts
+        WHERE
+            id = COALESCE(new.from_account, old.from_account)
+    ),
+    from_category = COALESCE(new.from_category, old.from_category),
+    payee_id = new.payee_id,
+    notes = COALESCE(new.notes, ''),
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 13;
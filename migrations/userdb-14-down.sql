@@ -0,0 +1,202 @@
+/*
+ * Remove audit log
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+DROP TRIGGER IF EXISTS triggers__procedure_restore_category__insert;
+
+DROP VIEW IF EXISTS procedure_restore_category;
+
+DROP TRIGGER IF EXISTS triggers__procedure_log_action__insert;
+
+DROP VIEW IF EXISTS procedure_log_action;
+
+DROP TRIGGER IF EXISTS triggers__audit_log__dont_delete;
+
+DROP TRIGGER IF EXISTS triggers__audit_log__dont_update;
+
+DROP TABLE IF EXISTS audit_log;
+
+DROP TRIGGER IF EXISTS triggers__procedure_new_expense__add_expense;
+
+DROP VIEW IF EXISTS procedure_new_expense;
+
+DROP TRIGGER IF EXISTS trigger__procedure_insert_account__insert;
+
+DROP VIEW IF EXISTS procedure_insert_account;
+
+DROP TRIGGER IF EXISTS triggers__procedure_add_free_funds__update;
+
+DROP VIEW IF EXISTS procedure_add_free_funds;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_expense AS
+SELECT
+    parent_id,
+    payee_id,
+    amount,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    notes
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_expense__add_expense INSTEAD OF INSERT ON procedure_new_expense BEGIN
+INSERT INTO
+    expenses (
+        parent_id,
+        payee_id,
+        amount,
+        currency,
+        base_amount,
+        date,
+        from_account,
+        from_category,
+        notes
+    )
+VALUES
+    (
+        new.parent_id,
+        new.payee_id,
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.from_account
+        ),
+        new.base_amount,
+        new.date,
+        new.from_account,
+        new.from_category,
+        COALESCE(new.notes, '')
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.base_amount,
+    spending_left = spending_left - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+UPDATE payees
+SET
+    usage_count = usage_count + 1
+WHERE
+    payees.id = new.payee_id;
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_insert_account AS
+SELECT
+    name,
+    currency
+FROM
+    accounts;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_insert_account__insert INSTEAD OF INSERT ON procedure_insert_account BEGIN
+INSERT INTO
+    accounts (name, currency, table_order)
+VALUES
+    (
+        new.name,
+        COALESCE(
+            new.currency,
+            (
+                SELECT
+                    currency
+                FROM
+                    user
+                LIMIT
+                    1
+            )
+        ),
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                accounts
+        ) + 1
+    );
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_add_free_funds AS
+SELECT
+    amount,
+    base_amount,
+    date,
+    source,
+    account AS to_account,
+    tag_id
+FROM
+    accounts_input_log;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_add_free_funds__update INSTEAD OF INSERT ON procedure_add_free_funds BEGIN
+UPDATE user
+SET
+    free_funds = free_funds + new.base_amount,
+    updated_at = datetime ('now');
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.amount,
+    updated_at = datetime ('now')
+WHERE
+    id = new.to_account;
+
+INSERT INTO
+    accounts_input_log (
+        account,
+        tag_id,
+        amount,
+        currency,
+        base_amount,
+        date,
+        source
+    )
+VALUES
+    (
+        new.to_account,
+        new.tag_id,
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.to_account
+        ),
+        new.base_amount,
+        COALESCE(new.date, datetime ('now')),
+        COALESCE(new.source, '')
+    );
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 13;
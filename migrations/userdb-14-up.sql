@@ -0,0 +1,345 @@
+/*
+ * Audit log
+ *
+ * Data-changing operations record the state of what they changed before and after, so they can be traced and undone
+ * The procedures that create records take an optional id, so undone deletes keep their ids
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Audit log table
+ *
+ * Before and after are JSON, null when the record didn't exist
+ * Undo of points to the entry that an undo reverted
+ */
+CREATE TABLE
+    IF NOT EXISTS audit_log (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        action TEXT NOT NULL,
+        entity TEXT NOT NULL,
+        entity_id INTEGER NOT NULL,
+        before TEXT DEFAULT null CHECK (
+            before IS NULL
+            OR json_valid (before)
+        ),
+        after TEXT DEFAULT null CHECK (
+            after IS NULL
+            OR json_valid (after)
+        ),
+        undo_of INTEGER DEFAULT null UNIQUE REFERENCES audit_log (id) ON UPDATE RESTRICT ON DELETE RESTRICT,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+/*
+ * Audit log is append-only
+ */
+CREATE TRIGGER IF NOT EXISTS triggers__audit_log__dont_update BEFORE
+UPDATE ON audit_log BEGIN
+SELECT
+    RAISE (ABORT, 'audit log is append-only');
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__audit_log__dont_delete BEFORE DELETE ON audit_log BEGIN
+SELECT
+    RAISE (ABORT, 'audit log is append-only');
+
+END;
+
+/*
+ * Log action
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_log_action AS
+SELECT
+    action,
+    entity,
+    entity_id,
+    before,
+    after,
+    undo_of
+FROM
+    audit_log;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_log_action__insert INSTEAD OF INSERT ON procedure_log_action BEGIN
+INSERT INTO
+    audit_log (action, entity, entity_id, before, after, undo_of)
+VALUES
+    (
+        new.action,
+        new.entity,
+        new.entity_id,
+        new.before,
+        new.after,
+        new.undo_of
+    );
+
+END;
+
+/*
+ * Restore category
+ *
+ * Puts back a removed category with its amounts. It's added as the last category
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_restore_category AS
+SELECT
+    id,
+    name,
+    budget_input,
+    last_input_date,
+    input_interval,
+    input_period,
+    spending_limit,
+    spending_left,
+    initial_amount,
+    current_amount,
+    rollover_policy,
+    rollover_cap,
+    auto_reset,
+    goal_amount,
+    goal_date
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_restore_category__insert INSTEAD OF INSERT ON procedure_restore_category BEGIN
+INSERT INTO
+    categories (
+        id,
+        name,
+        budget_input,
+        last_input_date,
+        input_interval,
+        input_period,
+        spending_limit,
+        spending_left,
+        initial_amount,
+        current_amount,
+        table_order,
+        rollover_policy,
+        rollover_cap,
+        auto_reset,
+        goal_amount,
+        goal_date
+    )
+VALUES
+    (
+        new.id,
+        new.name,
+        new.budget_input,
+        new.last_input_date,
+        new.input_interval,
+        new.input_period,
+        new.spending_limit,
+        new.spending_left,
+        new.initial_amount,
+        new.current_amount,
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                categories
+        ) + 1,
+        new.rollover_policy,
+        new.rollover_cap,
+        new.auto_reset,
+        new.goal_amount,
+        new.goal_date
+    );
+
+END;
+
+/*
+ * Procedures that create records take an id
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_new_expense__add_expense;
+
+DROP VIEW IF EXISTS procedure_new_expense;
+
+DROP TRIGGER IF EXISTS trigger__procedure_insert_account__insert;
+
+DROP VIEW IF EXISTS procedure_insert_account;
+
+DROP TRIGGER IF EXISTS triggers__procedure_add_free_funds__update;
+
+DROP VIEW IF EXISTS procedure_add_free_funds;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_expense AS
+SELECT
+    id,
+    parent_id,
+    payee_id,
+    amount,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    notes
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_expense__add_expense INSTEAD OF INSERT ON procedure_new_expense BEGIN
+INSERT INTO
+    expenses (
+        id,
+        parent_id,
+        payee_id,
+        amount,
+        currency,
+        base_amount,
+        date,
+        from_account,
+        from_category,
+        notes
+    )
+VALUES
+    (
+        new.id,
+        new.parent_id,
+        new.payee_id,
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.from_account
+        ),
+        new.base_amount,
+        new.date,
+        new.from_account,
+        new.from_category,
+        COALESCE(new.notes, '')
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.base_amount,
+    spending_left = spending_left - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+UPDATE payees
+SET
+    usage_count = usage_count + 1
+WHERE
+    payees.id = new.payee_id;
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_insert_account AS
+SELECT
+    id,
+    name,
+    currency
+FROM
+    accounts;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_insert_account__insert INSTEAD OF INSERT ON procedure_insert_account BEGIN
+INSERT INTO
+    accounts (id, name, currency, table_order)
+VALUES
+    (
+        new.id,
+        new.name,
+        COALESCE(
+            new.currency,
+            (
+                SELECT
+                    currency
+                FROM
+                    user
+                LIMIT
+                    1
+            )
+        ),
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                accounts
+        ) + 1
+    );
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_add_free_funds AS
+SELECT
+    id,
+    amount,
+    base_amount,
+    date,
+    source,
+    account AS to_account,
+    tag_id
+FROM
+    accounts_input_log;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_add_free_funds__update INSTEAD OF INSERT ON procedure_add_free_funds BEGIN
+UPDATE user
+SET
+    free_funds = free_funds + new.base_amount,
+    updated_at = datetime ('now');
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.amount,
+    updated_at = datetime ('now')
+WHERE
+    id = new.to_account;
+
+INSERT INTO
+    accounts_input_log (
+        id,
+        account,
+        tag_id,
+        amount,
+        currency,
+        base_amount,
+        date,
+        source
+    )
+VALUES
+    (
+        new.id,
+        new.to_account,
+        new.tag_id,
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.to_account
+        ),
+        new.base_amount,
+        COALESCE(new.date, datetime ('now')),
+        COALESCE(new.source, '')
+    );
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 14;
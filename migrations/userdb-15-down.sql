@@ -0,0 +1,31 @@
+/*
+ * Remove integrity fixes
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+DROP TRIGGER IF EXISTS triggers__procedure_fix_free_funds__update;
+
+DROP VIEW IF EXISTS procedure_fix_free_funds;
+
+DROP TRIGGER IF EXISTS triggers__procedure_fix_tag_usage_count__update;
+
+DROP VIEW IF EXISTS procedure_fix_tag_usage_count;
+
+DROP TRIGGER IF EXISTS triggers__procedure_fix_category_amounts__update;
+
+DROP VIEW IF EXISTS procedure_fix_category_amounts;
+
+DROP TRIGGER IF EXISTS triggers__procedure_fix_account_amount__update;
+
+DROP VIEW IF EXISTS procedure_fix_account_amount;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 14;
@@ -0,0 +1,108 @@
+/*
+ * Integrity fixes
+ *
+ * Account and category amounts, spending left, tag usage counts and free funds are kept by triggers
+ * These procedures overwrite them with values recomputed from expenses, incomes, transfers and refunds
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Fix account amount
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_fix_account_amount AS
+SELECT
+    id,
+    current_amount
+FROM
+    accounts;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_fix_account_amount__update INSTEAD OF
+UPDATE ON procedure_fix_account_amount BEGIN
+UPDATE accounts
+SET
+    current_amount = new.current_amount,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Fix category amounts
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_fix_category_amounts AS
+SELECT
+    id,
+    current_amount,
+    spending_left
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_fix_category_amounts__update INSTEAD OF
+UPDATE ON procedure_fix_category_amounts BEGIN
+UPDATE categories
+SET
+    current_amount = new.current_amount,
+    spending_left = new.spending_left,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Fix tag usage count
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_fix_tag_usage_count AS
+SELECT
+    id,
+    usage_count
+FROM
+    tags;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_fix_tag_usage_count__update INSTEAD OF
+UPDATE ON procedure_fix_tag_usage_count BEGIN
+UPDATE tags
+SET
+    usage_count = new.usage_count,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Fix free funds
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_fix_free_funds AS
+SELECT
+    id,
+    free_funds
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_fix_free_funds__update INSTEAD OF
+UPDATE ON procedure_fix_free_funds BEGIN
+UPDATE user
+SET
+    free_funds = new.free_funds,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 15;
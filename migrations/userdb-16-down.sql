@@ -0,0 +1,50 @@
+/*
+ * Remove two-factor authentication
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+DROP TRIGGER IF EXISTS triggers__procedure_use_recovery_code__update;
+
+DROP VIEW IF EXISTS procedure_use_recovery_code;
+
+DROP TRIGGER IF EXISTS triggers__procedure_add_recovery_code__insert;
+
+DROP VIEW IF EXISTS procedure_add_recovery_code;
+
+DROP TRIGGER IF EXISTS triggers__procedure_use_totp_counter__update;
+
+DROP VIEW IF EXISTS procedure_use_totp_counter;
+
+DROP TRIGGER IF EXISTS triggers__procedure_disable_totp__delete;
+
+DROP VIEW IF EXISTS procedure_disable_totp;
+
+DROP TRIGGER IF EXISTS triggers__procedure_enable_totp__update;
+
+DROP VIEW IF EXISTS procedure_enable_totp;
+
+DROP TRIGGER IF EXISTS triggers__procedure_set_totp_pending_secret__update;
+
+DROP VIEW IF EXISTS procedure_set_totp_pending_secret;
+
+DROP TABLE IF EXISTS recovery_codes;
+
+ALTER TABLE user
+DROP COLUMN totp_last_counter;
+
+ALTER TABLE user
+DROP COLUMN totp_pending_secret;
+
+ALTER TABLE user
+DROP COLUMN totp_secret;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 15;
@@ -0,0 +1,205 @@
+/*
+ * Two-factor authentication
+ *
+ * Users can require a time-based one-time password after their password
+ * The last used time step is kept, so codes can't be used twice
+ * Recovery codes replace a one-time password once. They are stored hashed
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Secrets
+ *
+ * Pending secret is set during setup and becomes the secret once a code from it is confirmed
+ */
+ALTER TABLE user
+ADD COLUMN totp_secret TEXT DEFAULT null;
+
+ALTER TABLE user
+ADD COLUMN totp_pending_secret TEXT DEFAULT null;
+
+ALTER TABLE user
+ADD COLUMN totp_last_counter INTEGER NOT NULL DEFAULT 0;
+
+/*
+ * Recovery codes table
+ */
+CREATE TABLE
+    IF NOT EXISTS recovery_codes (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        code_hash TEXT NOT NULL UNIQUE,
+        used_at DATETIME DEFAULT null,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+/*
+ * Set pending secret
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_set_totp_pending_secret AS
+SELECT
+    id,
+    totp_pending_secret
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_set_totp_pending_secret__update INSTEAD OF
+UPDATE ON procedure_set_totp_pending_secret BEGIN
+UPDATE user
+SET
+    totp_pending_secret = new.totp_pending_secret,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Enable two-factor authentication
+ *
+ * The pending secret becomes the secret. The step of the confirming code is used
+ * Old recovery codes are removed
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_enable_totp AS
+SELECT
+    id,
+    totp_last_counter
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_enable_totp__update INSTEAD OF
+UPDATE ON procedure_enable_totp BEGIN
+SELECT
+    RAISE (ABORT, 'two-factor setup was not started')
+WHERE
+    (
+        SELECT
+            totp_pending_secret
+        FROM
+            user
+        WHERE
+            id = old.id
+    ) IS NULL;
+
+UPDATE user
+SET
+    totp_secret = totp_pending_secret,
+    totp_pending_secret = null,
+    totp_last_counter = new.totp_last_counter,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+DELETE FROM recovery_codes;
+
+END;
+
+/*
+ * Disable two-factor authentication
+ *
+ * Recovery codes are removed
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_disable_totp AS
+SELECT
+    id
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_disable_totp__delete INSTEAD OF DELETE ON procedure_disable_totp BEGIN
+UPDATE user
+SET
+    totp_secret = null,
+    totp_pending_secret = null,
+    totp_last_counter = 0,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+DELETE FROM recovery_codes;
+
+END;
+
+/*
+ * Use time step
+ *
+ * Steps only move forward, so a code can't be used twice
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_use_totp_counter AS
+SELECT
+    id,
+    totp_last_counter
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_use_totp_counter__update INSTEAD OF
+UPDATE ON procedure_use_totp_counter BEGIN
+SELECT
+    RAISE (ABORT, 'code was already used')
+WHERE
+    new.totp_last_counter <= old.totp_last_counter;
+
+UPDATE user
+SET
+    totp_last_counter = new.totp_last_counter
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Add recovery code
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_add_recovery_code AS
+SELECT
+    code_hash
+FROM
+    recovery_codes;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_add_recovery_code__insert INSTEAD OF INSERT ON procedure_add_recovery_code BEGIN
+INSERT INTO
+    recovery_codes (code_hash)
+VALUES
+    (new.code_hash);
+
+END;
+
+/*
+ * Use recovery code
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_use_recovery_code AS
+SELECT
+    id,
+    used_at
+FROM
+    recovery_codes;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_use_recovery_code__update INSTEAD OF
+UPDATE ON procedure_use_recovery_code BEGIN
+SELECT
+    RAISE (ABORT, 'recovery code was already used')
+WHERE
+    old.used_at IS NOT NULL;
+
+UPDATE recovery_codes
+SET
+    used_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 16;
@@ -0,0 +1,21 @@
+/*
+ * Remove user settings
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_settings__update;
+
+DROP VIEW IF EXISTS procedure_update_settings;
+
+DROP TABLE IF EXISTS settings;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 16;
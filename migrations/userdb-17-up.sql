@@ -0,0 +1,83 @@
+/*
+ * User settings
+ *
+ * The settings table has a single row with the preferences of the user
+ * The base currency stays in the user table, since changing it re-values amounts
+ * Empty formats mean the defaults of the locale are used
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Settings table
+ *
+ * Number format is a sample of a grouped amount, e.g. 1,234.56
+ * Date format is a Go time layout, e.g. 02.01.2006
+ * First day of week counts from Sunday as 0
+ * Default account and category preselect the new expense form. They are cleared when the account or category is removed
+ */
+CREATE TABLE
+    IF NOT EXISTS settings (
+        id INTEGER NOT NULL PRIMARY KEY CHECK (id = 1),
+        locale TEXT NOT NULL DEFAULT '',
+        number_format TEXT NOT NULL DEFAULT '',
+        date_format TEXT NOT NULL DEFAULT '',
+        first_day_of_week INTEGER NOT NULL DEFAULT 1 CHECK (
+            first_day_of_week >= 0
+            AND first_day_of_week <= 6
+        ),
+        default_account INTEGER DEFAULT null REFERENCES accounts (id) ON UPDATE CASCADE ON DELETE SET NULL,
+        default_category INTEGER DEFAULT null REFERENCES categories (id) ON UPDATE CASCADE ON DELETE SET NULL,
+        expense_range TEXT NOT NULL DEFAULT 'all' CHECK (
+            expense_range IN ('all', 'week', 'month', 'last_30_days', 'year')
+        ),
+        updated_at DATETIME DEFAULT null
+    );
+
+INSERT INTO
+    settings (id)
+VALUES
+    (1);
+
+/*
+ * Update settings
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_update_settings AS
+SELECT
+    locale,
+    number_format,
+    date_format,
+    first_day_of_week,
+    default_account,
+    default_category,
+    expense_range
+FROM
+    settings;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_settings__update INSTEAD OF
+UPDATE ON procedure_update_settings BEGIN
+UPDATE settings
+SET
+    locale = new.locale,
+    number_format = new.number_format,
+    date_format = new.date_format,
+    first_day_of_week = new.first_day_of_week,
+    default_account = new.default_account,
+    default_category = new.default_category,
+    expense_range = new.expense_range,
+    updated_at = datetime ('now')
+WHERE
+    id = 1;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 17;
@@ -0,0 +1,249 @@
+/*
+ * Exact base currency conversion
+ *
+ * Amounts were converted by a float factor and rounded row by row, so cached totals could drift from the records they sum
+ * Amounts are now converted with exact rates by the application, one rounding per record
+ * Cached totals are recomputed from the converted records
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+/*
+ * Base currency
+ *
+ * Amounts are converted before the currency changes, see the procedures below
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    id,
+    currency
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_change_base_currency__update INSTEAD OF
+UPDATE ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Category amounts
+ *
+ * Current amount and spending left are recomputed from the converted records with procedure_fix_category_amounts
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_convert_category AS
+SELECT
+    id,
+    budget_input,
+    spending_limit,
+    goal_amount,
+    initial_amount
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_convert_category__update INSTEAD OF
+UPDATE ON procedure_convert_category BEGIN
+UPDATE categories
+SET
+    budget_input = new.budget_input,
+    spending_limit = new.spending_limit,
+    goal_amount = new.goal_amount,
+    initial_amount = new.initial_amount,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Archived period amounts
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_convert_archived_period AS
+SELECT
+    id,
+    budget_input,
+    spending_limit,
+    initial_amount,
+    end_amount,
+    carried_amount,
+    returned_amount,
+    deducted_amount
+FROM
+    archived_periods;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_convert_archived_period__update INSTEAD OF
+UPDATE ON procedure_convert_archived_period BEGIN
+UPDATE archived_periods
+SET
+    budget_input = new.budget_input,
+    spending_limit = new.spending_limit,
+    initial_amount = new.initial_amount,
+    end_amount = new.end_amount,
+    carried_amount = new.carried_amount,
+    returned_amount = new.returned_amount,
+    deducted_amount = new.deducted_amount
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Expense base amounts
+ *
+ * Amount and category don't change, so the category triggers of expenses don't run
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_convert_expense AS
+SELECT
+    id,
+    base_amount,
+    reimburse_amount
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_convert_expense__update INSTEAD OF
+UPDATE ON procedure_convert_expense BEGIN
+UPDATE expenses
+SET
+    base_amount = new.base_amount,
+    reimburse_amount = new.reimburse_amount
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Refund base amounts
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_convert_refund AS
+SELECT
+    id,
+    base_amount
+FROM
+    expense_refunds;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_convert_refund__update INSTEAD OF
+UPDATE ON procedure_convert_refund BEGIN
+UPDATE expense_refunds
+SET
+    base_amount = new.base_amount
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Income base amounts
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_convert_income AS
+SELECT
+    id,
+    base_amount
+FROM
+    accounts_input_log;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_convert_income__update INSTEAD OF
+UPDATE ON procedure_convert_income BEGIN
+UPDATE accounts_input_log
+SET
+    base_amount = new.base_amount
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Rule amount ranges
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_convert_rule AS
+SELECT
+    id,
+    min_amount,
+    max_amount
+FROM
+    rules;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_convert_rule__update INSTEAD OF
+UPDATE ON procedure_convert_rule BEGIN
+UPDATE rules
+SET
+    min_amount = new.min_amount,
+    max_amount = new.max_amount
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Liability amounts
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_convert_liability AS
+SELECT
+    id,
+    principal,
+    balance,
+    payment_amount
+FROM
+    liabilities;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_convert_liability__update INSTEAD OF
+UPDATE ON procedure_convert_liability BEGIN
+UPDATE liabilities
+SET
+    principal = new.principal,
+    balance = new.balance,
+    payment_amount = new.payment_amount,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Liability payment amounts
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_convert_liability_payment AS
+SELECT
+    id,
+    amount,
+    interest
+FROM
+    liability_payments;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_convert_liability_payment__update INSTEAD OF
+UPDATE ON procedure_convert_liability_payment BEGIN
+UPDATE liability_payments
+SET
+    amount = new.amount,
+    interest = new.interest
+WHERE
+    id = old.id;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 18;
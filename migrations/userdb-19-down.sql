@@ -0,0 +1,202 @@
+/*
+ * Remove refund periods
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Restore input data to category and reset period
+ *
+ * The rollover policy passed in is applied and saved to the category
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_fund_category_and_reset_period_insert;
+
+DROP VIEW IF EXISTS procedure_fund_category_and_reset_period;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_fund_category_and_reset_period AS
+SELECT
+    current_amount as amount,
+    id as category,
+    budget_input,
+    input_interval,
+    input_period,
+    spending_limit,
+    rollover_policy,
+    rollover_cap
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_fund_category_and_reset_period_insert INSTEAD OF INSERT ON procedure_fund_category_and_reset_period BEGIN
+SELECT
+    CASE
+        WHEN new.amount < 0 THEN RAISE (ABORT, 'input amount must be greather than zero')
+    END;
+
+INSERT INTO
+    archived_periods (
+        category,
+        period_start,
+        period_end,
+        budget_input,
+        spending_limit,
+        input_interval,
+        input_period,
+        initial_amount,
+        end_amount,
+        rollover_policy,
+        carried_amount,
+        returned_amount,
+        deducted_amount
+    )
+SELECT
+    id,
+    last_input_date,
+    datetime ('now'),
+    budget_input,
+    spending_limit,
+    input_interval,
+    input_period,
+    initial_amount,
+    current_amount,
+    new.rollover_policy,
+    CASE new.rollover_policy
+        WHEN 'return' THEN 0
+        WHEN 'cap' THEN MIN(current_amount, new.rollover_cap * new.budget_input)
+        ELSE current_amount
+    END,
+    CASE new.rollover_policy
+        WHEN 'return' THEN current_amount
+        WHEN 'cap' THEN MAX(current_amount - new.rollover_cap * new.budget_input, 0)
+        ELSE 0
+    END,
+    CASE
+        WHEN new.rollover_policy = 'deduct'
+        AND spending_left < 0 THEN - spending_left
+        ELSE 0
+    END
+FROM
+    categories
+WHERE
+    id = new.category;
+
+UPDATE user
+SET
+    free_funds = free_funds - new.amount + (
+        SELECT
+            returned_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    initial_amount = (
+        SELECT
+            carried_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ) + new.amount,
+    current_amount = (
+        SELECT
+            carried_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ) + new.amount,
+    budget_input = new.budget_input,
+    input_interval = new.input_interval,
+    input_period = new.input_period,
+    spending_limit = new.spending_limit,
+    spending_left = new.spending_limit - (
+        SELECT
+            deducted_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ),
+    last_input_date = datetime ('now'),
+    rollover_policy = new.rollover_policy,
+    rollover_cap = new.rollover_cap,
+    updated_at = datetime ('now')
+WHERE
+    id = new.category;
+
+UPDATE expenses
+SET
+    from_period = last_insert_rowid (),
+    updated_at = datetime ('now')
+WHERE
+    from_period IS NULL
+    AND from_category = new.category;
+
+END;
+
+/*
+ * Restore remove refund
+ *
+ * Refunds have to be removed before their expense, so balances are restored
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_remove_expense_refund__remove;
+
+DROP VIEW IF EXISTS procedure_remove_expense_refund;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_expense_refund AS
+SELECT
+    id,
+    expense_id,
+    amount,
+    base_amount,
+    to_account
+FROM
+    expense_refunds;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_expense_refund__remove INSTEAD OF DELETE ON procedure_remove_expense_refund BEGIN
+UPDATE accounts
+SET
+    current_amount = current_amount - old.amount,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = old.to_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - old.base_amount,
+    spending_left = spending_left - old.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = (
+        SELECT
+            from_category
+        FROM
+            expenses
+        WHERE
+            id = old.expense_id
+    );
+
+DELETE FROM expense_refunds
+WHERE
+    id = old.id;
+
+END;
+
+ALTER TABLE expense_refunds
+DROP COLUMN from_period;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 18;
@@ -0,0 +1,246 @@
+/*
+ * Refund periods
+ *
+ * Refunds were matched to category periods by their creation time, which is ambiguous within the second of a reset
+ * Refunds now reference the archived period they were counted in, like expenses. Refunds of the current period have none
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+ALTER TABLE expense_refunds
+ADD COLUMN from_period INTEGER DEFAULT null REFERENCES archived_periods (id) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+/*
+ * Link existing refunds
+ *
+ * A refund was counted in the period of its expense category that was current when it was made
+ * That is the first archived period that ended after it. Refunds made in the second of a reset go to the next period
+ */
+UPDATE expense_refunds
+SET
+    from_period = (
+        SELECT
+            p.id
+        FROM
+            archived_periods AS p
+            INNER JOIN expenses AS e ON e.from_category = p.category
+        WHERE
+            e.id = expense_refunds.expense_id
+            AND p.period_end > expense_refunds.created_at
+        ORDER BY
+            p.id
+        LIMIT
+            1
+    );
+
+/*
+ * Input data to category and reset period
+ *
+ * The rollover policy passed in is applied and saved to the category
+ * Expenses and refunds of the current period are linked to the archived period
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_fund_category_and_reset_period_insert;
+
+DROP VIEW IF EXISTS procedure_fund_category_and_reset_period;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_fund_category_and_reset_period AS
+SELECT
+    current_amount as amount,
+    id as category,
+    budget_input,
+    input_interval,
+    input_period,
+    spending_limit,
+    rollover_policy,
+    rollover_cap
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_fund_category_and_reset_period_insert INSTEAD OF INSERT ON procedure_fund_category_and_reset_period BEGIN
+SELECT
+    CASE
+        WHEN new.amount < 0 THEN RAISE (ABORT, 'input amount must be greather than zero')
+    END;
+
+INSERT INTO
+    archived_periods (
+        category,
+        period_start,
+        period_end,
+        budget_input,
+        spending_limit,
+        input_interval,
+        input_period,
+        initial_amount,
+        end_amount,
+        rollover_policy,
+        carried_amount,
+        returned_amount,
+        deducted_amount
+    )
+SELECT
+    id,
+    last_input_date,
+    datetime ('now'),
+    budget_input,
+    spending_limit,
+    input_interval,
+    input_period,
+    initial_amount,
+    current_amount,
+    new.rollover_policy,
+    CASE new.rollover_policy
+        WHEN 'return' THEN 0
+        WHEN 'cap' THEN MIN(current_amount, new.rollover_cap * new.budget_input)
+        ELSE current_amount
+    END,
+    CASE new.rollover_policy
+        WHEN 'return' THEN current_amount
+        WHEN 'cap' THEN MAX(current_amount - new.rollover_cap * new.budget_input, 0)
+        ELSE 0
+    END,
+    CASE
+        WHEN new.rollover_policy = 'deduct'
+        AND spending_left < 0 THEN - spending_left
+        ELSE 0
+    END
+FROM
+    categories
+WHERE
+    id = new.category;
+
+UPDATE user
+SET
+    free_funds = free_funds - new.amount + (
+        SELECT
+            returned_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    initial_amount = (
+        SELECT
+            carried_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ) + new.amount,
+    current_amount = (
+        SELECT
+            carried_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ) + new.amount,
+    budget_input = new.budget_input,
+    input_interval = new.input_interval,
+    input_period = new.input_period,
+    spending_limit = new.spending_limit,
+    spending_left = new.spending_limit - (
+        SELECT
+            deducted_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ),
+    last_input_date = datetime ('now'),
+    rollover_policy = new.rollover_policy,
+    rollover_cap = new.rollover_cap,
+    updated_at = datetime ('now')
+WHERE
+    id = new.category;
+
+UPDATE expenses
+SET
+    from_period = last_insert_rowid (),
+    updated_at = datetime ('now')
+WHERE
+    from_period IS NULL
+    AND from_category = new.category;
+
+UPDATE expense_refunds
+SET
+    from_period = last_insert_rowid ()
+WHERE
+    from_period IS NULL
+    AND expense_id IN (
+        SELECT
+            id
+        FROM
+            expenses
+        WHERE
+            from_category = new.category
+    );
+
+END;
+
+/*
+ * Remove refund
+ *
+ * Refunds have to be removed before their expense, so balances are restored
+ * Only refunds of the current period change the category
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_remove_expense_refund__remove;
+
+DROP VIEW IF EXISTS procedure_remove_expense_refund;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_expense_refund AS
+SELECT
+    id,
+    expense_id,
+    amount,
+    base_amount,
+    to_account,
+    from_period
+FROM
+    expense_refunds;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_expense_refund__remove INSTEAD OF DELETE ON procedure_remove_expense_refund BEGIN
+UPDATE accounts
+SET
+    current_amount = current_amount - old.amount,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = old.to_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - old.base_amount,
+    spending_left = spending_left - old.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = (
+        SELECT
+            from_category
+        FROM
+            expenses
+        WHERE
+            id = old.expense_id
+    )
+    AND old.from_period IS NULL;
+
+DELETE FROM expense_refunds
+WHERE
+    id = old.id;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 19;
@@ -0,0 +1,311 @@
+/*
+ * Money as decimals
+ *
+ * Convert INTEGER minor units back to NUMERIC amounts and remove the user currency
+ */
+PRAGMA foreign_keys = OFF;
+
+PRAGMA legacy_alter_table = ON;
+
+BEGIN TRANSACTION;
+
+/*
+ * User table
+ */
+CREATE TABLE
+    new_user (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        email TEXT UNIQUE NOT NULL,
+        password TEXT NOT NULL,
+        db_version INTEGER,
+        free_funds NUMERIC NOT NULL DEFAULT 0 CHECK (free_funds >= 0),
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+INSERT INTO
+    new_user (
+        id,
+        email,
+        password,
+        db_version,
+        free_funds,
+        created_at,
+        updated_at
+    )
+SELECT
+    id,
+    email,
+    password,
+    db_version,
+    free_funds / 100.0,
+    created_at,
+    updated_at
+FROM
+    user;
+
+DROP TABLE user;
+
+ALTER TABLE new_user
+RENAME TO user;
+
+/*
+ * Expenses table
+ */
+CREATE TABLE
+    new_expenses (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        amount NUMERIC NOT NULL,
+        date DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        from_account INTEGER NOT NULL REFERENCES accounts (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+        from_category INTEGER NOT NULL REFERENCES categories (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+        from_period INTEGER DEFAULT null REFERENCES archived_periods (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+INSERT INTO
+    new_expenses
+SELECT
+    id,
+    amount / 100.0,
+    date,
+    from_account,
+    from_category,
+    from_period,
+    created_at,
+    updated_at
+FROM
+    expenses;
+
+DROP TABLE expenses;
+
+ALTER TABLE new_expenses
+RENAME TO expenses;
+
+/*
+ * Accounts table
+ */
+CREATE TABLE
+    new_accounts (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        name TEXT NOT NULL UNIQUE CHECK (length (name) > 2),
+        current_amount NUMERIC NOT NULL DEFAULT 0 CHECK (current_amount >= 0),
+        usage_count INTEGER NOT NULL DEFAULT 0,
+        table_order INTEGER NOT NULL CHECK (table_order > 0),
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+INSERT INTO
+    new_accounts
+SELECT
+    id,
+    name,
+    current_amount / 100.0,
+    usage_count,
+    table_order,
+    created_at,
+    updated_at
+FROM
+    accounts;
+
+DROP TABLE accounts;
+
+ALTER TABLE new_accounts
+RENAME TO accounts;
+
+/*
+ * Accounts input log
+ */
+CREATE TABLE
+    new_accounts_input_log (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        account INTEGER NOT NULL REFERENCES accounts (id) ON UPDATE CASCADE ON DELETE CASCADE,
+        tag_id INTEGER NOT NULL REFERENCES tags (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+        amount NUMERIC NOT NULL,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+INSERT INTO
+    new_accounts_input_log
+SELECT
+    id,
+    account,
+    tag_id,
+    amount / 100.0,
+    created_at,
+    updated_at
+FROM
+    accounts_input_log;
+
+DROP TABLE accounts_input_log;
+
+ALTER TABLE new_accounts_input_log
+RENAME TO accounts_input_log;
+
+/*
+ * Categories table
+ */
+CREATE TABLE
+    new_categories (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        name TEXT NOT NULL UNIQUE,
+        budget_input NUMERIC NOT NULL CHECK (budget_input >= 0),
+        last_input_date DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        input_interval INTEGER NOT NULL CHECK (input_interval > 0),
+        input_period INTEGER NOT NULL REFERENCES time_periods (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+        spending_limit NUMERIC NOT NULL CHECK (spending_limit >= 0),
+        spending_left NUMERIC NOT NULL,
+        initial_amount NUMERIC NOT NULL DEFAULT 0 CHECK (initial_amount >= 0),
+        current_amount NUMERIC NOT NULL DEFAULT 0 CHECK (current_amount >= 0),
+        table_order INTEGER NOT NULL CHECK (table_order > 0),
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME null DEFAULT null
+    );
+
+INSERT INTO
+    new_categories
+SELECT
+    id,
+    name,
+    budget_input / 100.0,
+    last_input_date,
+    input_interval,
+    input_period,
+    spending_limit / 100.0,
+    spending_left / 100.0,
+    initial_amount / 100.0,
+    current_amount / 100.0,
+    table_order,
+    created_at,
+    updated_at
+FROM
+    categories;
+
+DROP TABLE categories;
+
+ALTER TABLE new_categories
+RENAME TO categories;
+
+/*
+ * Archived periods table
+ */
+CREATE TABLE
+    new_archived_periods (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        category INTEGER NOT NULL REFERENCES categories (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+        period_start DATETIME NOT NULL,
+        period_end DATETIME NOT NULL,
+        budget_input NUMERIC NOT NULL,
+        spending_limit NUMERIC NOT NULL,
+        input_interval INTEGER NOT NULL,
+        input_period INTEGER NOT NULL REFERENCES time_periods (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+        initial_amount NUMERIC NOT NULL,
+        end_amount NUMERIC NOT NULL,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+INSERT INTO
+    new_archived_periods
+SELECT
+    id,
+    category,
+    period_start,
+    period_end,
+    budget_input / 100.0,
+    spending_limit / 100.0,
+    input_interval,
+    input_period,
+    initial_amount / 100.0,
+    end_amount / 100.0,
+    created_at,
+    updated_at
+FROM
+    archived_periods;
+
+DROP TABLE archived_periods;
+
+ALTER TABLE new_archived_periods
+RENAME TO archived_periods;
+
+/*
+ * Recreate expense triggers
+ */
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__amount_changes_account_stays_the_same BEFORE
+UPDATE ON expenses WHEN old.amount <> new.amount
+AND old.from_account = new.from_account BEGIN
+UPDATE accounts
+SET
+    current_amount = current_amount + old.amount - new.amount,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__account_changes BEFORE
+UPDATE ON expenses WHEN old.from_account <> new.from_account BEGIN
+UPDATE accounts
+SET
+    current_amount = current_amount + old.amount,
+    usage_count = usage_count - 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = old.from_account;
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__amount_changes_category_stays_the_same BEFORE
+UPDATE ON expenses WHEN old.amount <> new.amount
+AND old.from_category = new.from_category BEGIN
+UPDATE categories
+SET
+    current_amount = current_amount + old.amount - new.amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__category_changes BEFORE
+UPDATE ON expenses WHEN old.from_category <> new.from_category BEGIN
+UPDATE categories
+SET
+    current_amount = current_amount + old.amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = old.from_category;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+COMMIT;
+
+PRAGMA legacy_alter_table = OFF;
+
+/*
+ * Enable foreign key constraints
+ */
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 1;
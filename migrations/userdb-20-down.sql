@@ -0,0 +1,23 @@
+/*
+ * Remove undo of refunds and attachments
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+DROP TRIGGER IF EXISTS triggers__procedure_restore_attachment__insert;
+
+DROP VIEW IF EXISTS procedure_restore_attachment;
+
+DROP TRIGGER IF EXISTS triggers__procedure_restore_expense_refund__insert;
+
+DROP VIEW IF EXISTS procedure_restore_expense_refund;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 19;
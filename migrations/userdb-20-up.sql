@@ -0,0 +1,136 @@
+/*
+ * Undo of refunds and attachments
+ *
+ * Removed refunds and attachments are put back with their ids when the action that removed them is undone
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Restore refund
+ *
+ * Puts back a removed refund with its period. Only refunds of the current period change the category
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_restore_expense_refund AS
+SELECT
+    id,
+    expense_id,
+    kind,
+    counterparty,
+    amount,
+    currency,
+    base_amount,
+    to_account,
+    date,
+    created_at,
+    from_period
+FROM
+    expense_refunds;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_restore_expense_refund__insert INSTEAD OF INSERT ON procedure_restore_expense_refund BEGIN
+INSERT INTO
+    expense_refunds (
+        id,
+        expense_id,
+        kind,
+        counterparty,
+        amount,
+        currency,
+        base_amount,
+        to_account,
+        date,
+        created_at,
+        from_period
+    )
+VALUES
+    (
+        new.id,
+        new.expense_id,
+        new.kind,
+        COALESCE(new.counterparty, ''),
+        new.amount,
+        new.currency,
+        new.base_amount,
+        new.to_account,
+        new.date,
+        COALESCE(new.created_at, datetime ('now')),
+        new.from_period
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.amount,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.to_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount + new.base_amount,
+    spending_left = spending_left + new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = (
+        SELECT
+            from_category
+        FROM
+            expenses
+        WHERE
+            id = new.expense_id
+    )
+    AND new.from_period IS NULL;
+
+END;
+
+/*
+ * Restore attachment
+ *
+ * Puts back a removed attachment. Its content is kept until the removal can't be undone
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_restore_attachment AS
+SELECT
+    id,
+    expense_id,
+    name,
+    content_type,
+    size,
+    hash,
+    created_at
+FROM
+    attachments;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_restore_attachment__insert INSTEAD OF INSERT ON procedure_restore_attachment BEGIN
+INSERT INTO
+    attachments (
+        id,
+        expense_id,
+        name,
+        content_type,
+        size,
+        hash,
+        created_at
+    )
+VALUES
+    (
+        new.id,
+        new.expense_id,
+        new.name,
+        new.content_type,
+        new.size,
+        new.hash,
+        COALESCE(new.created_at, datetime ('now'))
+    );
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 20;
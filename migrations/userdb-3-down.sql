@@ -0,0 +1,358 @@
+/*
+ * Single currency
+ *
+ * Remove account and expense currencies, exchange rates and transfers
+ * Amounts in accounts with another currency are kept as they are
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Remove procedures
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+DROP TRIGGER IF EXISTS trigger__procedure_transfer_funds__transfer;
+
+DROP VIEW IF EXISTS procedure_transfer_funds;
+
+DROP TRIGGER IF EXISTS trigger__procedure_remove_exchange_rate__delete;
+
+DROP VIEW IF EXISTS procedure_remove_exchange_rate;
+
+DROP TRIGGER IF EXISTS trigger__procedure_set_exchange_rate__upsert;
+
+DROP VIEW IF EXISTS procedure_set_exchange_rate;
+
+DROP TRIGGER IF EXISTS triggers__procedure_add_free_funds__update;
+
+DROP VIEW IF EXISTS procedure_add_free_funds;
+
+DROP TRIGGER IF EXISTS trigger__procedure_insert_account__insert;
+
+DROP VIEW IF EXISTS procedure_insert_account;
+
+DROP VIEW IF EXISTS view_detailed_expenses;
+
+DROP VIEW IF EXISTS view_current_expenses;
+
+DROP TRIGGER IF EXISTS triggers__procedure_new_expense__add_expense;
+
+DROP VIEW IF EXISTS procedure_new_expense;
+
+DROP TRIGGER IF EXISTS triggers__procedure_remove_expense__remove;
+
+DROP VIEW IF EXISTS procedure_remove_expense;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__update;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__amount_changes_account_stays_the_same;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__account_changes;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__amount_changes_category_stays_the_same;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__category_changes;
+
+DROP VIEW IF EXISTS procedure_update_expense;
+
+/*
+ * Remove tables
+ */
+DROP TABLE IF EXISTS account_transfers;
+
+DROP TABLE IF EXISTS exchange_rates;
+
+/*
+ * Remove columns
+ */
+ALTER TABLE expenses
+DROP COLUMN base_amount;
+
+ALTER TABLE expenses
+DROP COLUMN currency;
+
+ALTER TABLE accounts
+DROP COLUMN currency;
+
+/*
+ * Restore procedures
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_add_free_funds AS
+SELECT
+    free_funds as amount,
+    null as to_account,
+    null as tag_id
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_add_free_funds__update INSTEAD OF INSERT ON procedure_add_free_funds BEGIN
+UPDATE user
+SET
+    free_funds = free_funds + new.amount,
+    updated_at = datetime ('now');
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.amount,
+    updated_at = datetime ('now')
+WHERE
+    id = new.to_account;
+
+INSERT INTO
+    accounts_input_log (account, tag_id, amount)
+VALUES
+    (new.to_account, new.tag_id, new.amount);
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_insert_account AS
+SELECT
+    name
+FROM
+    accounts;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_insert_account__insert INSTEAD OF INSERT ON procedure_insert_account BEGIN
+INSERT INTO
+    accounts (name, table_order)
+VALUES
+    (
+        new.name,
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                accounts
+        ) + 1
+    );
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS view_current_expenses AS
+SELECT
+    id,
+    amount,
+    date,
+    from_account,
+    from_category,
+    created_at,
+    updated_at
+FROM
+    expenses
+WHERE
+    from_period IS NULL;
+
+/*
+ * View current expenses with tags, accounts and categories
+ */
+CREATE VIEW
+    IF NOT EXISTS view_detailed_expenses AS
+SELECT
+    e.id AS expense_id,
+    e.amount,
+    e.date,
+    e.from_account,
+    e.from_category,
+    e.created_at,
+    e.updated_at,
+    tags.id AS tag_id,
+    tags.name AS tag_name,
+    tags.usage_count,
+    accounts.id AS account_id,
+    accounts.name AS account_name,
+    categories.id AS category_id,
+    categories.name AS category_name
+FROM
+    view_current_expenses AS e
+    JOIN expense_tags ON (e.id = expense_tags.expense_id)
+    JOIN tags ON (expense_tags.tag_id = tags.id)
+    JOIN accounts ON (e.from_account = accounts.id)
+    JOIN categories ON (e.from_category = categories.id)
+ORDER BY
+    e.date DESC,
+    tags.usage_count DESC;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_expense AS
+SELECT
+    amount,
+    date,
+    from_account,
+    from_category
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_expense__add_expense INSTEAD OF INSERT ON procedure_new_expense BEGIN
+INSERT INTO
+    expenses (amount, date, from_account, from_category)
+VALUES
+    (
+        new.amount,
+        new.date,
+        new.from_account,
+        new.from_category
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.amount,
+    spending_left = spending_left - new.amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_expense AS
+SELECT
+    id,
+    amount,
+    from_account,
+    from_category,
+    from_period
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_expense__remove INSTEAD OF DELETE ON procedure_remove_expense BEGIN
+SELECT
+    CASE
+        WHEN old.from_period IS NOT NULL THEN RAISE (ABORT, 'cant delete archived expense')
+    END;
+
+DELETE FROM expenses
+WHERE
+    id = old.id;
+
+UPDATE accounts
+SET
+    current_amount = current_amount + old.amount,
+    usage_count = usage_count - 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = old.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount + old.amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = old.from_category;
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_update_expense AS
+SELECT
+    id,
+    amount,
+    date,
+    from_account,
+    from_category,
+    from_period
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__update INSTEAD OF
+UPDATE ON procedure_update_expense BEGIN
+SELECT
+    CASE
+        WHEN old.from_period IS NOT NULL THEN RAISE (ABORT, 'cant delete archived expense')
+    END;
+
+UPDATE expenses
+SET
+    amount = COALESCE(new.amount, old.amount),
+    date = COALESCE(new.date, old.date),
+    from_account = COALESCE(new.from_account, old.from_account),
+    from_category = COALESCE(new.from_category, old.from_category),
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__amount_changes_account_stays_the_same BEFORE
+UPDATE ON expenses WHEN old.amount <> new.amount
+AND old.from_account = new.from_account BEGIN
+UPDATE accounts
+SET
+    current_amount = current_amount + old.amount - new.amount,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__account_changes BEFORE
+UPDATE ON expenses WHEN old.from_account <> new.from_account BEGIN
+UPDATE accounts
+SET
+    current_amount = current_amount + old.amount,
+    usage_count = usage_count - 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = old.from_account;
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__amount_changes_category_stays_the_same BEFORE
+UPDATE ON expenses WHEN old.amount <> new.amount
+AND old.from_category = new.from_category BEGIN
+UPDATE categories
+SET
+    current_amount = current_amount + old.amount - new.amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__category_changes BEFORE
+UPDATE ON expenses WHEN old.from_category <> new.from_category BEGIN
+UPDATE categories
+SET
+    current_amount = current_amount + old.amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = old.from_category;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 2;
@@ -0,0 +1,603 @@
+/*
+ * Multiple currencies
+ *
+ * The user currency is the base currency. Categories, free funds and archived periods stay in it
+ * Each account has its own currency and its current amount is kept in it
+ * Expenses keep the amount in the account currency and the base amount in the base currency, converted with the rate at the expense date
+ * Account procedures use the amount, category procedures use the base amount
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Accounts currency
+ */
+ALTER TABLE accounts
+ADD COLUMN currency TEXT NOT NULL DEFAULT 'EUR' CHECK (length (currency) = 3);
+
+UPDATE accounts
+SET
+    currency = (
+        SELECT
+            currency
+        FROM
+            user
+        LIMIT
+            1
+    );
+
+/*
+ * Expenses currency and base amount
+ */
+ALTER TABLE expenses
+ADD COLUMN currency TEXT NOT NULL DEFAULT 'EUR' CHECK (length (currency) = 3);
+
+ALTER TABLE expenses
+ADD COLUMN base_amount INTEGER NOT NULL DEFAULT 0;
+
+UPDATE expenses
+SET
+    currency = (
+        SELECT
+            currency
+        FROM
+            accounts
+        WHERE
+            accounts.id = expenses.from_account
+    ),
+    base_amount = amount;
+
+/*
+ * Exchange rates table
+ *
+ * Rate is the price of one unit of from_currency in to_currency, on a given date
+ * Rates are stored as exact decimal text and are only used from Go, where they are parsed into rationals
+ * One rate per currency pair per day
+ */
+CREATE TABLE
+    IF NOT EXISTS exchange_rates (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        from_currency TEXT NOT NULL CHECK (length (from_currency) = 3),
+        to_currency TEXT NOT NULL CHECK (length (to_currency) = 3),
+        rate TEXT NOT NULL,
+        date DATE NOT NULL,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null,
+        UNIQUE (from_currency, to_currency, date),
+        CHECK (from_currency <> to_currency)
+    );
+
+/*
+ * Set exchange rate
+ *
+ * Inserting a rate for an existing pair and date replaces it
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_set_exchange_rate AS
+SELECT
+    from_currency,
+    to_currency,
+    rate,
+    date
+FROM
+    exchange_rates;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_set_exchange_rate__upsert INSTEAD OF INSERT ON procedure_set_exchange_rate BEGIN
+INSERT INTO
+    exchange_rates (from_currency, to_currency, rate, date)
+VALUES
+    (
+        new.from_currency,
+        new.to_currency,
+        new.rate,
+        new.date
+    ) ON CONFLICT (from_currency, to_currency, date) DO
+UPDATE
+SET
+    rate = excluded.rate,
+    updated_at = datetime ('now');
+
+END;
+
+/*
+ * Remove exchange rate
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_exchange_rate AS
+SELECT
+    id
+FROM
+    exchange_rates;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_remove_exchange_rate__delete INSTEAD OF DELETE ON procedure_remove_exchange_rate BEGIN
+DELETE FROM exchange_rates
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Account transfers table
+ *
+ * Moving money between accounts. The amounts are in the currency of each account
+ * The rate used for the conversion is recorded. It is 1 when both accounts share a currency
+ */
+CREATE TABLE
+    IF NOT EXISTS account_transfers (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        from_account INTEGER NOT NULL REFERENCES accounts (id) ON UPDATE CASCADE ON DELETE CASCADE,
+        to_account INTEGER NOT NULL REFERENCES accounts (id) ON UPDATE CASCADE ON DELETE CASCADE,
+        from_amount INTEGER NOT NULL CHECK (from_amount > 0),
+        to_amount INTEGER NOT NULL CHECK (to_amount > 0),
+        rate TEXT NOT NULL,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        CHECK (from_account <> to_account)
+    );
+
+/*
+ * Transfer funds
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_transfer_funds AS
+SELECT
+    from_account,
+    to_account,
+    from_amount,
+    to_amount,
+    rate
+FROM
+    account_transfers;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_transfer_funds__transfer INSTEAD OF INSERT ON procedure_transfer_funds BEGIN
+INSERT INTO
+    account_transfers (
+        from_account,
+        to_account,
+        from_amount,
+        to_amount,
+        rate
+    )
+VALUES
+    (
+        new.from_account,
+        new.to_account,
+        new.from_amount,
+        new.to_amount,
+        new.rate
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.from_amount,
+    updated_at = datetime ('now')
+WHERE
+    id = new.from_account;
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.to_amount,
+    updated_at = datetime ('now')
+WHERE
+    id = new.to_account;
+
+END;
+
+/*
+ * Change base currency
+ *
+ * Re-values everything kept in the base currency. Factor converts minor units of the old currency to minor units of the new one
+ * Expenses base amounts are updated on their own, so the expenses update triggers (which only watch amount, account and category) don't run
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+END;
+
+/*
+ * Free funds
+ *
+ * Amount is in the account currency, base amount in the base currency
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_add_free_funds__update;
+
+DROP VIEW IF EXISTS procedure_add_free_funds;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_add_free_funds AS
+SELECT
+    free_funds as amount,
+    free_funds as base_amount,
+    null as to_account,
+    null as tag_id
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_add_free_funds__update INSTEAD OF INSERT ON procedure_add_free_funds BEGIN
+UPDATE user
+SET
+    free_funds = free_funds + new.base_amount,
+    updated_at = datetime ('now');
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.amount,
+    updated_at = datetime ('now')
+WHERE
+    id = new.to_account;
+
+INSERT INTO
+    accounts_input_log (account, tag_id, amount)
+VALUES
+    (new.to_account, new.tag_id, new.amount);
+
+END;
+
+/*
+ * Create new account
+ *
+ * Provide a name and optionally a currency. The base currency is used by default
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_insert_account__insert;
+
+DROP VIEW IF EXISTS procedure_insert_account;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_insert_account AS
+SELECT
+    name,
+    currency
+FROM
+    accounts;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_insert_account__insert INSTEAD OF INSERT ON procedure_insert_account BEGIN
+INSERT INTO
+    accounts (name, currency, table_order)
+VALUES
+    (
+        new.name,
+        COALESCE(
+            new.currency,
+            (
+                SELECT
+                    currency
+                FROM
+                    user
+                LIMIT
+                    1
+            )
+        ),
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                accounts
+        ) + 1
+    );
+
+END;
+
+/*
+ * Expense views
+ */
+DROP VIEW IF EXISTS view_detailed_expenses;
+
+DROP VIEW IF EXISTS view_current_expenses;
+
+CREATE VIEW
+    IF NOT EXISTS view_current_expenses AS
+SELECT
+    id,
+    amount,
+    currency,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    created_at,
+    updated_at
+FROM
+    expenses
+WHERE
+    from_period IS NULL;
+
+CREATE VIEW
+    IF NOT EXISTS view_detailed_expenses AS
+SELECT
+    e.id AS expense_id,
+    e.amount,
+    e.currency,
+    e.base_amount,
+    e.date,
+    e.from_account,
+    e.from_category,
+    e.created_at,
+    e.updated_at,
+    tags.id AS tag_id,
+    tags.name AS tag_name,
+    tags.usage_count,
+    accounts.id AS account_id,
+    accounts.name AS account_name,
+    categories.id AS category_id,
+    categories.name AS category_name
+FROM
+    view_current_expenses AS e
+    JOIN expense_tags ON (e.id = expense_tags.expense_id)
+    JOIN tags ON (expense_tags.tag_id = tags.id)
+    JOIN accounts ON (e.from_account = accounts.id)
+    JOIN categories ON (e.from_category = categories.id)
+ORDER BY
+    e.date DESC,
+    tags.usage_count DESC;
+
+/*
+ * Insert new expense
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_new_expense__add_expense;
+
+DROP VIEW IF EXISTS procedure_new_expense;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_expense AS
+SELECT
+    amount,
+    base_amount,
+    date,
+    from_account,
+    from_category
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_expense__add_expense INSTEAD OF INSERT ON procedure_new_expense BEGIN
+INSERT INTO
+    expenses (
+        amount,
+        currency,
+        base_amount,
+        date,
+        from_account,
+        from_category
+    )
+VALUES
+    (
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.from_account
+        ),
+        new.base_amount,
+        new.date,
+        new.from_account,
+        new.from_category
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.base_amount,
+    spending_left = spending_left - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+/*
+ * Delete expense
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_remove_expense__remove;
+
+DROP VIEW IF EXISTS procedure_remove_expense;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_expense AS
+SELECT
+    id,
+    amount,
+    base_amount,
+    from_account,
+    from_category,
+    from_period
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_expense__remove INSTEAD OF DELETE ON procedure_remove_expense BEGIN
+SELECT
+    CASE
+        WHEN old.from_period IS NOT NULL THEN RAISE (ABORT, 'cant delete archived expense')
+    END;
+
+DELETE FROM expenses
+WHERE
+    id = old.id;
+
+UPDATE accounts
+SET
+    current_amount = current_amount + old.amount,
+    usage_count = usage_count - 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = old.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount + old.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = old.from_category;
+
+END;
+
+/*
+ * Update expense
+ *
+ * The currency always follows the account
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__update;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__amount_changes_account_stays_the_same;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__account_changes;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__amount_changes_category_stays_the_same;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__category_changes;
+
+DROP VIEW IF EXISTS procedure_update_expense;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_update_expense AS
+SELECT
+    id,
+    amount,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    from_period
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__update INSTEAD OF
+UPDATE ON procedure_update_expense BEGIN
+SELECT
+    CASE
+        WHEN old.from_period IS NOT NULL THEN RAISE (ABORT, 'cant delete archived expense')
+    END;
+
+UPDATE expenses
+SET
+    amount = COALESCE(new.amount, old.amount),
+    base_amount = COALESCE(new.base_amount, old.base_amount),
+    date = COALESCE(new.date, old.date),
+    from_account = COALESCE(new.from_account, old.from_account),
+    currency = (
+        SELECT
+            currency
+        FROM
+            accounts
+        WHERE
+            id = COALESCE(new.from_account, old.from_account)
+    ),
+    from_category = COALESCE(new.from_category, old.from_category),
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__amount_changes_account_stays_the_same BEFORE
+UPDATE OF amount,
+from_account ON expenses WHEN old.amount <> new.amount
+AND old.from_account = new.from_account BEGIN
+UPDATE accounts
+SET
+    current_amount = current_amount + old.amount - new.amount,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__account_changes BEFORE
+UPDATE OF from_account ON expenses WHEN old.from_account <> new.from_account BEGIN
+UPDATE accounts
+SET
+    current_amount = current_amount + old.amount,
+    usage_count = usage_count - 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = old.from_account;
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__amount_changes_category_stays_the_same BEFORE
+UPDATE OF amount,
+from_category ON expenses WHEN old.base_amount <> new.base_amount
+AND old.from_category = new.from_category BEGIN
+UPDATE categories
+SET
+    current_amount = current_amount + old.base_amount - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__category_changes BEFORE
+UPDATE OF from_category ON expenses WHEN old.from_category <> new.from_category BEGIN
+UPDATE categories
+SET
+    current_amount = current_amount + old.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = old.from_category;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 3;
@@ -0,0 +1,218 @@
+/*
+ * Remove expense splits
+ *
+ * Lines of split expenses become expenses of their own
+ * Removing and updating expenses no longer restores spending_left
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Remove procedures and views
+ */
+DROP VIEW IF EXISTS view_detailed_expenses;
+
+DROP VIEW IF EXISTS view_current_expenses;
+
+DROP TRIGGER IF EXISTS triggers__procedure_new_expense__add_expense;
+
+DROP VIEW IF EXISTS procedure_new_expense;
+
+DROP TRIGGER IF EXISTS triggers__procedure_remove_expense__remove;
+
+DROP VIEW IF EXISTS procedure_remove_expense;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__amount_changes_category_stays_the_same;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_expense__category_changes;
+
+/*
+ * Remove expenses parent
+ */
+ALTER TABLE expenses
+DROP COLUMN parent_id;
+
+/*
+ * Restore procedures and views
+ */
+CREATE VIEW
+    IF NOT EXISTS view_current_expenses AS
+SELECT
+    id,
+    amount,
+    currency,
+    base_amount,
+    date,
+    from_account,
+    from_category,
+    created_at,
+    updated_at
+FROM
+    expenses
+WHERE
+    from_period IS NULL;
+
+CREATE VIEW
+    IF NOT EXISTS view_detailed_expenses AS
+SELECT
+    e.id AS expense_id,
+    e.amount,
+    e.currency,
+    e.base_amount,
+    e.date,
+    e.from_account,
+    e.from_category,
+    e.created_at,
+    e.updated_at,
+    tags.id AS tag_id,
+    tags.name AS tag_name,
+    tags.usage_count,
+    accounts.id AS account_id,
+    accounts.name AS account_name,
+    categories.id AS category_id,
+    categories.name AS category_name
+FROM
+    view_current_expenses AS e
+    JOIN expense_tags ON (e.id = expense_tags.expense_id)
+    JOIN tags ON (expense_tags.tag_id = tags.id)
+    JOIN accounts ON (e.from_account = accounts.id)
+    JOIN categories ON (e.from_category = categories.id)
+ORDER BY
+    e.date DESC,
+    tags.usage_count DESC;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_expense AS
+SELECT
+    amount,
+    base_amount,
+    date,
+    from_account,
+    from_category
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_expense__add_expense INSTEAD OF INSERT ON procedure_new_expense BEGIN
+INSERT INTO
+    expenses (
+        amount,
+        currency,
+        base_amount,
+        date,
+        from_account,
+        from_category
+    )
+VALUES
+    (
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.from_account
+        ),
+        new.base_amount,
+        new.date,
+        new.from_account,
+        new.from_category
+    );
+
+UPDATE accounts
+SET
+    current_amount = current_amount - new.amount,
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = new.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.base_amount,
+    spending_left = spending_left - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_expense AS
+SELECT
+    id,
+    amount,
+    base_amount,
+    from_account,
+    from_category,
+    from_period
+FROM
+    expenses;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_expense__remove INSTEAD OF DELETE ON procedure_remove_expense BEGIN
+SELECT
+    CASE
+        WHEN old.from_period IS NOT NULL THEN RAISE (ABORT, 'cant delete archived expense')
+    END;
+
+DELETE FROM expenses
+WHERE
+    id = old.id;
+
+UPDATE accounts
+SET
+    current_amount = current_amount + old.amount,
+    usage_count = usage_count - 1,
+    updated_at = datetime ('now')
+WHERE
+    accounts.id = old.from_account;
+
+UPDATE categories
+SET
+    current_amount = current_amount + old.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = old.from_category;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__amount_changes_category_stays_the_same BEFORE
+UPDATE OF amount,
+from_category ON expenses WHEN old.base_amount <> new.base_amount
+AND old.from_category = new.from_category BEGIN
+UPDATE categories
+SET
+    current_amount = current_amount + old.base_amount - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_expense__category_changes BEFORE
+UPDATE OF from_category ON expenses WHEN old.from_category <> new.from_category BEGIN
+UPDATE categories
+SET
+    current_amount = current_amount + old.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = old.from_category;
+
+UPDATE categories
+SET
+    current_amount = current_amount - new.base_amount,
+    updated_at = datetime ('now')
+WHERE
+    categories.id = new.from_category;
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 3;
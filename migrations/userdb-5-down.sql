@@ -0,0 +1,153 @@
+/*
+ * Remove income ledger
+ *
+ * Inputs keep only their first tag
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Remove procedures and views
+ */
+DROP VIEW IF EXISTS view_detailed_incomes;
+
+DROP VIEW IF EXISTS view_monthly_summary;
+
+DROP TRIGGER IF EXISTS triggers__procedure_update_income__update;
+
+DROP VIEW IF EXISTS procedure_update_income;
+
+DROP TRIGGER IF EXISTS triggers__procedure_remove_income__remove;
+
+DROP VIEW IF EXISTS procedure_remove_income;
+
+DROP TRIGGER IF EXISTS trigger__procedure_link_tag_to_income__add;
+
+DROP VIEW IF EXISTS procedure_link_tag_to_income;
+
+DROP TRIGGER IF EXISTS trigger__procedure_unlink_tags_from_income__remove;
+
+DROP VIEW IF EXISTS procedure_unlink_tags_from_income;
+
+DROP TRIGGER IF EXISTS triggers__income_tags__tag_usage_count_insert;
+
+DROP TRIGGER IF EXISTS triggers__income_tags__tag_usage_count_delete;
+
+DROP TRIGGER IF EXISTS triggers__procedure_add_free_funds__update;
+
+DROP VIEW IF EXISTS procedure_add_free_funds;
+
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+/*
+ * Remove income tags
+ */
+UPDATE tags
+SET
+    usage_count = usage_count - (
+        SELECT
+            COUNT(*)
+        FROM
+            income_tags
+        WHERE
+            income_tags.tag_id = tags.id
+    );
+
+DROP TABLE IF EXISTS income_tags;
+
+/*
+ * Remove income details
+ */
+ALTER TABLE accounts_input_log
+DROP COLUMN source;
+
+ALTER TABLE accounts_input_log
+DROP COLUMN date;
+
+ALTER TABLE accounts_input_log
+DROP COLUMN currency;
+
+ALTER TABLE accounts_input_log
+DROP COLUMN base_amount;
+
+/*
+ * Restore procedures
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_add_free_funds AS
+SELECT
+    free_funds as amount,
+    free_funds as base_amount,
+    null as to_account,
+    null as tag_id
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_add_free_funds__update INSTEAD OF INSERT ON procedure_add_free_funds BEGIN
+UPDATE user
+SET
+    free_funds = free_funds + new.base_amount,
+    updated_at = datetime ('now');
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.amount,
+    updated_at = datetime ('now')
+WHERE
+    id = new.to_account;
+
+INSERT INTO
+    accounts_input_log (account, tag_id, amount)
+VALUES
+    (new.to_account, new.tag_id, new.amount);
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 4;
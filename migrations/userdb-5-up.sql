@@ -0,0 +1,427 @@
+/*
+ * Income ledger
+ *
+ * Inputs of free funds become incomes with a date, a source and any number of tags
+ * Incomes can be edited and removed. Free funds and the account amount are adjusted accordingly
+ *
+ * The tag_id column of the input log keeps the first tag of the income
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Income details
+ *
+ * Base amount is the amount added to free funds
+ * Inputs logged before it was recorded use the account amount
+ */
+ALTER TABLE accounts_input_log
+ADD COLUMN base_amount NUMERIC NOT NULL DEFAULT 0;
+
+ALTER TABLE accounts_input_log
+ADD COLUMN currency TEXT NOT NULL DEFAULT 'EUR' CHECK (length (currency) = 3);
+
+ALTER TABLE accounts_input_log
+ADD COLUMN date DATETIME DEFAULT null;
+
+ALTER TABLE accounts_input_log
+ADD COLUMN source TEXT NOT NULL DEFAULT '';
+
+UPDATE accounts_input_log
+SET
+    base_amount = amount,
+    currency = (
+        SELECT
+            currency
+        FROM
+            accounts
+        WHERE
+            accounts.id = accounts_input_log.account
+    ),
+    date = created_at;
+
+/*
+ * Many-to-many relations table to link incomes and tags
+ */
+CREATE TABLE
+    IF NOT EXISTS income_tags (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        income_id INTEGER NOT NULL REFERENCES accounts_input_log (id) ON DELETE CASCADE ON UPDATE CASCADE,
+        tag_id INTEGER NOT NULL REFERENCES tags (id) ON DELETE RESTRICT ON UPDATE CASCADE,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+INSERT INTO
+    income_tags (income_id, tag_id)
+SELECT
+    id,
+    tag_id
+FROM
+    accounts_input_log;
+
+UPDATE tags
+SET
+    usage_count = usage_count + (
+        SELECT
+            COUNT(*)
+        FROM
+            income_tags
+        WHERE
+            income_tags.tag_id = tags.id
+    );
+
+CREATE VIEW
+    IF NOT EXISTS procedure_link_tag_to_income AS
+SELECT
+    income_id,
+    tag_id
+FROM
+    income_tags;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_link_tag_to_income__add INSTEAD OF INSERT ON procedure_link_tag_to_income BEGIN
+INSERT INTO
+    income_tags (income_id, tag_id)
+VALUES
+    (new.income_id, new.tag_id);
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_unlink_tags_from_income AS
+SELECT
+    income_id
+FROM
+    income_tags;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_unlink_tags_from_income__remove INSTEAD OF DELETE ON procedure_unlink_tags_from_income BEGIN
+DELETE FROM income_tags
+WHERE
+    income_id = old.income_id;
+
+END;
+
+/*
+ * Triggers related to incomes and tags
+ *
+ * Tags used by incomes count the same way as tags used by expenses
+ */
+CREATE TRIGGER IF NOT EXISTS triggers__income_tags__tag_usage_count_insert AFTER INSERT ON income_tags BEGIN
+UPDATE tags
+SET
+    usage_count = usage_count + 1,
+    updated_at = datetime ('now')
+WHERE
+    tags.id = new.tag_id;
+
+END;
+
+CREATE TRIGGER IF NOT EXISTS triggers__income_tags__tag_usage_count_delete AFTER DELETE ON income_tags BEGIN
+UPDATE tags
+SET
+    usage_count = usage_count - 1,
+    updated_at = datetime ('now')
+WHERE
+    tags.id = old.tag_id;
+
+END;
+
+/*
+ * Incomes view
+ */
+CREATE VIEW
+    IF NOT EXISTS view_detailed_incomes AS
+SELECT
+    i.id AS income_id,
+    i.amount,
+    i.currency,
+    i.base_amount,
+    i.date,
+    i.source,
+    i.created_at,
+    i.updated_at,
+    tags.id AS tag_id,
+    tags.name AS tag_name,
+    tags.usage_count,
+    accounts.id AS account_id,
+    accounts.name AS account_name
+FROM
+    accounts_input_log AS i
+    JOIN income_tags ON (i.id = income_tags.income_id)
+    JOIN tags ON (income_tags.tag_id = tags.id)
+    JOIN accounts ON (i.account = accounts.id)
+ORDER BY
+    i.date DESC,
+    i.id,
+    tags.usage_count DESC;
+
+/*
+ * Monthly summary of incomes and expenses in the base currency
+ *
+ * Archived expenses are included
+ */
+CREATE VIEW
+    IF NOT EXISTS view_monthly_summary AS
+SELECT
+    month,
+    SUM(income) AS income,
+    SUM(expenses) AS expenses
+FROM
+    (
+        SELECT
+            strftime ('%Y-%m', date) AS month,
+            base_amount AS income,
+            0 AS expenses
+        FROM
+            accounts_input_log
+        UNION ALL
+        SELECT
+            strftime ('%Y-%m', date) AS month,
+            0 AS income,
+            base_amount AS expenses
+        FROM
+            expenses
+    )
+GROUP BY
+    month
+ORDER BY
+    month DESC;
+
+/*
+ * Free funds
+ *
+ * Adds an income. Amount is in the account currency, base amount in the base currency
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_add_free_funds__update;
+
+DROP VIEW IF EXISTS procedure_add_free_funds;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_add_free_funds AS
+SELECT
+    amount,
+    base_amount,
+    date,
+    source,
+    account AS to_account,
+    tag_id
+FROM
+    accounts_input_log;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_add_free_funds__update INSTEAD OF INSERT ON procedure_add_free_funds BEGIN
+UPDATE user
+SET
+    free_funds = free_funds + new.base_amount,
+    updated_at = datetime ('now');
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.amount,
+    updated_at = datetime ('now')
+WHERE
+    id = new.to_account;
+
+INSERT INTO
+    accounts_input_log (
+        account,
+        tag_id,
+        amount,
+        currency,
+        base_amount,
+        date,
+        source
+    )
+VALUES
+    (
+        new.to_account,
+        new.tag_id,
+        new.amount,
+        (
+            SELECT
+                currency
+            FROM
+                accounts
+            WHERE
+                id = new.to_account
+        ),
+        new.base_amount,
+        COALESCE(new.date, datetime ('now')),
+        COALESCE(new.source, '')
+    );
+
+END;
+
+/*
+ * Update income
+ *
+ * The old amounts are taken back and the new ones added
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_update_income AS
+SELECT
+    id,
+    amount,
+    base_amount,
+    date,
+    source,
+    account AS to_account,
+    tag_id
+FROM
+    accounts_input_log;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_update_income__update INSTEAD OF
+UPDATE ON procedure_update_income BEGIN
+SELECT
+    CASE
+        WHEN (
+            SELECT
+                free_funds
+            FROM
+                user
+        ) - old.base_amount + new.base_amount < 0 THEN RAISE (ABORT, 'not enough free funds to change income')
+    END;
+
+UPDATE user
+SET
+    free_funds = free_funds - old.base_amount + new.base_amount,
+    updated_at = datetime ('now');
+
+UPDATE accounts
+SET
+    current_amount = current_amount - old.amount,
+    updated_at = datetime ('now')
+WHERE
+    id = old.to_account;
+
+UPDATE accounts
+SET
+    current_amount = current_amount + new.amount,
+    updated_at = datetime ('now')
+WHERE
+    id = new.to_account;
+
+UPDATE accounts_input_log
+SET
+    account = new.to_account,
+    tag_id = new.tag_id,
+    amount = new.amount,
+    currency = (
+        SELECT
+            currency
+        FROM
+            accounts
+        WHERE
+            id = new.to_account
+    ),
+    base_amount = new.base_amount,
+    date = new.date,
+    source = new.source,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Remove income
+ *
+ * Free funds that were already budgeted can't be taken back
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_income AS
+SELECT
+    id,
+    amount,
+    base_amount,
+    account AS to_account
+FROM
+    accounts_input_log;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_income__remove INSTEAD OF DELETE ON procedure_remove_income BEGIN
+SELECT
+    CASE
+        WHEN (
+            SELECT
+                free_funds
+            FROM
+                user
+        ) < old.base_amount THEN RAISE (ABORT, 'not enough free funds to remove income')
+    END;
+
+DELETE FROM accounts_input_log
+WHERE
+    id = old.id;
+
+UPDATE user
+SET
+    free_funds = free_funds - old.base_amount,
+    updated_at = datetime ('now');
+
+UPDATE accounts
+SET
+    current_amount = current_amount - old.amount,
+    updated_at = datetime ('now')
+WHERE
+    id = old.to_account;
+
+END;
+
+/*
+ * Change base currency
+ *
+ * Income base amounts are re-valued as well
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE accounts_input_log
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 5;
@@ -0,0 +1,251 @@
+/*
+ * Remove rollover policies
+ *
+ * Resets carry the surplus over again
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Remove procedures and views
+ */
+DROP VIEW IF EXISTS view_categories_overview;
+
+DROP TRIGGER IF EXISTS triggers__procedure_new_category__insert_new;
+
+DROP VIEW IF EXISTS procedure_new_category;
+
+DROP TRIGGER IF EXISTS trigger__procedure_fund_category_and_reset_period_insert;
+
+DROP VIEW IF EXISTS procedure_fund_category_and_reset_period;
+
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+/*
+ * Remove applied policy
+ */
+ALTER TABLE archived_periods
+DROP COLUMN deducted_amount;
+
+ALTER TABLE archived_periods
+DROP COLUMN returned_amount;
+
+ALTER TABLE archived_periods
+DROP COLUMN carried_amount;
+
+ALTER TABLE archived_periods
+DROP COLUMN rollover_policy;
+
+/*
+ * Remove category policy
+ */
+ALTER TABLE categories
+DROP COLUMN rollover_cap;
+
+ALTER TABLE categories
+DROP COLUMN rollover_policy;
+
+/*
+ * Restore procedures and views
+ */
+CREATE VIEW
+    IF NOT EXISTS view_categories_overview AS
+SELECT
+    c.id,
+    c.name,
+    c.budget_input,
+    c.input_interval,
+    c.input_period,
+    (CONCAT (c.input_interval, ' ', p.caption)) AS period_caption,
+    c.spending_limit,
+    c.spending_left,
+    c.last_input_date AS period_start,
+    datetime (
+        c.last_input_date,
+        concat (c.input_interval, p.period)
+    ) AS period_end,
+    c.initial_amount,
+    c.current_amount,
+    (
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                archived_periods
+            WHERE
+                category = c.id
+        ) = 0
+    ) AS can_be_deleted,
+    c.table_order
+FROM
+    categories AS c
+    JOIN time_periods AS p ON c.input_period = p.id;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_category AS
+SELECT
+    name,
+    budget_input,
+    input_interval,
+    input_period,
+    spending_limit
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_category__insert_new INSTEAD OF INSERT ON procedure_new_category BEGIN
+INSERT INTO
+    categories (
+        name,
+        budget_input,
+        input_interval,
+        input_period,
+        spending_limit,
+        spending_left,
+        initial_amount,
+        current_amount,
+        table_order
+    )
+VALUES
+    (
+        new.name,
+        new.budget_input,
+        new.input_interval,
+        new.input_period,
+        new.spending_limit,
+        new.spending_limit,
+        0,
+        0,
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                categories
+        ) + 1
+    );
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_fund_category_and_reset_period AS
+SELECT
+    current_amount as amount,
+    id as category,
+    budget_input,
+    input_interval,
+    input_period,
+    spending_limit
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_fund_category_and_reset_period_insert INSTEAD OF INSERT ON procedure_fund_category_and_reset_period BEGIN
+SELECT
+    CASE
+        WHEN new.amount < 0 THEN RAISE (ABORT, 'input amount must be greather than zero')
+    END;
+
+INSERT INTO
+    archived_periods (
+        category,
+        period_start,
+        period_end,
+        budget_input,
+        spending_limit,
+        input_interval,
+        input_period,
+        initial_amount,
+        end_amount
+    )
+SELECT
+    id,
+    last_input_date,
+    datetime ('now'),
+    budget_input,
+    spending_limit,
+    input_interval,
+    input_period,
+    initial_amount,
+    current_amount
+FROM
+    categories
+WHERE
+    id = new.category;
+
+UPDATE user
+SET
+    free_funds = free_funds - new.amount,
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    initial_amount = current_amount + new.amount,
+    current_amount = current_amount + new.amount,
+    budget_input = new.budget_input,
+    input_interval = new.input_interval,
+    input_period = new.input_period,
+    spending_limit = new.spending_limit,
+    spending_left = new.spending_limit,
+    updated_at = datetime ('now')
+WHERE
+    id = new.category;
+
+UPDATE expenses
+SET
+    from_period = last_insert_rowid (),
+    updated_at = datetime ('now')
+WHERE
+    from_period IS NULL;
+
+END;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE accounts_input_log
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 5;
@@ -0,0 +1,337 @@
+/*
+ * Rollover policies
+ *
+ * Every category decides what happens with its leftover funds when the period is reset
+ * carry - the surplus stays in the category
+ * return - the surplus goes back to free funds
+ * cap - the surplus stays up to rollover_cap times the budget input, the rest goes back to free funds
+ * deduct - the surplus stays and an overspend is taken from the next spending limit
+ *
+ * Archived periods record the applied policy and amounts
+ * Resetting a category archives only the expenses of that category
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Category policy
+ */
+ALTER TABLE categories
+ADD COLUMN rollover_policy TEXT NOT NULL DEFAULT 'carry' CHECK (rollover_policy IN ('carry', 'return', 'cap', 'deduct'));
+
+ALTER TABLE categories
+ADD COLUMN rollover_cap INTEGER NOT NULL DEFAULT 1 CHECK (rollover_cap > 0);
+
+/*
+ * Applied policy
+ */
+ALTER TABLE archived_periods
+ADD COLUMN rollover_policy TEXT NOT NULL DEFAULT 'carry';
+
+ALTER TABLE archived_periods
+ADD COLUMN carried_amount INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE archived_periods
+ADD COLUMN returned_amount INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE archived_periods
+ADD COLUMN deducted_amount INTEGER NOT NULL DEFAULT 0;
+
+UPDATE archived_periods
+SET
+    carried_amount = end_amount;
+
+/*
+ * View categories overview
+ */
+DROP VIEW IF EXISTS view_categories_overview;
+
+CREATE VIEW
+    IF NOT EXISTS view_categories_overview AS
+SELECT
+    c.id,
+    c.name,
+    c.budget_input,
+    c.input_interval,
+    c.input_period,
+    (CONCAT (c.input_interval, ' ', p.caption)) AS period_caption,
+    c.spending_limit,
+    c.spending_left,
+    c.last_input_date AS period_start,
+    datetime (
+        c.last_input_date,
+        concat (c.input_interval, p.period)
+    ) AS period_end,
+    c.initial_amount,
+    c.current_amount,
+    (
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                archived_periods
+            WHERE
+                category = c.id
+        ) = 0
+    ) AS can_be_deleted,
+    c.table_order,
+    c.rollover_policy,
+    c.rollover_cap
+FROM
+    categories AS c
+    JOIN time_periods AS p ON c.input_period = p.id;
+
+/*
+ * Create new category
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_new_category__insert_new;
+
+DROP VIEW IF EXISTS procedure_new_category;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_category AS
+SELECT
+    name,
+    budget_input,
+    input_interval,
+    input_period,
+    spending_limit,
+    rollover_policy,
+    rollover_cap
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_category__insert_new INSTEAD OF INSERT ON procedure_new_category BEGIN
+INSERT INTO
+    categories (
+        name,
+        budget_input,
+        input_interval,
+        input_period,
+        spending_limit,
+        spending_left,
+        initial_amount,
+        current_amount,
+        table_order,
+        rollover_policy,
+        rollover_cap
+    )
+VALUES
+    (
+        new.name,
+        new.budget_input,
+        new.input_interval,
+        new.input_period,
+        new.spending_limit,
+        new.spending_limit,
+        0,
+        0,
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                categories
+        ) + 1,
+        COALESCE(new.rollover_policy, 'carry'),
+        COALESCE(new.rollover_cap, 1)
+    );
+
+END;
+
+/*
+ * Input data to category and reset period
+ *
+ * The rollover policy passed in is applied and saved to the category
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_fund_category_and_reset_period_insert;
+
+DROP VIEW IF EXISTS procedure_fund_category_and_reset_period;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_fund_category_and_reset_period AS
+SELECT
+    current_amount as amount,
+    id as category,
+    budget_input,
+    input_interval,
+    input_period,
+    spending_limit,
+    rollover_policy,
+    rollover_cap
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_fund_category_and_reset_period_insert INSTEAD OF INSERT ON procedure_fund_category_and_reset_period BEGIN
+SELECT
+    CASE
+        WHEN new.amount < 0 THEN RAISE (ABORT, 'input amount must be greather than zero')
+    END;
+
+INSERT INTO
+    archived_periods (
+        category,
+        period_start,
+        period_end,
+        budget_input,
+        spending_limit,
+        input_interval,
+        input_period,
+        initial_amount,
+        end_amount,
+        rollover_policy,
+        carried_amount,
+        returned_amount,
+        deducted_amount
+    )
+SELECT
+    id,
+    last_input_date,
+    datetime ('now'),
+    budget_input,
+    spending_limit,
+    input_interval,
+    input_period,
+    initial_amount,
+    current_amount,
+    new.rollover_policy,
+    CASE new.rollover_policy
+        WHEN 'return' THEN 0
+        WHEN 'cap' THEN MIN(current_amount, new.rollover_cap * new.budget_input)
+        ELSE current_amount
+    END,
+    CASE new.rollover_policy
+        WHEN 'return' THEN current_amount
+        WHEN 'cap' THEN MAX(current_amount - new.rollover_cap * new.budget_input, 0)
+        ELSE 0
+    END,
+    CASE
+        WHEN new.rollover_policy = 'deduct'
+        AND spending_left < 0 THEN - spending_left
+        ELSE 0
+    END
+FROM
+    categories
+WHERE
+    id = new.category;
+
+UPDATE user
+SET
+    free_funds = free_funds - new.amount + (
+        SELECT
+            returned_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    initial_amount = (
+        SELECT
+            carried_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ) + new.amount,
+    current_amount = (
+        SELECT
+            carried_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ) + new.amount,
+    budget_input = new.budget_input,
+    input_interval = new.input_interval,
+    input_period = new.input_period,
+    spending_limit = new.spending_limit,
+    spending_left = new.spending_limit - (
+        SELECT
+            deducted_amount
+        FROM
+            archived_periods
+        WHERE
+            id = last_insert_rowid ()
+    ),
+    last_input_date = datetime ('now'),
+    rollover_policy = new.rollover_policy,
+    rollover_cap = new.rollover_cap,
+    updated_at = datetime ('now')
+WHERE
+    id = new.category;
+
+UPDATE expenses
+SET
+    from_period = last_insert_rowid (),
+    updated_at = datetime ('now')
+WHERE
+    from_period IS NULL
+    AND from_category = new.category;
+
+END;
+
+/*
+ * Change base currency
+ *
+ * Rollover amounts of archived periods are re-valued as well
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER),
+    carried_amount = CAST(ROUND(carried_amount * new.factor) AS INTEGER),
+    returned_amount = CAST(ROUND(returned_amount * new.factor) AS INTEGER),
+    deducted_amount = CAST(ROUND(deducted_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE accounts_input_log
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 6;
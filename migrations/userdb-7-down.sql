@@ -0,0 +1,130 @@
+/*
+ * Remove automatic category resets
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+DROP TRIGGER IF EXISTS triggers__procedure_log_category_reset__insert;
+
+DROP VIEW IF EXISTS procedure_log_category_reset;
+
+DROP TRIGGER IF EXISTS triggers__procedure_set_category_auto_reset__update;
+
+DROP VIEW IF EXISTS procedure_set_category_auto_reset;
+
+DROP TRIGGER IF EXISTS triggers__archived_periods__clear_reset_due;
+
+DROP TABLE IF EXISTS category_reset_log;
+
+/*
+ * Restore categories overview
+ */
+DROP VIEW IF EXISTS view_categories_overview;
+
+CREATE VIEW
+    IF NOT EXISTS view_categories_overview AS
+SELECT
+    c.id,
+    c.name,
+    c.budget_input,
+    c.input_interval,
+    c.input_period,
+    (CONCAT (c.input_interval, ' ', p.caption)) AS period_caption,
+    c.spending_limit,
+    c.spending_left,
+    c.last_input_date AS period_start,
+    datetime (
+        c.last_input_date,
+        concat (c.input_interval, p.period)
+    ) AS period_end,
+    c.initial_amount,
+    c.current_amount,
+    (
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                archived_periods
+            WHERE
+                category = c.id
+        ) = 0
+    ) AS can_be_deleted,
+    c.table_order,
+    c.rollover_policy,
+    c.rollover_cap
+FROM
+    categories AS c
+    JOIN time_periods AS p ON c.input_period = p.id;
+
+/*
+ * Restore create new category
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_new_category__insert_new;
+
+DROP VIEW IF EXISTS procedure_new_category;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_category AS
+SELECT
+    name,
+    budget_input,
+    input_interval,
+    input_period,
+    spending_limit,
+    rollover_policy,
+    rollover_cap
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_category__insert_new INSTEAD OF INSERT ON procedure_new_category BEGIN
+INSERT INTO
+    categories (
+        name,
+        budget_input,
+        input_interval,
+        input_period,
+        spending_limit,
+        spending_left,
+        initial_amount,
+        current_amount,
+        table_order,
+        rollover_policy,
+        rollover_cap
+    )
+VALUES
+    (
+        new.name,
+        new.budget_input,
+        new.input_interval,
+        new.input_period,
+        new.spending_limit,
+        new.spending_limit,
+        0,
+        0,
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                categories
+        ) + 1,
+        COALESCE(new.rollover_policy, 'carry'),
+        COALESCE(new.rollover_cap, 1)
+    );
+
+END;
+
+ALTER TABLE categories
+DROP COLUMN reset_due;
+
+ALTER TABLE categories
+DROP COLUMN auto_reset;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 6;
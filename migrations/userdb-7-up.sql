@@ -0,0 +1,210 @@
+/*
+ * Automatic category resets
+ *
+ * Categories can be reset automatically when their period ends
+ * The node resets them with the budget input from free funds or marks them as due when free funds are not enough
+ * Every run is logged
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Auto reset settings
+ *
+ * A due category stays due until it is reset
+ */
+ALTER TABLE categories
+ADD COLUMN auto_reset INTEGER NOT NULL DEFAULT 0 CHECK (auto_reset IN (0, 1));
+
+ALTER TABLE categories
+ADD COLUMN reset_due INTEGER NOT NULL DEFAULT 0 CHECK (reset_due IN (0, 1));
+
+/*
+ * Log of automatic reset runs
+ */
+CREATE TABLE
+    IF NOT EXISTS category_reset_log (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        category INTEGER NOT NULL REFERENCES categories (id) ON DELETE CASCADE ON UPDATE CASCADE,
+        period_end DATETIME NOT NULL,
+        amount INTEGER NOT NULL DEFAULT 0,
+        status TEXT NOT NULL CHECK (status IN ('reset', 'due')),
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+/*
+ * Resetting a category clears the due state
+ */
+CREATE TRIGGER IF NOT EXISTS triggers__archived_periods__clear_reset_due AFTER INSERT ON archived_periods BEGIN
+UPDATE categories
+SET
+    reset_due = 0
+WHERE
+    id = new.category;
+
+END;
+
+/*
+ * View categories overview
+ */
+DROP VIEW IF EXISTS view_categories_overview;
+
+CREATE VIEW
+    IF NOT EXISTS view_categories_overview AS
+SELECT
+    c.id,
+    c.name,
+    c.budget_input,
+    c.input_interval,
+    c.input_period,
+    (CONCAT (c.input_interval, ' ', p.caption)) AS period_caption,
+    c.spending_limit,
+    c.spending_left,
+    c.last_input_date AS period_start,
+    datetime (
+        c.last_input_date,
+        concat (c.input_interval, p.period)
+    ) AS period_end,
+    c.initial_amount,
+    c.current_amount,
+    (
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                archived_periods
+            WHERE
+                category = c.id
+        ) = 0
+    ) AS can_be_deleted,
+    c.table_order,
+    c.rollover_policy,
+    c.rollover_cap,
+    c.auto_reset,
+    c.reset_due
+FROM
+    categories AS c
+    JOIN time_periods AS p ON c.input_period = p.id;
+
+/*
+ * Create new category
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_new_category__insert_new;
+
+DROP VIEW IF EXISTS procedure_new_category;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_category AS
+SELECT
+    name,
+    budget_input,
+    input_interval,
+    input_period,
+    spending_limit,
+    rollover_policy,
+    rollover_cap,
+    auto_reset
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_category__insert_new INSTEAD OF INSERT ON procedure_new_category BEGIN
+INSERT INTO
+    categories (
+        name,
+        budget_input,
+        input_interval,
+        input_period,
+        spending_limit,
+        spending_left,
+        initial_amount,
+        current_amount,
+        table_order,
+        rollover_policy,
+        rollover_cap,
+        auto_reset
+    )
+VALUES
+    (
+        new.name,
+        new.budget_input,
+        new.input_interval,
+        new.input_period,
+        new.spending_limit,
+        new.spending_limit,
+        0,
+        0,
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                categories
+        ) + 1,
+        COALESCE(new.rollover_policy, 'carry'),
+        COALESCE(new.rollover_cap, 1),
+        COALESCE(new.auto_reset, 0)
+    );
+
+END;
+
+/*
+ * Turn auto reset on or off
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_set_category_auto_reset AS
+SELECT
+    id,
+    auto_reset
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_set_category_auto_reset__update INSTEAD OF
+UPDATE ON procedure_set_category_auto_reset BEGIN
+UPDATE categories
+SET
+    auto_reset = new.auto_reset,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Log automatic reset run
+ *
+ * A due run marks the category as due
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_log_category_reset AS
+SELECT
+    category,
+    period_end,
+    amount,
+    status
+FROM
+    category_reset_log;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_log_category_reset__insert INSTEAD OF INSERT ON procedure_log_category_reset BEGIN
+INSERT INTO
+    category_reset_log (category, period_end, amount, status)
+VALUES
+    (new.category, new.period_end, new.amount, new.status);
+
+UPDATE categories
+SET
+    reset_due = 1,
+    updated_at = datetime ('now')
+WHERE
+    id = new.category
+    AND new.status = 'due';
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 7;
@@ -0,0 +1,239 @@
+/*
+ * Savings goals
+ *
+ * Categories with a goal amount save towards it until the goal date
+ * The input needed at every reset is calculated by the node
+ * Spending from a goal category is never overspending
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Goal settings
+ *
+ * A category is a goal when the goal amount is greater than zero
+ */
+ALTER TABLE categories
+ADD COLUMN goal_amount INTEGER NOT NULL DEFAULT 0 CHECK (goal_amount >= 0);
+
+ALTER TABLE categories
+ADD COLUMN goal_date DATETIME DEFAULT null;
+
+/*
+ * Goal categories keep their spending limit
+ *
+ * Expenses take from the saved amount, so spending left is not reduced
+ */
+CREATE TRIGGER IF NOT EXISTS triggers__categories__goal_spending_left AFTER
+UPDATE OF spending_left ON categories WHEN new.goal_amount > 0
+AND new.spending_left <> new.spending_limit BEGIN
+UPDATE categories
+SET
+    spending_left = new.spending_limit
+WHERE
+    id = new.id;
+
+END;
+
+/*
+ * View categories overview
+ */
+DROP VIEW IF EXISTS view_categories_overview;
+
+CREATE VIEW
+    IF NOT EXISTS view_categories_overview AS
+SELECT
+    c.id,
+    c.name,
+    c.budget_input,
+    c.input_interval,
+    c.input_period,
+    (CONCAT (c.input_interval, ' ', p.caption)) AS period_caption,
+    c.spending_limit,
+    c.spending_left,
+    c.last_input_date AS period_start,
+    datetime (
+        c.last_input_date,
+        concat (c.input_interval, p.period)
+    ) AS period_end,
+    c.initial_amount,
+    c.current_amount,
+    (
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                archived_periods
+            WHERE
+                category = c.id
+        ) = 0
+    ) AS can_be_deleted,
+    c.table_order,
+    c.rollover_policy,
+    c.rollover_cap,
+    c.auto_reset,
+    c.reset_due,
+    c.goal_amount,
+    c.goal_date,
+    p.period AS period_unit
+FROM
+    categories AS c
+    JOIN time_periods AS p ON c.input_period = p.id;
+
+/*
+ * Create new category
+ */
+DROP TRIGGER IF EXISTS triggers__procedure_new_category__insert_new;
+
+DROP VIEW IF EXISTS procedure_new_category;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_new_category AS
+SELECT
+    name,
+    budget_input,
+    input_interval,
+    input_period,
+    spending_limit,
+    rollover_policy,
+    rollover_cap,
+    auto_reset,
+    goal_amount,
+    goal_date
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_category__insert_new INSTEAD OF INSERT ON procedure_new_category BEGIN
+INSERT INTO
+    categories (
+        name,
+        budget_input,
+        input_interval,
+        input_period,
+        spending_limit,
+        spending_left,
+        initial_amount,
+        current_amount,
+        table_order,
+        rollover_policy,
+        rollover_cap,
+        auto_reset,
+        goal_amount,
+        goal_date
+    )
+VALUES
+    (
+        new.name,
+        new.budget_input,
+        new.input_interval,
+        new.input_period,
+        new.spending_limit,
+        new.spending_limit,
+        0,
+        0,
+        (
+            SELECT
+                COUNT(*)
+            FROM
+                categories
+        ) + 1,
+        COALESCE(new.rollover_policy, 'carry'),
+        COALESCE(new.rollover_cap, 1),
+        COALESCE(new.auto_reset, 0),
+        COALESCE(new.goal_amount, 0),
+        new.goal_date
+    );
+
+END;
+
+/*
+ * Set or clear goal
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_set_category_goal AS
+SELECT
+    id,
+    goal_amount,
+    goal_date
+FROM
+    categories;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_set_category_goal__update INSTEAD OF
+UPDATE ON procedure_set_category_goal BEGIN
+UPDATE categories
+SET
+    goal_amount = new.goal_amount,
+    goal_date = new.goal_date,
+    spending_left = CASE
+        WHEN new.goal_amount > 0 THEN spending_limit
+        ELSE spending_left
+    END,
+    updated_at = datetime ('now')
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Change base currency
+ *
+ * Goal amounts are re-valued as well
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    goal_amount = CAST(ROUND(goal_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER),
+    carried_amount = CAST(ROUND(carried_amount * new.factor) AS INTEGER),
+    returned_amount = CAST(ROUND(returned_amount * new.factor) AS INTEGER),
+    deducted_amount = CAST(ROUND(deducted_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE accounts_input_log
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 8;
@@ -0,0 +1,292 @@
+/*
+ * Liabilities
+ *
+ * Money borrowed from or lent to a counterparty, with an optional interest rate and payment schedule
+ * Amounts are in the base currency
+ * Payments are recorded as an expense (borrowed) or an income (lent) and lower the balance by their principal part
+ */
+PRAGMA foreign_keys = OFF;
+
+BEGIN TRANSACTION;
+
+/*
+ * Liabilities table
+ *
+ * Interest rate is yearly, in basis points
+ * Liabilities without a payment period have no schedule
+ */
+CREATE TABLE
+    IF NOT EXISTS liabilities (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        kind TEXT NOT NULL CHECK (kind IN ('borrowed', 'lent')),
+        counterparty TEXT NOT NULL,
+        principal INTEGER NOT NULL CHECK (principal > 0),
+        balance INTEGER NOT NULL CHECK (balance >= 0),
+        interest_rate INTEGER NOT NULL DEFAULT 0 CHECK (interest_rate >= 0),
+        payment_amount INTEGER NOT NULL DEFAULT 0 CHECK (payment_amount >= 0),
+        payment_interval INTEGER NOT NULL DEFAULT 1 CHECK (payment_interval > 0),
+        payment_period INTEGER DEFAULT null REFERENCES time_periods (id) ON DELETE RESTRICT ON UPDATE CASCADE,
+        start_date DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at DATETIME DEFAULT null
+    );
+
+/*
+ * Liability payments table
+ *
+ * The expense or income created by the payment is kept when the liability is removed
+ */
+CREATE TABLE
+    IF NOT EXISTS liability_payments (
+        id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+        liability INTEGER NOT NULL REFERENCES liabilities (id) ON DELETE CASCADE ON UPDATE CASCADE,
+        amount INTEGER NOT NULL CHECK (amount > 0),
+        interest INTEGER NOT NULL DEFAULT 0 CHECK (
+            interest >= 0
+            AND interest <= amount
+        ),
+        expense_id INTEGER DEFAULT null REFERENCES expenses (id) ON DELETE SET NULL ON UPDATE CASCADE,
+        income_id INTEGER DEFAULT null REFERENCES accounts_input_log (id) ON DELETE SET NULL ON UPDATE CASCADE,
+        date DATETIME NOT NULL,
+        created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+/*
+ * Liabilities view
+ *
+ * Next payment is one payment period after the last payment or the start date
+ */
+CREATE VIEW
+    IF NOT EXISTS view_liabilities AS
+SELECT
+    l.id,
+    l.kind,
+    l.counterparty,
+    l.principal,
+    l.balance,
+    l.interest_rate,
+    l.payment_amount,
+    l.payment_interval,
+    l.payment_period,
+    COALESCE(CONCAT (l.payment_interval, ' ', p.caption), '') AS payment_period_caption,
+    l.start_date,
+    CASE
+        WHEN l.payment_period IS NULL
+        OR l.balance = 0 THEN null
+        ELSE datetime (
+            COALESCE(
+                (
+                    SELECT
+                        MAX(date)
+                    FROM
+                        liability_payments
+                    WHERE
+                        liability = l.id
+                ),
+                l.start_date
+            ),
+            concat (l.payment_interval, p.period)
+        )
+    END AS next_payment
+FROM
+    liabilities AS l
+    LEFT JOIN time_periods AS p ON l.payment_period = p.id
+ORDER BY
+    l.balance = 0,
+    l.start_date DESC;
+
+/*
+ * Add liability
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_new_liability AS
+SELECT
+    kind,
+    counterparty,
+    principal,
+    interest_rate,
+    payment_amount,
+    payment_interval,
+    payment_period,
+    start_date
+FROM
+    liabilities;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_new_liability__insert INSTEAD OF INSERT ON procedure_new_liability BEGIN
+INSERT INTO
+    liabilities (
+        kind,
+        counterparty,
+        principal,
+        balance,
+        interest_rate,
+        payment_amount,
+        payment_interval,
+        payment_period,
+        start_date
+    )
+VALUES
+    (
+        new.kind,
+        new.counterparty,
+        new.principal,
+        new.principal,
+        COALESCE(new.interest_rate, 0),
+        COALESCE(new.payment_amount, 0),
+        COALESCE(new.payment_interval, 1),
+        new.payment_period,
+        COALESCE(new.start_date, datetime ('now'))
+    );
+
+END;
+
+/*
+ * Remove liability
+ *
+ * Expenses and incomes of its payments are kept
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_remove_liability AS
+SELECT
+    id
+FROM
+    liabilities;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_remove_liability__remove INSTEAD OF DELETE ON procedure_remove_liability BEGIN
+DELETE FROM liabilities
+WHERE
+    id = old.id;
+
+END;
+
+/*
+ * Add liability payment
+ *
+ * The expense or income must be created first
+ */
+CREATE VIEW
+    IF NOT EXISTS procedure_add_liability_payment AS
+SELECT
+    liability,
+    amount,
+    interest,
+    expense_id,
+    income_id,
+    date
+FROM
+    liability_payments;
+
+CREATE TRIGGER IF NOT EXISTS triggers__procedure_add_liability_payment__insert INSTEAD OF INSERT ON procedure_add_liability_payment BEGIN
+SELECT
+    CASE
+        WHEN (
+            SELECT
+                balance
+            FROM
+                liabilities
+            WHERE
+                id = new.liability
+        ) < new.amount - new.interest THEN RAISE (ABORT, 'payment is larger than the liability balance')
+    END;
+
+INSERT INTO
+    liability_payments (
+        liability,
+        amount,
+        interest,
+        expense_id,
+        income_id,
+        date
+    )
+VALUES
+    (
+        new.liability,
+        new.amount,
+        new.interest,
+        new.expense_id,
+        new.income_id,
+        new.date
+    );
+
+UPDATE liabilities
+SET
+    balance = balance - (new.amount - new.interest),
+    updated_at = datetime ('now')
+WHERE
+    id = new.liability;
+
+END;
+
+/*
+ * Change base currency
+ *
+ * Liabilities are re-valued as well
+ */
+DROP TRIGGER IF EXISTS trigger__procedure_change_base_currency__update;
+
+DROP VIEW IF EXISTS procedure_change_base_currency;
+
+CREATE VIEW
+    IF NOT EXISTS procedure_change_base_currency AS
+SELECT
+    currency,
+    null as factor
+FROM
+    user;
+
+CREATE TRIGGER IF NOT EXISTS trigger__procedure_change_base_currency__update INSTEAD OF INSERT ON procedure_change_base_currency BEGIN
+UPDATE user
+SET
+    currency = new.currency,
+    free_funds = CAST(ROUND(free_funds * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE categories
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    spending_left = CAST(ROUND(spending_left * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    current_amount = CAST(ROUND(current_amount * new.factor) AS INTEGER),
+    goal_amount = CAST(ROUND(goal_amount * new.factor) AS INTEGER),
+    updated_at = datetime ('now');
+
+UPDATE archived_periods
+SET
+    budget_input = CAST(ROUND(budget_input * new.factor) AS INTEGER),
+    spending_limit = CAST(ROUND(spending_limit * new.factor) AS INTEGER),
+    initial_amount = CAST(ROUND(initial_amount * new.factor) AS INTEGER),
+    end_amount = CAST(ROUND(end_amount * new.factor) AS INTEGER),
+    carried_amount = CAST(ROUND(carried_amount * new.factor) AS INTEGER),
+    returned_amount = CAST(ROUND(returned_amount * new.factor) AS INTEGER),
+    deducted_amount = CAST(ROUND(deducted_amount * new.factor) AS INTEGER);
+
+UPDATE expenses
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE accounts_input_log
+SET
+    base_amount = CAST(ROUND(base_amount * new.factor) AS INTEGER);
+
+UPDATE liabilities
+SET
+    principal = CAST(ROUND(principal * new.factor) AS INTEGER),
+    balance = CAST(ROUND(balance * new.factor) AS INTEGER),
+    payment_amount = CAST(ROUND(payment_amount * new.factor) AS INTEGER);
+
+UPDATE liability_payments
+SET
+    amount = CAST(ROUND(amount * new.factor) AS INTEGER),
+    interest = CAST(ROUND(interest * new.factor) AS INTEGER);
+
+END;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+/*
+ * Set user version
+ */
+PRAGMA user_version = 9;
@@ -0,0 +1,36 @@
+window.addEventListener("load", () => {
+  // Test to see if the browser supports the HTML template element
+  if (!("content" in document.createElement("template"))) {
+    return;
+  }
+
+  // Get all split editors and loop trough them
+  document.querySelectorAll(".split-editor").forEach((editor) => {
+    /**
+     * Get lines container
+     * @type {HTMLDivElement | null}
+     */
+    const lines = editor.querySelector(".split-lines");
+    if (!lines) return;
+
+    /**
+     * Get line template
+     * @type {HTMLTemplateElement | null}
+     */
+    const template = editor.querySelector(".split-line-template");
+    if (!template) return;
+
+    // Add a new empty line
+    editor.querySelector(".split-add")?.addEventListener("click", () => {
+      lines.appendChild(template.content.cloneNode(true));
+    });
+
+    // Remove a line
+    lines.addEventListener("click", (e) => {
+      const button = e.target.closest(".split-remove");
+      if (!button) return;
+
+      button.closest(".split-line")?.remove();
+    });
+  });
+});
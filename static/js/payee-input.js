@@ -0,0 +1,49 @@
+window.addEventListener("load", () => {
+  // Get all payee inputs and loop trough them
+  document.querySelectorAll(".payee-input input").forEach((input) => {
+    /**
+     * Get the form of the input
+     * @type {HTMLFormElement | null}
+     */
+    const form = input.closest("form");
+    if (!form) return;
+
+    // Prefill the form when a known payee is chosen
+    input.addEventListener("change", () => {
+      // Get payee option
+      const name = input.value.trim().toLocaleLowerCase();
+      const option = [...(input.list?.options ?? [])].find(
+        (option) => option.value.toLocaleLowerCase() === name
+      );
+      if (!option) return;
+
+      // Set account and category
+      setSelect(
+        form.querySelector("select[name='from_account']"),
+        option.dataset.account
+      );
+      setSelect(
+        form.querySelector("select[name='from_category']"),
+        option.dataset.category
+      );
+
+      // Set tags
+      /** @type {HTMLInputElement | null} */
+      const tags = form.querySelector("input[name='tags']");
+      if (tags && option.dataset.tags) {
+        tags.value = option.dataset.tags;
+        tags.dispatchEvent(new CustomEvent("tags:set"));
+      }
+    });
+  });
+
+  /**
+   * Select a value, if there is one
+   * @param {HTMLSelectElement | null} select
+   * @param {string | undefined} value
+   */
+  function setSelect(select, value) {
+    if (!select || !value) return;
+    select.value = value;
+  }
+});
@@ -1,4 +1,6 @@
 /**
+ * Amounts are in minor units of the user currency (e.g. cents)
+ *
  * @typedef {Object} CategoryOverview
  * @property {number} ID
  * @property {string} Name
@@ -11,6 +13,19 @@
  * @property {number} PeriodEnd
  * @property {number} InitialAmount
  * @property {number} CurrentAmount
+ * @property {number} SpendingLeft
+ * @property {string} RolloverPolicy
+ * @property {number} RolloverCap
+ * @property {number} GoalAmount
+ * @property {number} GoalContribution
+ */
+
+/**
+ * Amounts moved by the rollover policy on reset
+ * @typedef {Object} Rollover
+ * @property {number} carried
+ * @property {number} returned
+ * @property {number} deducted
  */
 
 /**
@@ -75,6 +90,12 @@ window.addEventListener("load", () => {
       });
       if (!periods) return;
 
+      /**
+       * Decimal places of the user currency
+       * @type {number}
+       */
+      const exponent = Number(container?.dataset?.exponent ?? 2);
+
       /**
        * Get unused categories container
        * @type {HTMLDivElement | null}
@@ -108,7 +129,7 @@ window.addEventListener("load", () => {
        * Free funds value
        * @type {{value: () => number, setValue: (v: number) => void, addEventListener: (cb: (v: number) => void) => void}, removeEventListener: (cb: (v: number) => void) => void}
        */
-      const vFreeFunds = Value(Number(freeFundsSpan.textContent));
+      const vFreeFunds = Value(Number(freeFundsSpan.dataset.amount));
 
       /**
        * Unused categories values
@@ -227,7 +248,7 @@ window.addEventListener("load", () => {
       });
 
       vFreeFunds.addEventListener((val) => {
-        freeFundsSpan.textContent = val;
+        freeFundsSpan.textContent = formatAmount(val);
       });
 
       /**
@@ -256,13 +277,19 @@ window.addEventListener("load", () => {
         card
           .querySelectorAll(".name")
           .forEach((e) => (e.textContent = category.Name));
-        card.querySelector(".amount").textContent =
-          category.CurrentAmount.toFixed(2);
-        card.querySelector(".input").textContent =
-          category.BudgetInput.toFixed(2);
-        card.querySelector(".spending-limit").textContent =
-          category.SpendingLimit.toFixed(2);
+        card.querySelector(".amount").textContent = formatAmount(
+          category.CurrentAmount
+        );
+        card.querySelector(".input").textContent = formatAmount(
+          category.BudgetInput
+        );
+        card.querySelector(".spending-limit").textContent = formatAmount(
+          category.SpendingLimit
+        );
         card.querySelector(".period").textContent = category.InputPeriodCaption;
+        card
+          .querySelectorAll(".goal")
+          .forEach((e) => (e.textContent = goalText(category)));
 
         /**
          * Get dialog
@@ -273,16 +300,25 @@ window.addEventListener("load", () => {
         // Add click event listener to card
         card.addEventListener("click", () => {
           // Set dialog values
-          dialog.querySelector("[name='add_amount']").value =
-            category.BudgetInput;
-          dialog.querySelector("[name='budget_input']").value =
-            category.BudgetInput;
+          // Goals suggest the input needed to reach them
+          dialog.querySelector("[name='add_amount']").value = formatAmount(
+            category.GoalAmount > 0
+              ? category.GoalContribution
+              : category.BudgetInput
+          );
+          dialog.querySelector("[name='budget_input']").value = formatAmount(
+            category.BudgetInput
+          );
           dialog.querySelector("[name='spending_limit']").value =
-            category.SpendingLimit;
+            formatAmount(category.SpendingLimit);
           dialog.querySelector("[name='input_interval']").value =
             category.InputInterval;
           dialog.querySelector("[name='input_period']").value =
             category.InputPeriodId;
+          dialog.querySelector("[name='rollover_policy']").value =
+            category.RolloverPolicy;
+          dialog.querySelector("[name='rollover_cap']").value =
+            category.RolloverCap;
 
           // Open dialog
           dialog.showModal();
@@ -310,14 +346,17 @@ window.addEventListener("load", () => {
 
           // Create new category object
           const c = { ...category };
-          c.InitialAmount = Number(values.get("add_amount"));
-          c.BudgetInput = Number(values.get("budget_input"));
-          c.SpendingLimit = Number(values.get("spending_limit"));
+          c.InitialAmount = parseAmount(values.get("add_amount"));
+          c.BudgetInput = parseAmount(values.get("budget_input"));
+          c.SpendingLimit = parseAmount(values.get("spending_limit"));
           c.InputInterval = Number(values.get("input_interval"));
           c.InputPeriodId = Number(values.get("input_period"));
+          c.RolloverPolicy = values.get("rollover_policy");
+          c.RolloverCap = Math.max(Number(values.get("rollover_cap")) || 1, 1);
 
-          // Recalculate free funds
-          const free = vFreeFunds.value() - c.InitialAmount;
+          // Recalculate free funds. Returned surplus is added back
+          const free =
+            vFreeFunds.value() - c.InitialAmount + rollover(c).returned;
 
           // If free funds gets bellow zero
           if (free < 0) {
@@ -357,25 +396,32 @@ window.addEventListener("load", () => {
         const card = usedCatgoryTemplate.cloneNode(true).firstChild;
         if (!card) return;
 
+        // Get amounts moved by the rollover policy
+        const r = rollover(category);
+
         // Set props
         card.querySelector(".name").textContent = category.Name;
-        card.querySelector(".amount-current").textContent = (
-          category.CurrentAmount + category.InitialAmount
-        ).toFixed(2);
-        card.querySelector(".amount-add").textContent =
-          category.InitialAmount.toFixed(2);
-        card.querySelector(".input").textContent =
-          category.BudgetInput.toFixed(2);
-        card.querySelector(".spending-limit").textContent =
-          category.SpendingLimit.toFixed(2);
+        card.querySelector(".amount-current").textContent = formatAmount(
+          r.carried + category.InitialAmount
+        );
+        card.querySelector(".amount-add").textContent = formatAmount(
+          category.InitialAmount
+        );
+        card.querySelector(".input").textContent = formatAmount(
+          category.BudgetInput
+        );
+        card.querySelector(".spending-limit").textContent = formatAmount(
+          category.SpendingLimit
+        );
         card.querySelector(".period").textContent = `${
           category.InputInterval
         } ${periods.find((p) => p.ID == category.InputPeriodId)?.Caption}`;
+        card.querySelector(".rollover").textContent = rolloverText(r);
 
         // Add click event listener
         card.addEventListener("click", () => {
           // Calculate old free funds value
-          const free = vFreeFunds.value() + category.InitialAmount;
+          const free = vFreeFunds.value() + category.InitialAmount - r.returned;
 
           // Update free funds
           vFreeFunds.setValue(free);
@@ -394,6 +440,57 @@ window.addEventListener("load", () => {
 
         return card;
       }
+
+      /**
+       * Describe the goal of a category
+       * @param {CategoryOverview} c
+       * @returns {string}
+       */
+      function goalText(c) {
+        if (!(c.GoalAmount > 0)) {
+          return "";
+        }
+        if (c.GoalContribution === 0) {
+          return `Goal ${formatAmount(c.GoalAmount)} reached`;
+        }
+        return `Goal ${formatAmount(c.GoalAmount)} · suggested ${formatAmount(
+          c.GoalContribution
+        )}`;
+      }
+
+      /**
+       * Describe the amounts moved by the rollover policy
+       * @param {Rollover} r
+       * @returns {string}
+       */
+      function rolloverText(r) {
+        const parts = [`Carry ${formatAmount(r.carried)}`];
+        if (r.returned > 0) {
+          parts.push(`Return ${formatAmount(r.returned)}`);
+        }
+        if (r.deducted > 0) {
+          parts.push(`Limit -${formatAmount(r.deducted)}`);
+        }
+        return parts.join(" · ");
+      }
+
+      /**
+       * Format minor units as a decimal
+       * @param {number} amount
+       * @returns {string}
+       */
+      function formatAmount(amount) {
+        return (amount / 10 ** exponent).toFixed(exponent);
+      }
+
+      /**
+       * Parse a decimal into minor units
+       * @param {string} value
+       * @returns {number}
+       */
+      function parseAmount(value) {
+        return Math.round(Number(value) * 10 ** exponent);
+      }
     }
   );
 
@@ -401,6 +498,31 @@ window.addEventListener("load", () => {
    * Helper functions
    */
 
+  /**
+   * Get amounts moved by the rollover policy. Mirrors the reset procedure in the user database
+   *
+   * @param {CategoryOverview} c
+   * @returns {Rollover}
+   */
+  function rollover(c) {
+    const cap = c.RolloverCap * c.BudgetInput;
+    const deducted =
+      c.RolloverPolicy === "deduct" && c.SpendingLeft < 0 ? -c.SpendingLeft : 0;
+
+    switch (c.RolloverPolicy) {
+      case "return":
+        return { carried: 0, returned: c.CurrentAmount, deducted };
+      case "cap":
+        return {
+          carried: Math.min(c.CurrentAmount, cap),
+          returned: Math.max(c.CurrentAmount - cap, 0),
+          deducted,
+        };
+      default:
+        return { carried: c.CurrentAmount, returned: 0, deducted };
+    }
+  }
+
   /**
    * Map text to categories
    *
@@ -418,7 +540,7 @@ window.addEventListener("load", () => {
       const props = cat.split(",");
 
       // Check length of props
-      if (props.length < 11) {
+      if (props.length < 16) {
         console.error(
           "Not enough properties passed for Category Overview in Reset Categories Form"
         );
@@ -437,6 +559,11 @@ window.addEventListener("load", () => {
       c.PeriodEnd = Number(props[8]);
       c.InitialAmount = Number(props[9]);
       c.CurrentAmount = Number(props[10]);
+      c.SpendingLeft = Number(props[11]);
+      c.RolloverPolicy = props[12];
+      c.RolloverCap = Number(props[13]);
+      c.GoalAmount = Number(props[14]);
+      c.GoalContribution = Number(props[15]);
 
       return c;
     });
@@ -463,6 +590,11 @@ window.addEventListener("load", () => {
           c.PeriodEnd,
           c.InitialAmount,
           c.CurrentAmount,
+          c.SpendingLeft,
+          c.RolloverPolicy,
+          c.RolloverCap,
+          c.GoalAmount,
+          c.GoalContribution,
         ].join(",")
       )
       .join(";");
@@ -316,6 +316,15 @@ window.addEventListener("load", () => {
       }
     });
 
+    // Handle tags set from outside, e.g. by a payee
+    hiddenInput.addEventListener("tags:set", () => {
+      vSelectedTags.setValue(
+        new Set(
+          hiddenInput.value.length === 0 ? [] : hiddenInput.value.split(/,\s*/)
+        )
+      );
+    });
+
     /**
      * Set initial values
      */